@@ -28,3 +28,21 @@ type TypeWithValidate interface {
 	// Type.
 	Validate(context.Context, tftypes.Value, path.Path) diag.Diagnostics
 }
+
+// TypeWithTerraformValueEqual extends the attr.Type interface to include a
+// TerraformValueEqual method, which the framework server uses when comparing
+// planned and prior state values of top level attributes directly on
+// tftypes.Value, before any attr.Value are created. This enables types with
+// extremely large values to short-circuit the comparison cheaply, such as by
+// comparing precomputed digests.
+type TypeWithTerraformValueEqual interface {
+	attr.Type
+
+	// TerraformValueEqual should return true if the given values are equal.
+	// It is only called with known, non-null values of the type, and the
+	// values must not be modified. Returning an incorrect result can cause
+	// the framework to skip marking computed values as unknown or to log
+	// incorrect value changes, so it must be consistent with the Equal
+	// method of the associated attr.Value.
+	TerraformValueEqual(ctx context.Context, a tftypes.Value, b tftypes.Value) bool
+}
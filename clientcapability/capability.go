@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clientcapability
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var (
+	// DeferredActions is the capability to receive deferred responses from
+	// the provider, such as from the resource.ModifyPlanResponse type
+	// Deferred field. Check the DeferralAllowed field of request
+	// ClientCapabilities before returning a deferred response.
+	DeferredActions = Capability{
+		Name:                    "deferred actions",
+		MinimumTerraformVersion: "1.9",
+		Notes:                   "Deferred actions are experimental and must be enabled in Terraform.",
+	}
+
+	// EphemeralResources is the capability to use ephemeral resources.
+	EphemeralResources = Capability{
+		Name:                    "ephemeral resources",
+		MinimumTerraformVersion: "1.10",
+	}
+
+	// MoveResourceState is the capability to move resource state across
+	// resource types with moved configuration blocks.
+	MoveResourceState = Capability{
+		Name:                    "moving resource state across resource types",
+		MinimumTerraformVersion: "1.8",
+	}
+
+	// ProviderFunctions is the capability to call provider-defined
+	// functions.
+	ProviderFunctions = Capability{
+		Name:                    "provider-defined functions",
+		MinimumTerraformVersion: "1.8",
+	}
+
+	// WriteOnlyAttributes is the capability to send write-only attribute
+	// values, which are never persisted in plan or state.
+	WriteOnlyAttributes = Capability{
+		Name:                    "write-only attributes",
+		MinimumTerraformVersion: "1.11",
+	}
+)
+
+// Capability is a Terraform client capability which providers may depend on.
+type Capability struct {
+	// Name is the practitioner-facing name of the capability, such as
+	// "deferred actions".
+	Name string
+
+	// MinimumTerraformVersion is the earliest Terraform version which
+	// supports the capability, such as "1.9".
+	MinimumTerraformVersion string
+
+	// Notes is additional practitioner-facing information about enabling
+	// the capability, if any.
+	Notes string
+}
+
+// UnsupportedError returns an error diagnostic for when the Terraform client
+// initiating the request does not support the capability, but the provider
+// requires it. The optional detail explains why the capability is needed.
+func (c Capability) UnsupportedError(detail string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(unsupportedSummary, c.unsupportedDetail(detail))
+}
+
+// UnsupportedWarning returns a warning diagnostic for when the Terraform
+// client initiating the request does not support the capability and the
+// provider can continue without it. The optional detail explains the
+// consequences of the missing capability.
+func (c Capability) UnsupportedWarning(detail string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(unsupportedSummary, c.unsupportedDetail(detail))
+}
+
+// unsupportedSummary is the summary of all unsupported capability
+// diagnostics.
+const unsupportedSummary = "Unsupported Terraform Capability"
+
+func (c Capability) unsupportedDetail(detail string) string {
+	result := fmt.Sprintf("The Terraform client initiating this request does not support %s, which this provider operation uses. ", c.Name) +
+		fmt.Sprintf("Support for %s requires Terraform %s or later.", c.Name, c.MinimumTerraformVersion)
+
+	if c.Notes != "" {
+		result += " " + c.Notes
+	}
+
+	if detail != "" {
+		result += "\n\n" + detail
+	}
+
+	return result
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clientcapability_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/clientcapability"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestCapabilityUnsupportedError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		capability clientcapability.Capability
+		detail     string
+		expected   diag.Diagnostic
+	}{
+		"no-detail": {
+			capability: clientcapability.MoveResourceState,
+			expected: diag.NewErrorDiagnostic(
+				"Unsupported Terraform Capability",
+				"The Terraform client initiating this request does not support moving resource state across resource types, which this provider operation uses. "+
+					"Support for moving resource state across resource types requires Terraform 1.8 or later.",
+			),
+		},
+		"detail": {
+			capability: clientcapability.WriteOnlyAttributes,
+			detail:     "The password attribute cannot be set.",
+			expected: diag.NewErrorDiagnostic(
+				"Unsupported Terraform Capability",
+				"The Terraform client initiating this request does not support write-only attributes, which this provider operation uses. "+
+					"Support for write-only attributes requires Terraform 1.11 or later.\n\n"+
+					"The password attribute cannot be set.",
+			),
+		},
+		"notes": {
+			capability: clientcapability.DeferredActions,
+			expected: diag.NewErrorDiagnostic(
+				"Unsupported Terraform Capability",
+				"The Terraform client initiating this request does not support deferred actions, which this provider operation uses. "+
+					"Support for deferred actions requires Terraform 1.9 or later. "+
+					"Deferred actions are experimental and must be enabled in Terraform.",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.capability.UnsupportedError(testCase.detail)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCapabilityUnsupportedWarning(t *testing.T) {
+	t.Parallel()
+
+	got := clientcapability.EphemeralResources.UnsupportedWarning("")
+	expected := diag.NewWarningDiagnostic(
+		"Unsupported Terraform Capability",
+		"The Terraform client initiating this request does not support ephemeral resources, which this provider operation uses. "+
+			"Support for ephemeral resources requires Terraform 1.10 or later.",
+	)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package clientcapability contains the Terraform client capabilities which
+// providers may depend on, such as deferred actions, along with helpers to
+// generate consistent diagnostics when the Terraform client initiating a
+// request does not support a capability.
+package clientcapability
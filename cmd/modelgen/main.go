@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// The modelgen command generates a typed data model from a JSON schema
+// definition, which replaces string attribute paths, such as
+// path.Root("disk_size"), in provider code. The generated source contains:
+//
+//   - The NAMEModel struct with Get and Set methods for reading and writing
+//     the entire model, such as from req.Plan or to resp.State.
+//   - The NAMEPaths variable with the path of each attribute, such as
+//     ThingPaths.DiskSize.
+//   - GetNAMEATTRIBUTE and SetNAMEATTRIBUTE functions for reading and writing
+//     a single attribute value with its value type, such as
+//     GetThingDiskSize returning types.Int64.
+//
+// The definition has the same format as the scaffold command. The command is
+// intended for use with go generate, so the output file is overwritten:
+//
+//	//go:generate go run github.com/hashicorp/terraform-plugin-framework/cmd/modelgen -definition thing.json -output thing_model_gen.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/scaffold"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("modelgen", flag.ContinueOnError)
+	definitionPath := flags.String("definition", "", "path to the JSON schema definition")
+	outputPath := flags.String("output", "", "path to write the generated file, defaults to NAME_model_gen.go")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *definitionPath == "" {
+		return fmt.Errorf("-definition must be provided")
+	}
+
+	definition, err := os.ReadFile(*definitionPath)
+
+	if err != nil {
+		return fmt.Errorf("unable to read schema definition: %w", err)
+	}
+
+	resource, err := scaffold.ParseResource(definition)
+
+	if err != nil {
+		return err
+	}
+
+	generated, err := scaffold.GenerateModel(resource)
+
+	if err != nil {
+		return err
+	}
+
+	filename := *outputPath
+
+	if filename == "" {
+		filename = resource.Name + "_model_gen.go"
+	}
+
+	if err := os.WriteFile(filename, generated, 0o644); err != nil {
+		return fmt.Errorf("unable to write %s: %w", filename, err)
+	}
+
+	fmt.Println("Generated", filename)
+
+	return nil
+}
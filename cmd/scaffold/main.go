@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// The scaffold command generates a skeleton resource.Resource implementation,
+// including its model struct, Metadata, Schema, and empty CRUD methods, plus
+// table-driven unit tests, from a JSON resource definition.
+//
+// Usage:
+//
+//	go run github.com/hashicorp/terraform-plugin-framework/cmd/scaffold -definition thing.json -output internal/provider
+//
+// The resource definition has the following format:
+//
+//	{
+//	  "name": "thing",
+//	  "package": "provider",
+//	  "description": "Manages a thing.",
+//	  "attributes": [
+//	    {"name": "id", "type": "string", "computed": true},
+//	    {"name": "name", "type": "string", "required": true},
+//	    {"name": "tags", "type": "map", "element_type": "string", "optional": true}
+//	  ]
+//	}
+//
+// The files NAME_resource.go and NAME_resource_test.go are written into the
+// output directory. Existing files are never overwritten.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/scaffold"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("scaffold", flag.ContinueOnError)
+	definitionPath := flags.String("definition", "", "path to the JSON resource definition")
+	outputDir := flags.String("output", ".", "directory to write the generated files")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *definitionPath == "" {
+		return fmt.Errorf("-definition must be provided")
+	}
+
+	definition, err := os.ReadFile(*definitionPath)
+
+	if err != nil {
+		return fmt.Errorf("unable to read resource definition: %w", err)
+	}
+
+	resource, err := scaffold.ParseResource(definition)
+
+	if err != nil {
+		return err
+	}
+
+	generated, err := scaffold.GenerateResource(resource)
+
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]byte{
+		filepath.Join(*outputDir, resource.Name+"_resource.go"):      generated.Source,
+		filepath.Join(*outputDir, resource.Name+"_resource_test.go"): generated.TestSource,
+	}
+
+	for filename := range files {
+		if _, err := os.Stat(filename); err == nil {
+			return fmt.Errorf("file %s already exists", filename)
+		}
+	}
+
+	for filename, contents := range files {
+		if err := os.WriteFile(filename, contents, 0o644); err != nil {
+			return fmt.Errorf("unable to write %s: %w", filename, err)
+		}
+
+		fmt.Println("Generated", filename)
+	}
+
+	return nil
+}
@@ -21,4 +21,31 @@ type MetadataResponse struct {
 	// TypeName should be the full data source type, including the provider
 	// type prefix and an underscore. For example, examplecloud_thing.
 	TypeName string
+
+	// Deprecation, if set, marks the data source type as deprecated. The
+	// framework composes a deprecation message from its fields, which is
+	// returned in the schema and shown to practitioners as a warning when
+	// the data source is used in configuration, unless the schema already
+	// defines a DeprecationMessage.
+	Deprecation Deprecation
+}
+
+// Deprecation describes the deprecation of a data source type.
+type Deprecation struct {
+	// Message is additional practitioner-facing information about the
+	// deprecation, such as migration steps.
+	Message string
+
+	// ReplacementTypeName is the data source type that practitioners should
+	// use instead, if any. For example, examplecloud_new_thing.
+	ReplacementTypeName string
+
+	// RemovalVersion is the provider version in which the data source type
+	// will be removed, if known. For example, 2.0.0.
+	RemovalVersion string
+}
+
+// IsDeprecated returns true if any Deprecation field is set.
+func (d Deprecation) IsDeprecated() bool {
+	return d != Deprecation{}
 }
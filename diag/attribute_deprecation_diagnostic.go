@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ DiagnosticWithPath = AttributeDeprecationDiagnostic{}
+
+// AttributeDeprecationDiagnostic is a warning severity diagnostic for a
+// configured attribute which is deprecated in favor of a replacement
+// attribute. The replacement is available as a path expression, so tooling
+// can suggest configuration migrations without parsing the detail.
+type AttributeDeprecationDiagnostic struct {
+	message     string
+	path        path.Path
+	replacement path.Expression
+}
+
+// Detail returns the diagnostic detail, which is the deprecation message
+// followed by the replacement attribute.
+func (d AttributeDeprecationDiagnostic) Detail() string {
+	return d.message + "\n\nReplacement Attribute: " + d.replacement.String()
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d AttributeDeprecationDiagnostic) Equal(other Diagnostic) bool {
+	o, ok := other.(AttributeDeprecationDiagnostic)
+
+	if !ok {
+		return false
+	}
+
+	return d.message == o.message && d.path.Equal(o.path) && d.replacement.Equal(o.replacement)
+}
+
+// Path returns the path of the deprecated attribute.
+func (d AttributeDeprecationDiagnostic) Path() path.Path {
+	return d.path
+}
+
+// ReplacementPathExpression returns the path expression of the replacement
+// attribute. The expression is resolved against the path of the deprecated
+// attribute, so relative steps are removed.
+func (d AttributeDeprecationDiagnostic) ReplacementPathExpression() path.Expression {
+	return d.replacement
+}
+
+// Severity returns the diagnostic severity.
+func (d AttributeDeprecationDiagnostic) Severity() Severity {
+	return SeverityWarning
+}
+
+// Summary returns the diagnostic summary.
+func (d AttributeDeprecationDiagnostic) Summary() string {
+	return "Attribute Deprecated"
+}
+
+// NewAttributeDeprecationDiagnostic returns a new warning severity
+// diagnostic for the deprecated attribute at the given path, with the given
+// replacement attribute path expression and deprecation message. The
+// replacement expression is merged with the path and resolved.
+func NewAttributeDeprecationDiagnostic(path path.Path, replacement path.Expression, message string) AttributeDeprecationDiagnostic {
+	return AttributeDeprecationDiagnostic{
+		message:     message,
+		path:        path,
+		replacement: path.Expression().Merge(replacement).Resolve(),
+	}
+}
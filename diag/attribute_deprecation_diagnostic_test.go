@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestAttributeDeprecationDiagnosticDetail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.AttributeDeprecationDiagnostic
+		expected string
+	}{
+		"root": {
+			diag: diag.NewAttributeDeprecationDiagnostic(
+				path.Root("test"),
+				path.MatchRoot("test_replacement"),
+				"Use test_replacement instead.",
+			),
+			expected: "Use test_replacement instead.\n\nReplacement Attribute: test_replacement",
+		},
+		"relative": {
+			diag: diag.NewAttributeDeprecationDiagnostic(
+				path.Root("test").AtListIndex(0).AtName("nested"),
+				path.MatchRelative().AtParent().AtName("nested_replacement"),
+				"Use nested_replacement instead.",
+			),
+			expected: "Use nested_replacement instead.\n\nReplacement Attribute: test[0].nested_replacement",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Detail()
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributeDeprecationDiagnosticEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.AttributeDeprecationDiagnostic
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("other"), "test message"),
+			other:    diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("other"), "test message"),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("other"), "test message"),
+			other:    nil,
+			expected: false,
+		},
+		"different-path": {
+			diag:     diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("other"), "test message"),
+			other:    diag.NewAttributeDeprecationDiagnostic(path.Root("different"), path.MatchRoot("other"), "test message"),
+			expected: false,
+		},
+		"different-replacement": {
+			diag:     diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("other"), "test message"),
+			other:    diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("different"), "test message"),
+			expected: false,
+		},
+		"different-message": {
+			diag:     diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("other"), "test message"),
+			other:    diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("other"), "different message"),
+			expected: false,
+		},
+		"different-type": {
+			diag:     diag.NewAttributeDeprecationDiagnostic(path.Root("test"), path.MatchRoot("other"), "test message"),
+			other:    diag.NewAttributeWarningDiagnostic(path.Root("test"), "Attribute Deprecated", "test message\n\nReplacement Attribute: other"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
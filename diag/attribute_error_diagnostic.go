@@ -14,3 +14,12 @@ func NewAttributeErrorDiagnostic(path path.Path, summary string, detail string)
 		path:       path,
 	}
 }
+
+// NewAttributeErrorDiagnosticForExpression returns a new error severity
+// diagnostic with the given summary, detail, and path expression.
+func NewAttributeErrorDiagnosticForExpression(expression path.Expression, summary string, detail string) DiagnosticWithPathExpression {
+	return withPathExpression{
+		Diagnostic:     NewErrorDiagnostic(summary, detail),
+		pathExpression: expression,
+	}
+}
@@ -14,3 +14,23 @@ func NewAttributeWarningDiagnostic(path path.Path, summary string, detail string
 		path:       path,
 	}
 }
+
+// NewAttributeWarningDiagnosticForExpression returns a new warning severity
+// diagnostic with the given summary, detail, and path expression.
+func NewAttributeWarningDiagnosticForExpression(expression path.Expression, summary string, detail string) DiagnosticWithPathExpression {
+	return withPathExpression{
+		Diagnostic:     NewWarningDiagnostic(summary, detail),
+		pathExpression: expression,
+	}
+}
+
+// NewAttributeWarningDiagnosticWithKey returns a new warning severity
+// diagnostic with the given path, key, summary, and detail. The key
+// classifies the warning, so practitioners can suppress it with
+// SuppressedWarnings.
+func NewAttributeWarningDiagnosticWithKey(path path.Path, key string, summary string, detail string) DiagnosticWithPath {
+	return withPath{
+		Diagnostic: NewWarningDiagnosticWithKey(key, summary, detail),
+		path:       path,
+	}
+}
@@ -51,3 +51,18 @@ type DiagnosticWithPath interface {
 	// supporting implementations such as Terraform CLI commands.
 	Path() path.Path
 }
+
+// DiagnosticWithPathExpression is a diagnostic associated with an attribute
+// path expression, which may match zero or more attribute paths.
+//
+// The framework expands these diagnostics into one DiagnosticWithPath per
+// matching attribute path in the current value where the value is available,
+// such as when returned from validation. If the expression does not match any
+// path, the diagnostic is returned without path information.
+type DiagnosticWithPathExpression interface {
+	Diagnostic
+
+	// PathExpression is the expression of attribute paths associated with the
+	// diagnostic.
+	PathExpression() path.Expression
+}
@@ -23,6 +23,26 @@ func (diags *Diagnostics) AddAttributeWarning(path path.Path, summary string, de
 	diags.Append(NewAttributeWarningDiagnostic(path, summary, detail))
 }
 
+// AddAttributeWarningWithKey adds a generic attribute warning diagnostic with
+// a key to the collection. Practitioners can suppress the warning by key.
+func (diags *Diagnostics) AddAttributeWarningWithKey(path path.Path, key string, summary string, detail string) {
+	diags.Append(NewAttributeWarningDiagnosticWithKey(path, key, summary, detail))
+}
+
+// AddAttributeErrorForExpression adds a generic attribute error diagnostic
+// with a path expression to the collection. The framework expands the
+// diagnostic into one diagnostic per matching attribute path where possible.
+func (diags *Diagnostics) AddAttributeErrorForExpression(expression path.Expression, summary string, detail string) {
+	diags.Append(NewAttributeErrorDiagnosticForExpression(expression, summary, detail))
+}
+
+// AddAttributeWarningForExpression adds a generic attribute warning diagnostic
+// with a path expression to the collection. The framework expands the
+// diagnostic into one diagnostic per matching attribute path where possible.
+func (diags *Diagnostics) AddAttributeWarningForExpression(expression path.Expression, summary string, detail string) {
+	diags.Append(NewAttributeWarningDiagnosticForExpression(expression, summary, detail))
+}
+
 // AddError adds a generic error diagnostic to the collection.
 func (diags *Diagnostics) AddError(summary string, detail string) {
 	diags.Append(NewErrorDiagnostic(summary, detail))
@@ -33,6 +53,12 @@ func (diags *Diagnostics) AddWarning(summary string, detail string) {
 	diags.Append(NewWarningDiagnostic(summary, detail))
 }
 
+// AddWarningWithKey adds a generic warning diagnostic with a key to the
+// collection. Practitioners can suppress the warning by key.
+func (diags *Diagnostics) AddWarningWithKey(key string, summary string, detail string) {
+	diags.Append(NewWarningDiagnosticWithKey(key, summary, detail))
+}
+
 // Append adds non-empty and non-duplicate diagnostics to the collection.
 func (diags *Diagnostics) Append(in ...Diagnostic) {
 	for _, diag := range in {
@@ -47,6 +73,20 @@ func (diags *Diagnostics) Append(in ...Diagnostic) {
 	}
 }
 
+// AsError returns a DiagnosticsError containing all diagnostics in the
+// collection, if the collection has an error severity Diagnostic. Otherwise,
+// nil is returned. Use the FromError function to convert the error back into
+// Diagnostics.
+func (diags Diagnostics) AsError() error {
+	if !diags.HasError() {
+		return nil
+	}
+
+	return DiagnosticsError{
+		diagnostics: diags,
+	}
+}
+
 // Contains returns true if the collection contains an equal Diagnostic.
 func (diags Diagnostics) Contains(in Diagnostic) bool {
 	for _, diag := range diags {
@@ -74,6 +74,130 @@ func TestDiagnosticsAddAttributeError(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsAddAttributeErrorForExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags      diag.Diagnostics
+		expression path.Expression
+		summary    string
+		detail     string
+		expected   diag.Diagnostics
+	}{
+		"nil-add": {
+			diags:      nil,
+			expression: path.MatchRoot("test"),
+			summary:    "one summary",
+			detail:     "one detail",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("test"), "one summary", "one detail"),
+			},
+		},
+		"add": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+			},
+			expression: path.MatchRoot("test"),
+			summary:    "three summary",
+			detail:     "three detail",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("test"), "three summary", "three detail"),
+			},
+		},
+		"duplicate": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+			},
+			expression: path.MatchRoot("test"),
+			summary:    "one summary",
+			detail:     "one detail",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.diags.AddAttributeErrorForExpression(tc.expression, tc.summary, tc.detail)
+
+			if diff := cmp.Diff(tc.diags, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsAddAttributeWarningForExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags      diag.Diagnostics
+		expression path.Expression
+		summary    string
+		detail     string
+		expected   diag.Diagnostics
+	}{
+		"nil-add": {
+			diags:      nil,
+			expression: path.MatchRoot("test"),
+			summary:    "one summary",
+			detail:     "one detail",
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnosticForExpression(path.MatchRoot("test"), "one summary", "one detail"),
+			},
+		},
+		"add": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+			},
+			expression: path.MatchRoot("test"),
+			summary:    "three summary",
+			detail:     "three detail",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+				diag.NewAttributeWarningDiagnosticForExpression(path.MatchRoot("test"), "three summary", "three detail"),
+			},
+		},
+		"duplicate": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnosticForExpression(path.MatchRoot("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+			},
+			expression: path.MatchRoot("test"),
+			summary:    "one summary",
+			detail:     "one detail",
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnosticForExpression(path.MatchRoot("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.diags.AddAttributeWarningForExpression(tc.expression, tc.summary, tc.detail)
+
+			if diff := cmp.Diff(tc.diags, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsAddAttributeWarning(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ error = DiagnosticError{}
+	_ error = DiagnosticsError{}
+)
+
+// DiagnosticError is a Go error representing a single Diagnostic. It is
+// returned by unwrapping a DiagnosticsError.
+type DiagnosticError struct {
+	diagnostic Diagnostic
+}
+
+// Diagnostic returns the underlying Diagnostic.
+func (e DiagnosticError) Diagnostic() Diagnostic {
+	return e.diagnostic
+}
+
+// Error returns the diagnostic severity, path (if any), summary, and detail.
+func (e DiagnosticError) Error() string {
+	if e.diagnostic == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString(e.diagnostic.Severity().String())
+	b.WriteString(": ")
+
+	if diagWithPath, ok := e.diagnostic.(DiagnosticWithPath); ok && !diagWithPath.Path().Equal(path.Empty()) {
+		b.WriteString(diagWithPath.Path().String())
+		b.WriteString(": ")
+	}
+
+	b.WriteString(e.diagnostic.Summary())
+
+	if detail := e.diagnostic.Detail(); detail != "" {
+		b.WriteString(": ")
+		b.WriteString(detail)
+	}
+
+	return b.String()
+}
+
+// DiagnosticsError is a Go error representing a collection of Diagnostics,
+// such as to pass diagnostics through logic that can only return errors. Use
+// the Diagnostics type AsError method to create a DiagnosticsError and the
+// FromError function to convert it back into Diagnostics.
+type DiagnosticsError struct {
+	diagnostics Diagnostics
+}
+
+// Diagnostics returns the underlying Diagnostics.
+func (e DiagnosticsError) Diagnostics() Diagnostics {
+	return e.diagnostics
+}
+
+// Error returns each diagnostic error message, separated by newlines.
+func (e DiagnosticsError) Error() string {
+	messages := make([]string, 0, len(e.diagnostics))
+
+	for _, diagnostic := range e.diagnostics {
+		messages = append(messages, DiagnosticError{diagnostic: diagnostic}.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns a DiagnosticError for each underlying Diagnostic, which
+// enables errors.As to find individual diagnostics.
+func (e DiagnosticsError) Unwrap() []error {
+	errs := make([]error, 0, len(e.diagnostics))
+
+	for _, diagnostic := range e.diagnostics {
+		errs = append(errs, DiagnosticError{diagnostic: diagnostic})
+	}
+
+	return errs
+}
+
+// FromError returns Diagnostics from a Go error. If the error is or wraps a
+// DiagnosticsError, its underlying Diagnostics are returned. If the error is
+// or wraps a DiagnosticError, its underlying Diagnostic is returned.
+// Otherwise, a single error diagnostic is returned with the given summary
+// and the error message as its detail. A nil error returns nil.
+func FromError(summary string, err error) Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	var diagsErr DiagnosticsError
+
+	if errors.As(err, &diagsErr) {
+		return diagsErr.Diagnostics()
+	}
+
+	var diagErr DiagnosticError
+
+	if errors.As(err, &diagErr) && diagErr.Diagnostic() != nil {
+		return Diagnostics{diagErr.Diagnostic()}
+	}
+
+	return Diagnostics{
+		NewErrorDiagnostic(summary, err.Error()),
+	}
+}
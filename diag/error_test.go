@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestDiagnosticsAsError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags           diag.Diagnostics
+		expectedNil     bool
+		expectedMessage string
+	}{
+		"nil": {
+			diags:       nil,
+			expectedNil: true,
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+			},
+			expectedNil: true,
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+			expectedMessage: "Error: error summary: error detail",
+		},
+		"error-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "error summary", "error detail"),
+			},
+			expectedMessage: "Error: test[0]: error summary: error detail",
+		},
+		"error-no-detail": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", ""),
+			},
+			expectedMessage: "Error: error summary",
+		},
+		"errors-and-warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+			expectedMessage: "Warning: warning summary: warning detail\nError: error summary: error detail",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.diags.AsError()
+
+			if tc.expectedNil {
+				if err != nil {
+					t.Fatalf("expected nil error, got: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			if diff := cmp.Diff(err.Error(), tc.expectedMessage); diff != "" {
+				t.Errorf("Unexpected error message (+wanted, -got): %s", diff)
+			}
+
+			// Round trip through wrapping.
+			got := diag.FromError("unused summary", fmt.Errorf("wrapped: %w", err))
+
+			if diff := cmp.Diff(got, tc.diags); diff != "" {
+				t.Errorf("Unexpected round trip diagnostics (+wanted, -got): %s", diff)
+			}
+
+			var diagErr diag.DiagnosticError
+
+			if !errors.As(err, &diagErr) {
+				t.Fatal("expected errors.As to find DiagnosticError")
+			}
+
+			if diff := cmp.Diff(diagErr.Diagnostic(), tc.diags[0]); diff != "" {
+				t.Errorf("Unexpected unwrapped diagnostic (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			err:      nil,
+			expected: nil,
+		},
+		"error": {
+			err: errors.New("test error"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test error"),
+			},
+		},
+		"DiagnosticsError": {
+			err: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "error summary", "error detail"),
+			}.AsError(),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "error summary", "error detail"),
+			},
+		},
+		"DiagnosticsError-joined": {
+			err: errors.Join(
+				errors.New("other error"),
+				diag.Diagnostics{
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				}.AsError(),
+			),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.FromError("test summary", tc.err)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
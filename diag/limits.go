@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"fmt"
+)
+
+// Limits configures the maximum number of diagnostics returned to Terraform
+// in a single response, such as when validation returns a diagnostic for each
+// of thousands of collection elements. Omitted diagnostics are replaced with
+// summary diagnostics containing counts, so the Terraform UI is not
+// overwhelmed. Register limits with the provider.ProviderWithDiagnosticLimits
+// interface.
+//
+// Error diagnostics are never omitted without an error diagnostic remaining,
+// so a response with errors always fails.
+type Limits struct {
+	// MaxPerSummary is the maximum number of diagnostics with the same
+	// severity and summary. Additional diagnostics are replaced with one
+	// diagnostic of the same severity and summary, whose detail contains
+	// the number of omitted diagnostics. Zero means no limit.
+	MaxPerSummary int
+
+	// MaxTotal is the maximum number of diagnostics, after applying
+	// MaxPerSummary. Additional diagnostics are replaced with one "Too Many
+	// Diagnostics" diagnostic, whose detail contains the number of omitted
+	// errors and warnings. Zero means no limit.
+	MaxTotal int
+}
+
+// Apply returns the diagnostics with the limits applied. The order of the
+// remaining diagnostics is preserved and any summary diagnostics are
+// appended.
+func (l Limits) Apply(diags Diagnostics) Diagnostics {
+	if l.MaxPerSummary > 0 {
+		diags = l.applyMaxPerSummary(diags)
+	}
+
+	if l.MaxTotal > 0 {
+		diags = l.applyMaxTotal(diags)
+	}
+
+	return diags
+}
+
+// limitsGroupKey is the grouping of diagnostics for MaxPerSummary.
+type limitsGroupKey struct {
+	severity Severity
+	summary  string
+}
+
+func (l Limits) applyMaxPerSummary(diags Diagnostics) Diagnostics {
+	var groupOrder []limitsGroupKey
+
+	counts := make(map[limitsGroupKey]int)
+	result := make(Diagnostics, 0, len(diags))
+
+	for _, diagnostic := range diags {
+		key := limitsGroupKey{
+			severity: diagnostic.Severity(),
+			summary:  diagnostic.Summary(),
+		}
+
+		counts[key]++
+
+		if counts[key] == l.MaxPerSummary+1 {
+			groupOrder = append(groupOrder, key)
+		}
+
+		if counts[key] <= l.MaxPerSummary {
+			result = append(result, diagnostic)
+		}
+	}
+
+	for _, key := range groupOrder {
+		detail := fmt.Sprintf("%d additional diagnostics with this summary were omitted to limit output.", counts[key]-l.MaxPerSummary)
+
+		result = append(result, limitsDiagnostic(key.severity, key.summary, detail))
+	}
+
+	return result
+}
+
+func (l Limits) applyMaxTotal(diags Diagnostics) Diagnostics {
+	if len(diags) <= l.MaxTotal {
+		return diags
+	}
+
+	result := make(Diagnostics, 0, l.MaxTotal+1)
+	result = append(result, diags[:l.MaxTotal]...)
+
+	omitted := diags[l.MaxTotal:]
+	severity := SeverityWarning
+
+	if omitted.HasError() {
+		severity = SeverityError
+	}
+
+	detail := fmt.Sprintf(
+		"%d additional diagnostics were omitted to limit output, including %d errors and %d warnings.",
+		len(omitted), omitted.ErrorsCount(), omitted.WarningsCount(),
+	)
+
+	return append(result, limitsDiagnostic(severity, "Too Many Diagnostics", detail))
+}
+
+func limitsDiagnostic(severity Severity, summary string, detail string) Diagnostic {
+	if severity == SeverityError {
+		return NewErrorDiagnostic(summary, detail)
+	}
+
+	return NewWarningDiagnostic(summary, detail)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestLimitsApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		limits   diag.Limits
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"no-limits": {
+			limits: diag.Limits{},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("one", "detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("one", "detail"),
+			},
+		},
+		"max-per-summary-under-limit": {
+			limits: diag.Limits{
+				MaxPerSummary: 2,
+			},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail 1"),
+				diag.NewErrorDiagnostic("one", "detail 2"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail 1"),
+				diag.NewErrorDiagnostic("one", "detail 2"),
+			},
+		},
+		"max-per-summary": {
+			limits: diag.Limits{
+				MaxPerSummary: 1,
+			},
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "Invalid Attribute Value", "detail 1"),
+				diag.NewWarningDiagnostic("Invalid Attribute Value", "warning detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(1), "Invalid Attribute Value", "detail 2"),
+				diag.NewErrorDiagnostic("other", "other detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(2), "Invalid Attribute Value", "detail 3"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "Invalid Attribute Value", "detail 1"),
+				diag.NewWarningDiagnostic("Invalid Attribute Value", "warning detail"),
+				diag.NewErrorDiagnostic("other", "other detail"),
+				diag.NewErrorDiagnostic("Invalid Attribute Value", "2 additional diagnostics with this summary were omitted to limit output."),
+			},
+		},
+		"max-total-under-limit": {
+			limits: diag.Limits{
+				MaxTotal: 2,
+			},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewWarningDiagnostic("two", "detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewWarningDiagnostic("two", "detail"),
+			},
+		},
+		"max-total-omitted-errors": {
+			limits: diag.Limits{
+				MaxTotal: 1,
+			},
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("two", "detail"),
+				diag.NewWarningDiagnostic("three", "detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("Too Many Diagnostics", "2 additional diagnostics were omitted to limit output, including 1 errors and 1 warnings."),
+			},
+		},
+		"max-total-omitted-warnings": {
+			limits: diag.Limits{
+				MaxTotal: 1,
+			},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewWarningDiagnostic("two", "detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewWarningDiagnostic("Too Many Diagnostics", "1 additional diagnostics were omitted to limit output, including 0 errors and 1 warnings."),
+			},
+		},
+		"max-per-summary-and-max-total": {
+			limits: diag.Limits{
+				MaxPerSummary: 1,
+				MaxTotal:      2,
+			},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("two", "detail"),
+				diag.NewErrorDiagnostic("three", "detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewErrorDiagnostic("two", "detail"),
+				diag.NewErrorDiagnostic("Too Many Diagnostics", "2 additional diagnostics were omitted to limit output, including 2 errors and 0 warnings."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.limits.Apply(testCase.diags)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
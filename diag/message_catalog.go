@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"strings"
+	"text/template"
+)
+
+// MessageCatalog replaces diagnostic messages before they are returned to
+// Terraform, such as to translate framework-emitted diagnostics for
+// practitioners who do not read English. Keys are the original diagnostic
+// summaries, for example "Invalid Attribute Value", and values describe the
+// replacement message.
+//
+// The same catalog applies to all diagnostics, whether they are emitted by
+// the framework or by provider code, so overrides are consistent across the
+// provider. Register a catalog with the
+// provider.ProviderWithDiagnosticMessageCatalog interface.
+type MessageCatalog map[string]Message
+
+// Message is a replacement diagnostic message in a MessageCatalog. Each field
+// is a Go text/template which is executed with MessageTemplateData. An empty
+// field keeps the original value.
+type Message struct {
+	// Summary is the template for the replacement summary.
+	Summary string
+
+	// Detail is the template for the replacement detail. Use {{.Detail}} to
+	// include the original detail, which often contains values or errors
+	// only known when the diagnostic was created.
+	Detail string
+}
+
+// MessageTemplateData is the data available to Message templates.
+type MessageTemplateData struct {
+	// Summary is the original diagnostic summary.
+	Summary string
+
+	// Detail is the original diagnostic detail.
+	Detail string
+
+	// Path is the string representation of the diagnostic attribute path,
+	// if any.
+	Path string
+
+	// Severity is the string representation of the diagnostic severity,
+	// such as "Error" or "Warning".
+	Severity string
+}
+
+// Apply returns the diagnostics with messages replaced according to the
+// catalog. Severity and attribute path information is preserved. Diagnostics
+// without a matching catalog entry, or where a template cannot be executed,
+// are returned unmodified.
+func (c MessageCatalog) Apply(diags Diagnostics) Diagnostics {
+	if len(c) == 0 || len(diags) == 0 {
+		return diags
+	}
+
+	result := make(Diagnostics, 0, len(diags))
+
+	for _, diagnostic := range diags {
+		result = append(result, c.apply(diagnostic))
+	}
+
+	return result
+}
+
+func (c MessageCatalog) apply(diagnostic Diagnostic) Diagnostic {
+	message, ok := c[diagnostic.Summary()]
+
+	if !ok {
+		return diagnostic
+	}
+
+	data := MessageTemplateData{
+		Summary:  diagnostic.Summary(),
+		Detail:   diagnostic.Detail(),
+		Severity: diagnostic.Severity().String(),
+	}
+
+	if diagWithPath, ok := diagnostic.(DiagnosticWithPath); ok {
+		data.Path = diagWithPath.Path().String()
+	}
+
+	summary, err := executeMessageTemplate(message.Summary, data.Summary, data)
+
+	if err != nil {
+		return diagnostic
+	}
+
+	detail, err := executeMessageTemplate(message.Detail, data.Detail, data)
+
+	if err != nil {
+		return diagnostic
+	}
+
+	var result Diagnostic
+
+	switch diagnostic.Severity() {
+	case SeverityError:
+		result = NewErrorDiagnostic(summary, detail)
+	case SeverityWarning:
+		result = NewWarningDiagnostic(summary, detail)
+	default:
+		return diagnostic
+	}
+
+	switch d := diagnostic.(type) {
+	case DiagnosticWithPath:
+		result = WithPath(d.Path(), result)
+	case DiagnosticWithPathExpression:
+		result = WithPathExpression(d.PathExpression(), result)
+	}
+
+	return result
+}
+
+// executeMessageTemplate returns the executed template text, or the original
+// value if the template text is empty.
+func executeMessageTemplate(text string, original string, data MessageTemplateData) (string, error) {
+	if text == "" {
+		return original, nil
+	}
+
+	tmpl, err := template.New("message").Parse(text)
+
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
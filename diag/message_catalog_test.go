@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestMessageCatalogApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		catalog  diag.MessageCatalog
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"nil-catalog": {
+			catalog: nil,
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Value", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Value", "original detail"),
+			},
+		},
+		"no-match": {
+			catalog: diag.MessageCatalog{
+				"Other Summary": {
+					Summary: "Autre résumé",
+				},
+			},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Value", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Value", "original detail"),
+			},
+		},
+		"summary-only": {
+			catalog: diag.MessageCatalog{
+				"Invalid Attribute Value": {
+					Summary: "Valeur d'attribut invalide",
+				},
+			},
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Invalid Attribute Value", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Valeur d'attribut invalide", "original detail"),
+			},
+		},
+		"detail-template-path": {
+			catalog: diag.MessageCatalog{
+				"Invalid Attribute Value": {
+					Summary: "Valeur d'attribut invalide",
+					Detail:  "{{.Severity}} pour {{.Path}}: {{.Detail}}",
+				},
+			},
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Invalid Attribute Value", "original detail"),
+				diag.NewErrorDiagnostic("Other Summary", "other detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Valeur d'attribut invalide", "Error pour test: original detail"),
+				diag.NewErrorDiagnostic("Other Summary", "other detail"),
+			},
+		},
+		"path-expression": {
+			catalog: diag.MessageCatalog{
+				"Invalid Attribute Value": {
+					Summary: "Valeur d'attribut invalide",
+				},
+			},
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("test"), "Invalid Attribute Value", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("test"), "Valeur d'attribut invalide", "original detail"),
+			},
+		},
+		"invalid-template": {
+			catalog: diag.MessageCatalog{
+				"Invalid Attribute Value": {
+					Detail: "{{.Detail",
+				},
+			},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Value", "original detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Attribute Value", "original detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.catalog.Apply(tc.diags)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
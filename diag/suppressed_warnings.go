@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+// SuppressedWarnings is a collection of warning keys which are removed from
+// diagnostics before they are returned to Terraform. Providers classify
+// warnings with keys, such as with the NewWarningDiagnosticWithKey function,
+// and practitioners choose which keys to suppress in the provider
+// configuration, such as with the provider/schema package
+// SuppressWarningsAttribute function. Set the collection in the
+// provider.ConfigureResponse type SuppressedWarnings field.
+//
+// Error diagnostics and warnings without a key are never suppressed.
+type SuppressedWarnings []string
+
+// Apply returns the diagnostics without warnings whose key is in the
+// collection. The order of the remaining diagnostics is preserved.
+func (s SuppressedWarnings) Apply(diags Diagnostics) Diagnostics {
+	if len(s) == 0 || len(diags) == 0 {
+		return diags
+	}
+
+	keys := make(map[string]struct{}, len(s))
+
+	for _, key := range s {
+		keys[key] = struct{}{}
+	}
+
+	result := make(Diagnostics, 0, len(diags))
+
+	for _, diagnostic := range diags {
+		if _, ok := keys[warningKey(diagnostic)]; ok {
+			continue
+		}
+
+		result = append(result, diagnostic)
+	}
+
+	return result
+}
+
+// warningKey returns the key of a warning diagnostic, including diagnostics
+// wrapped with path or path expression information. An empty string is
+// returned for other diagnostics.
+func warningKey(diagnostic Diagnostic) string {
+	switch d := diagnostic.(type) {
+	case WarningDiagnostic:
+		return d.Key()
+	case withPath:
+		return warningKey(d.Diagnostic)
+	case withPathExpression:
+		return warningKey(d.Diagnostic)
+	default:
+		return ""
+	}
+}
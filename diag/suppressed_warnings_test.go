@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestSuppressedWarningsApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suppressed diag.SuppressedWarnings
+		diags      diag.Diagnostics
+		expected   diag.Diagnostics
+	}{
+		"no-suppressed-warnings": {
+			suppressed: nil,
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnosticWithKey("test_key", "one", "detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnosticWithKey("test_key", "one", "detail"),
+			},
+		},
+		"no-diagnostics": {
+			suppressed: diag.SuppressedWarnings{"test_key"},
+			diags:      nil,
+			expected:   nil,
+		},
+		"suppressed": {
+			suppressed: diag.SuppressedWarnings{"test_key"},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewWarningDiagnosticWithKey("test_key", "two", "detail"),
+				diag.NewWarningDiagnosticWithKey("other_key", "three", "detail"),
+				diag.NewWarningDiagnostic("four", "detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one", "detail"),
+				diag.NewWarningDiagnosticWithKey("other_key", "three", "detail"),
+				diag.NewWarningDiagnostic("four", "detail"),
+			},
+		},
+		"suppressed-attribute": {
+			suppressed: diag.SuppressedWarnings{"test_key"},
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnosticWithKey(path.Root("test"), "test_key", "one", "detail"),
+				diag.WithPathExpression(path.MatchRoot("test"), diag.NewWarningDiagnosticWithKey("test_key", "two", "detail")),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "three", "detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "three", "detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.suppressed.Apply(testCase.diags)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ var _ Diagnostic = WarningDiagnostic{}
 // WarningDiagnostic is a generic diagnostic with warning severity.
 type WarningDiagnostic struct {
 	detail  string
+	key     string
 	summary string
 }
 
@@ -24,7 +25,13 @@ func (d WarningDiagnostic) Equal(other Diagnostic) bool {
 		return false
 	}
 
-	return wd.Summary() == d.Summary() && wd.Detail() == d.Detail()
+	return wd.Summary() == d.Summary() && wd.Detail() == d.Detail() && wd.Key() == d.Key()
+}
+
+// Key returns the diagnostic key, which practitioners can use to suppress the
+// warning. An empty key means the warning cannot be suppressed.
+func (d WarningDiagnostic) Key() string {
+	return d.key
 }
 
 // Severity returns the diagnostic severity.
@@ -44,3 +51,14 @@ func NewWarningDiagnostic(summary string, detail string) WarningDiagnostic {
 		summary: summary,
 	}
 }
+
+// NewWarningDiagnosticWithKey returns a new warning severity diagnostic with
+// the given key, summary, and detail. The key classifies the warning, so
+// practitioners can suppress it with SuppressedWarnings.
+func NewWarningDiagnosticWithKey(key string, summary string, detail string) WarningDiagnostic {
+	return WarningDiagnostic{
+		detail:  detail,
+		key:     key,
+		summary: summary,
+	}
+}
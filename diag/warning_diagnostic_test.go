@@ -37,6 +37,16 @@ func TestWarningDiagnosticEqual(t *testing.T) {
 			other:    diag.NewWarningDiagnostic("different summary", "test detail"),
 			expected: false,
 		},
+		"different-key": {
+			diag:     diag.NewWarningDiagnosticWithKey("test_key", "test summary", "test detail"),
+			other:    diag.NewWarningDiagnostic("test summary", "test detail"),
+			expected: false,
+		},
+		"matching-key": {
+			diag:     diag.NewWarningDiagnosticWithKey("test_key", "test summary", "test detail"),
+			other:    diag.NewWarningDiagnosticWithKey("test_key", "test summary", "test detail"),
+			expected: true,
+		},
 		"different-type": {
 			diag:     diag.NewWarningDiagnostic("test summary", "test detail"),
 			other:    diag.NewErrorDiagnostic("test summary", "test detail"),
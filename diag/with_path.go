@@ -41,7 +41,12 @@ func (d withPath) Path() path.Path {
 }
 
 // WithPath wraps a diagnostic with path information or overwrites the path.
+// Any existing path expression information is removed.
 func WithPath(path path.Path, d Diagnostic) DiagnosticWithPath {
+	if wpe, ok := d.(withPathExpression); ok {
+		d = wpe.Diagnostic
+	}
+
 	wp, ok := d.(withPath)
 
 	if !ok {
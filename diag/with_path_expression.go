@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ DiagnosticWithPathExpression = withPathExpression{}
+
+// withPathExpression wraps a diagnostic with path expression information.
+type withPathExpression struct {
+	Diagnostic
+
+	pathExpression path.Expression
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d withPathExpression) Equal(other Diagnostic) bool {
+	o, ok := other.(withPathExpression)
+
+	if !ok {
+		return false
+	}
+
+	if !d.PathExpression().Equal(o.PathExpression()) {
+		return false
+	}
+
+	if d.Diagnostic == nil {
+		return d.Diagnostic == o.Diagnostic
+	}
+
+	return d.Diagnostic.Equal(o.Diagnostic)
+}
+
+// PathExpression returns the diagnostic path expression.
+func (d withPathExpression) PathExpression() path.Expression {
+	return d.pathExpression
+}
+
+// WithPathExpression wraps a diagnostic with path expression information or
+// overwrites the path expression. Any existing path information is removed.
+func WithPathExpression(expression path.Expression, d Diagnostic) DiagnosticWithPathExpression {
+	switch wd := d.(type) {
+	case withPath:
+		d = wd.Diagnostic
+	case withPathExpression:
+		d = wd.Diagnostic
+	}
+
+	return withPathExpression{
+		Diagnostic:     d,
+		pathExpression: expression,
+	}
+}
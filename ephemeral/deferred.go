@@ -27,6 +27,13 @@ const (
 type Deferred struct {
 	// Reason is the reason for deferring the change.
 	Reason DeferredReason
+
+	// Explanation is an optional human-readable explanation of why the
+	// change was deferred, such as which remote dependency is not yet
+	// available. When set, the framework logs the explanation and returns it
+	// in a warning diagnostic, so practitioners can determine why the change
+	// was deferred in large plans.
+	Explanation string
 }
 
 // DeferredReason represents different reasons for deferring a change.
@@ -5,9 +5,26 @@ package ephemeral
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fixit"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
+// ValidateConfigClientCapabilities allows Terraform to publish information
+// regarding optionally supported protocol features for the ValidateEphemeralResourceConfig RPC,
+// such as forward-compatible Terraform behavior changes.
+//
+// Terraform does not send client capabilities with the ValidateEphemeralResourceConfig
+// RPC, so these are the capabilities sent when Terraform configured the
+// provider. They are only populated when the provider has been configured.
+type ValidateConfigClientCapabilities struct {
+	// DeferralAllowed indicates whether the Terraform client initiating
+	// the request allows a deferral response.
+	//
+	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
+	// to change or break without warning. It is not protected by version compatibility guarantees.
+	DeferralAllowed bool
+}
+
 // ValidateConfigRequest represents a request to validate the
 // configuration of an ephemeral resource. An instance of this request struct is
 // supplied as an argument to the EphemeralResource ValidateConfig receiver method
@@ -19,6 +36,24 @@ type ValidateConfigRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ValidateEphemeralResourceConfig RPC, such as forward-compatible Terraform behavior
+	// changes. Refer to the ValidateConfigClientCapabilities type
+	// documentation for when these are populated.
+	ClientCapabilities ValidateConfigClientCapabilities
+
+	// TerraformVersion is the version of Terraform executing the request,
+	// which can be used to return an error diagnostic when the configuration
+	// requires functionality the running Terraform version does not support.
+	//
+	// Terraform does not send its version with the ValidateEphemeralResourceConfig RPC,
+	// so this is the version sent when Terraform configured the provider.
+	// Terraform configures the provider before validating ephemeral resource
+	// configurations during plan and apply operations, but not during
+	// terraform validate, so this is empty when the provider has not been
+	// configured. Skip version checks when this is empty.
+	TerraformVersion string
 }
 
 // ValidateConfigResponse represents a response to a
@@ -30,4 +65,10 @@ type ValidateConfigResponse struct {
 	// configuration. An empty slice indicates success, with no warnings or
 	// errors generated.
 	Diagnostics diag.Diagnostics
+
+	// SuggestedChanges are machine-readable configuration changes which
+	// would resolve the validation issues reported in Diagnostics. They are
+	// not sent to Terraform, but enable tests and tooling to verify the
+	// suggested remediation rather than only the diagnostic text.
+	SuggestedChanges fixit.SuggestedChanges
 }
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fixit contains the machine-readable configuration remediations
+// which configuration validation can suggest in addition to diagnostics,
+// such as in the resource.ValidateConfigResponse type SuggestedChanges field.
+//
+// Terraform does not currently support receiving suggested changes, so they
+// are not sent across the protocol. They enable unit testing of validators
+// to verify the remediation itself, rather than only diagnostic text, and
+// enable future tooling to apply the remediation.
+package fixit
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fixit
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// SuggestedChange is a suggested configuration value for an attribute which
+// would resolve a validation issue.
+type SuggestedChange struct {
+	// Path is the path of the attribute to change.
+	Path path.Path
+
+	// Value is the proposed configuration value of the attribute. A null
+	// value suggests removing the attribute from the configuration.
+	Value attr.Value
+}
+
+// Equal returns true if the other suggested change has an equal path and
+// value.
+func (c SuggestedChange) Equal(other SuggestedChange) bool {
+	if !c.Path.Equal(other.Path) {
+		return false
+	}
+
+	if c.Value == nil {
+		return other.Value == nil
+	}
+
+	return c.Value.Equal(other.Value)
+}
+
+// SuggestedChanges is a collection of SuggestedChange.
+type SuggestedChanges []SuggestedChange
+
+// Append adds the suggested changes to the collection.
+func (c *SuggestedChanges) Append(changes ...SuggestedChange) {
+	*c = append(*c, changes...)
+}
+
+// Equal returns true if the other collection contains equal suggested
+// changes in the same order.
+func (c SuggestedChanges) Equal(other SuggestedChanges) bool {
+	if len(c) != len(other) {
+		return false
+	}
+
+	for i, change := range c {
+		if !change.Equal(other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fixit_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/fixit"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuggestedChangesEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		changes  fixit.SuggestedChanges
+		other    fixit.SuggestedChanges
+		expected bool
+	}{
+		"nil": {
+			changes:  nil,
+			other:    nil,
+			expected: true,
+		},
+		"matching": {
+			changes: fixit.SuggestedChanges{
+				{Path: path.Root("test"), Value: types.StringValue("test-value")},
+			},
+			other: fixit.SuggestedChanges{
+				{Path: path.Root("test"), Value: types.StringValue("test-value")},
+			},
+			expected: true,
+		},
+		"different-length": {
+			changes: fixit.SuggestedChanges{
+				{Path: path.Root("test"), Value: types.StringValue("test-value")},
+			},
+			other:    nil,
+			expected: false,
+		},
+		"different-path": {
+			changes: fixit.SuggestedChanges{
+				{Path: path.Root("test"), Value: types.StringValue("test-value")},
+			},
+			other: fixit.SuggestedChanges{
+				{Path: path.Root("other"), Value: types.StringValue("test-value")},
+			},
+			expected: false,
+		},
+		"different-value": {
+			changes: fixit.SuggestedChanges{
+				{Path: path.Root("test"), Value: types.StringValue("test-value")},
+			},
+			other: fixit.SuggestedChanges{
+				{Path: path.Root("test"), Value: types.StringNull()},
+			},
+			expected: false,
+		},
+		"nil-value": {
+			changes: fixit.SuggestedChanges{
+				{Path: path.Root("test")},
+			},
+			other: fixit.SuggestedChanges{
+				{Path: path.Root("test"), Value: types.StringNull()},
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.changes.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
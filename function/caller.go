@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Caller calls the provider-defined functions of the provider from other
+// provider logic, such as schema validators and defaults. This enables logic,
+// such as value normalization, to be implemented once as a function and
+// reused both in the Terraform configuration language and within the
+// provider.
+//
+// The framework provides a Caller in validator and default requests. Only
+// functions with a Pure definition can be called, since validators and
+// defaults must always return the same result for the same values.
+type Caller interface {
+	// Call runs the provider-defined function with the given name and
+	// arguments, returning the result value of the function.
+	//
+	// Each argument must be of the parameter type, or a type which converts
+	// into the parameter type, such as a base type of a custom parameter
+	// type. Arguments after the parameters are passed to the variadic
+	// parameter, if defined. Parameter validators are not run, as the
+	// arguments are determined by the provider rather than practitioners.
+	//
+	// A function error is returned if the function is not found, is not
+	// pure, the arguments do not match the function definition, or the
+	// function itself returns an error.
+	Call(ctx context.Context, name string, arguments ...attr.Value) (attr.Value, *FuncError)
+}
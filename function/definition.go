@@ -46,6 +46,13 @@ type Definition struct {
 	// summary is automatically set to "Function Deprecated" along with
 	// configuration source file and line information.
 	DeprecationMessage string
+
+	// Pure indicates that the function always returns the same result for the
+	// same arguments and has no side effects. When enabled, the framework
+	// caches successful results per argument values for the lifetime of the
+	// provider server and does not call the Function type Run method again for
+	// repeated calls with equal arguments.
+	Pure bool
 }
 
 // ValidateImplementation contains logic for validating the provider-defined
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// RunHook is a provider-defined type which the framework calls before and
+// after each function call, such as to record telemetry for functions that are
+// called frequently or are expensive to run. Register hooks with the
+// provider.ProviderWithFunctionRunHooks interface.
+//
+// Hooks never receive argument values, only metadata about each argument, so
+// sensitive data passed to functions is not exposed to telemetry systems.
+type RunHook interface {
+	// BeforeRun is called before the function logic is run.
+	BeforeRun(context.Context, BeforeRunHookRequest)
+
+	// AfterRun is called after the function logic is run, or after a cached
+	// result is used for a function with a Pure definition.
+	AfterRun(context.Context, AfterRunHookRequest)
+}
+
+// BeforeRunHookRequest represents a request to the RunHook type BeforeRun
+// method.
+type BeforeRunHookRequest struct {
+	// FunctionName is the name of the function being called.
+	FunctionName string
+
+	// Arguments is the metadata for each argument, in position order. Any
+	// variadic parameter arguments are represented as a single final tuple
+	// argument.
+	Arguments []RunHookArgument
+}
+
+// AfterRunHookRequest represents a request to the RunHook type AfterRun
+// method.
+type AfterRunHookRequest struct {
+	// FunctionName is the name of the function being called.
+	FunctionName string
+
+	// Arguments is the metadata for each argument, in position order. Any
+	// variadic parameter arguments are represented as a single final tuple
+	// argument.
+	Arguments []RunHookArgument
+
+	// Cached is true if the result was returned from the framework cache of
+	// a function with a Pure definition instead of calling Run.
+	Cached bool
+
+	// Duration is the time spent running the function logic.
+	Duration time.Duration
+
+	// Error is the function error returned by the function call, if any.
+	Error *FuncError
+}
+
+// RunHookArgument is the metadata of a single function argument. The argument
+// value itself is intentionally omitted.
+type RunHookArgument struct {
+	// Type is the data type of the argument.
+	Type attr.Type
+
+	// IsNull is true if the argument value is null.
+	IsNull bool
+
+	// IsUnknown is true if the argument value is unknown.
+	IsUnknown bool
+
+	// Size is the number of bytes in a known string value or the number of
+	// elements or attributes in a known collection, object, or tuple value.
+	// It is zero for all other values.
+	Size int
+}
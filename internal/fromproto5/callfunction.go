@@ -22,6 +22,7 @@ func CallFunctionRequest(ctx context.Context, proto *tfprotov5.CallFunctionReque
 	fw := &fwserver.CallFunctionRequest{
 		Function:           function,
 		FunctionDefinition: functionDefinition,
+		FunctionName:       proto.Name,
 	}
 
 	arguments, funcError := ArgumentsData(ctx, proto.Arguments, functionDefinition)
@@ -63,6 +63,7 @@ func TestCallFunctionRequest(t *testing.T) {
 					},
 					Return: function.StringReturn{},
 				},
+				FunctionName: "testfunction",
 			},
 		},
 		"name": {
@@ -76,6 +77,7 @@ func TestCallFunctionRequest(t *testing.T) {
 				FunctionDefinition: function.Definition{
 					Return: function.StringReturn{},
 				},
+				FunctionName: "testfunction",
 			},
 		},
 	}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func FuzzDynamicValue(f *testing.F) {
+	testProto5Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+			"test_list":      tftypes.List{ElementType: tftypes.Number},
+		},
+	}
+
+	testFwSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_attribute": testschema.Attribute{
+				Required: true,
+				Type:     types.StringType,
+			},
+			"test_list": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.NumberType},
+			},
+		},
+	}
+
+	for _, value := range []tftypes.Value{
+		tftypes.NewValue(testProto5Type, nil),
+		tftypes.NewValue(testProto5Type, tftypes.UnknownValue),
+		tftypes.NewValue(testProto5Type, map[string]tftypes.Value{
+			"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+			"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}),
+		}),
+	} {
+		dynamicValue, err := tfprotov5.NewDynamicValue(testProto5Type, value)
+
+		if err != nil {
+			f.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+		}
+
+		f.Add(dynamicValue.MsgPack, []byte(nil))
+	}
+
+	f.Add([]byte(nil), []byte(`{"test_attribute":"test-value","test_list":[1]}`))
+
+	f.Fuzz(func(t *testing.T, msgPack []byte, json []byte) {
+		ctx := context.Background()
+		dynamicValue := &tfprotov5.DynamicValue{
+			JSON:    json,
+			MsgPack: msgPack,
+		}
+
+		// Only panics are of interest, errors are expected for malformed data.
+		_, _ = fromproto5.Config(ctx, dynamicValue, testFwSchema)
+		_, _ = fromproto5.Plan(ctx, dynamicValue, testFwSchema)
+		_, _ = fromproto5.State(ctx, dynamicValue, testFwSchema)
+	})
+}
@@ -42,6 +42,7 @@ func PlanResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.PlanResour
 		ResourceBehavior:   resourceBehavior,
 		ResourceSchema:     resourceSchema,
 		Resource:           reqResource,
+		TypeName:           proto5.TypeName,
 		ClientCapabilities: ModifyPlanClientCapabilities(proto5.ClientCapabilities),
 	}
 
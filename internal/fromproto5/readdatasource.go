@@ -41,6 +41,7 @@ func ReadDataSourceRequest(ctx context.Context, proto5 *tfprotov5.ReadDataSource
 		DataSource:         dataSource,
 		DataSourceSchema:   dataSourceSchema,
 		ClientCapabilities: ReadDataSourceClientCapabilities(proto5.ClientCapabilities),
+		TypeName:           proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, dataSourceSchema)
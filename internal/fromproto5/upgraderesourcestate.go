@@ -16,7 +16,7 @@ import (
 
 // UpgradeResourceStateRequest returns the *fwserver.UpgradeResourceStateRequest
 // equivalent of a *tfprotov5.UpgradeResourceStateRequest.
-func UpgradeResourceStateRequest(ctx context.Context, proto5 *tfprotov5.UpgradeResourceStateRequest, resource resource.Resource, resourceSchema fwschema.Schema) (*fwserver.UpgradeResourceStateRequest, diag.Diagnostics) {
+func UpgradeResourceStateRequest(ctx context.Context, proto5 *tfprotov5.UpgradeResourceStateRequest, resource resource.Resource, resourceSchema fwschema.Schema, resourceBehavior resource.ResourceBehavior) (*fwserver.UpgradeResourceStateRequest, diag.Diagnostics) {
 	if proto5 == nil {
 		return nil, nil
 	}
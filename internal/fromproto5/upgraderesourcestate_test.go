@@ -91,7 +91,7 @@ func TestUpgradeResourceStateRequest(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := fromproto5.UpgradeResourceStateRequest(context.Background(), testCase.input, testCase.resource, testCase.resourceSchema)
+			got, diags := fromproto5.UpgradeResourceStateRequest(context.Background(), testCase.input, testCase.resource, testCase.resourceSchema, resource.ResourceBehavior{})
 
 			if diff := cmp.Diff(got, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func FuzzDynamicValue(f *testing.F) {
+	testProto6Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+			"test_list":      tftypes.List{ElementType: tftypes.Number},
+		},
+	}
+
+	testFwSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_attribute": testschema.Attribute{
+				Required: true,
+				Type:     types.StringType,
+			},
+			"test_list": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.NumberType},
+			},
+		},
+	}
+
+	for _, value := range []tftypes.Value{
+		tftypes.NewValue(testProto6Type, nil),
+		tftypes.NewValue(testProto6Type, tftypes.UnknownValue),
+		tftypes.NewValue(testProto6Type, map[string]tftypes.Value{
+			"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+			"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}),
+		}),
+	} {
+		dynamicValue, err := tfprotov6.NewDynamicValue(testProto6Type, value)
+
+		if err != nil {
+			f.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+		}
+
+		f.Add(dynamicValue.MsgPack, []byte(nil))
+	}
+
+	f.Add([]byte(nil), []byte(`{"test_attribute":"test-value","test_list":[1]}`))
+
+	f.Fuzz(func(t *testing.T, msgPack []byte, json []byte) {
+		ctx := context.Background()
+		dynamicValue := &tfprotov6.DynamicValue{
+			JSON:    json,
+			MsgPack: msgPack,
+		}
+
+		// Only panics are of interest, errors are expected for malformed data.
+		_, _ = fromproto6.Config(ctx, dynamicValue, testFwSchema)
+		_, _ = fromproto6.Plan(ctx, dynamicValue, testFwSchema)
+		_, _ = fromproto6.State(ctx, dynamicValue, testFwSchema)
+	})
+}
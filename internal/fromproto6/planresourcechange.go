@@ -42,6 +42,7 @@ func PlanResourceChangeRequest(ctx context.Context, proto6 *tfprotov6.PlanResour
 		ResourceBehavior:   resourceBehavior,
 		ResourceSchema:     resourceSchema,
 		Resource:           reqResource,
+		TypeName:           proto6.TypeName,
 		ClientCapabilities: ModifyPlanClientCapabilities(proto6.ClientCapabilities),
 	}
 
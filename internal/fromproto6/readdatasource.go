@@ -41,6 +41,7 @@ func ReadDataSourceRequest(ctx context.Context, proto6 *tfprotov6.ReadDataSource
 		DataSourceSchema:   dataSourceSchema,
 		DataSource:         dataSource,
 		ClientCapabilities: ReadDataSourceClientCapabilities(proto6.ClientCapabilities),
+		TypeName:           proto6.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto6.Config, dataSourceSchema)
@@ -15,7 +15,7 @@ import (
 
 // UpgradeResourceStateRequest returns the *fwserver.UpgradeResourceStateRequest
 // equivalent of a *tfprotov6.UpgradeResourceStateRequest.
-func UpgradeResourceStateRequest(ctx context.Context, proto6 *tfprotov6.UpgradeResourceStateRequest, resource resource.Resource, resourceSchema fwschema.Schema) (*fwserver.UpgradeResourceStateRequest, diag.Diagnostics) {
+func UpgradeResourceStateRequest(ctx context.Context, proto6 *tfprotov6.UpgradeResourceStateRequest, resource resource.Resource, resourceSchema fwschema.Schema, resourceBehavior resource.ResourceBehavior) (*fwserver.UpgradeResourceStateRequest, diag.Diagnostics) {
 	if proto6 == nil {
 		return nil, nil
 	}
@@ -37,10 +37,11 @@ func UpgradeResourceStateRequest(ctx context.Context, proto6 *tfprotov6.UpgradeR
 	}
 
 	fw := &fwserver.UpgradeResourceStateRequest{
-		RawState:       proto6.RawState,
-		ResourceSchema: resourceSchema,
-		Resource:       resource,
-		Version:        proto6.Version,
+		RawState:         proto6.RawState,
+		ResourceBehavior: resourceBehavior,
+		ResourceSchema:   resourceSchema,
+		Resource:         resource,
+		Version:          proto6.Version,
 	}
 
 	return fw, diags
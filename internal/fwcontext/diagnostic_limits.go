@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// diagnosticLimitsKey is the context key for the provider-defined
+// diag.Limits.
+type diagnosticLimitsKey struct{}
+
+// WithDiagnosticLimits returns a new context containing the given
+// diag.Limits.
+func WithDiagnosticLimits(ctx context.Context, limits diag.Limits) context.Context {
+	if limits == (diag.Limits{}) {
+		return ctx
+	}
+
+	return context.WithValue(ctx, diagnosticLimitsKey{}, limits)
+}
+
+// DiagnosticLimits returns the diag.Limits from the context, if any.
+func DiagnosticLimits(ctx context.Context) diag.Limits {
+	limits, ok := ctx.Value(diagnosticLimitsKey{}).(diag.Limits)
+
+	if !ok {
+		return diag.Limits{}
+	}
+
+	return limits
+}
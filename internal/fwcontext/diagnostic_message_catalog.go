@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// diagnosticMessageCatalogKey is the context key for the provider-defined
+// diag.MessageCatalog.
+type diagnosticMessageCatalogKey struct{}
+
+// WithDiagnosticMessageCatalog returns a new context containing the given
+// diag.MessageCatalog.
+func WithDiagnosticMessageCatalog(ctx context.Context, catalog diag.MessageCatalog) context.Context {
+	if len(catalog) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, diagnosticMessageCatalogKey{}, catalog)
+}
+
+// DiagnosticMessageCatalog returns the diag.MessageCatalog from the context,
+// if any.
+func DiagnosticMessageCatalog(ctx context.Context) diag.MessageCatalog {
+	catalog, ok := ctx.Value(diagnosticMessageCatalogKey{}).(diag.MessageCatalog)
+
+	if !ok {
+		return nil
+	}
+
+	return catalog
+}
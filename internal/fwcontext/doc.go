@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwcontext contains framework internal helpers for storing and
+// retrieving request-scoped values in a context.Context.
+package fwcontext
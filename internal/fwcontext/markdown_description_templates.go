@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import (
+	"context"
+)
+
+// markdownDescriptionTemplatesKey is the context key for whether the provider
+// enabled MarkdownDescription templates.
+type markdownDescriptionTemplatesKey struct{}
+
+// WithMarkdownDescriptionTemplates returns a new context containing whether
+// the provider enabled MarkdownDescription templates.
+func WithMarkdownDescriptionTemplates(ctx context.Context, enabled bool) context.Context {
+	if !enabled {
+		return ctx
+	}
+
+	return context.WithValue(ctx, markdownDescriptionTemplatesKey{}, enabled)
+}
+
+// MarkdownDescriptionTemplates returns true if the context contains an
+// enabled MarkdownDescription templates setting.
+func MarkdownDescriptionTemplates(ctx context.Context) bool {
+	enabled, ok := ctx.Value(markdownDescriptionTemplatesKey{}).(bool)
+
+	return ok && enabled
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// suppressedWarningsKey is the context key for the provider configured
+// diag.SuppressedWarnings.
+type suppressedWarningsKey struct{}
+
+// WithSuppressedWarnings returns a new context containing the given
+// diag.SuppressedWarnings.
+func WithSuppressedWarnings(ctx context.Context, suppressed diag.SuppressedWarnings) context.Context {
+	if len(suppressed) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, suppressedWarningsKey{}, suppressed)
+}
+
+// SuppressedWarnings returns the diag.SuppressedWarnings from the context,
+// if any.
+func SuppressedWarnings(ctx context.Context) diag.SuppressedWarnings {
+	suppressed, ok := ctx.Value(suppressedWarningsKey{}).(diag.SuppressedWarnings)
+
+	if !ok {
+		return nil
+	}
+
+	return suppressed
+}
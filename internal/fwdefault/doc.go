@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwdefault implements shared logic for the built-in resource schema
+// default value handlers.
+package fwdefault
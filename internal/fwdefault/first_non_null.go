@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwdefault
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+// FirstNonNull calls each default value handler in order, using the given
+// function which returns the planned value and diagnostics of the handler at
+// the given index.
+// It returns the first non-null planned value or, if all handlers return a
+// null value, the null value of the last handler. Error diagnostics from a
+// handler stop calling further handlers.
+func FirstNonNull[T any, V attr.Value](defaultValues []T, call func(int, T) (V, diag.Diagnostics)) (V, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var planValue V
+
+	for i, defaultValue := range defaultValues {
+		value, valueDiags := call(i, defaultValue)
+
+		diags.Append(valueDiags...)
+		planValue = value
+
+		if valueDiags.HasError() || !value.IsNull() {
+			break
+		}
+	}
+
+	return planValue, diags
+}
+
+// FirstNonNullDescription returns the description of a FirstNonNull default
+// value handler.
+func FirstNonNullDescription[T defaults.Describer](ctx context.Context, defaultValues []T) string {
+	descriptions := make([]string, 0, len(defaultValues))
+
+	for _, defaultValue := range defaultValues {
+		descriptions = append(descriptions, defaultValue.Description(ctx))
+	}
+
+	return "first non-null of: " + strings.Join(descriptions, "; ")
+}
+
+// FirstNonNullMarkdownDescription returns the markdown description of a
+// FirstNonNull default value handler.
+func FirstNonNullMarkdownDescription[T defaults.Describer](ctx context.Context, defaultValues []T) string {
+	descriptions := make([]string, 0, len(defaultValues))
+
+	for _, defaultValue := range defaultValues {
+		descriptions = append(descriptions, defaultValue.MarkdownDescription(ctx))
+	}
+
+	return "first non-null of: " + strings.Join(descriptions, "; ")
+}
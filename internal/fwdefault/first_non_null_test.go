@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNull(t *testing.T) {
+	t.Parallel()
+
+	type testResult struct {
+		value types.String
+		diags diag.Diagnostics
+	}
+
+	testCases := map[string]struct {
+		results        []testResult
+		expectedValue  types.String
+		expectedDiags  diag.Diagnostics
+		expectedCalled int
+	}{
+		"none": {
+			expectedValue: types.String{},
+		},
+		"first": {
+			results: []testResult{
+				{value: types.StringValue("first")},
+				{value: types.StringValue("second")},
+			},
+			expectedValue:  types.StringValue("first"),
+			expectedCalled: 1,
+		},
+		"fallback": {
+			results: []testResult{
+				{value: types.StringNull()},
+				{value: types.StringValue("second")},
+			},
+			expectedValue:  types.StringValue("second"),
+			expectedCalled: 2,
+		},
+		"all-null": {
+			results: []testResult{
+				{value: types.StringNull()},
+				{value: types.StringNull()},
+			},
+			expectedValue:  types.StringNull(),
+			expectedCalled: 2,
+		},
+		"warning": {
+			results: []testResult{
+				{
+					value: types.StringNull(),
+					diags: diag.Diagnostics{diag.NewWarningDiagnostic("test summary", "test detail")},
+				},
+				{value: types.StringValue("second")},
+			},
+			expectedValue: types.StringValue("second"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+			expectedCalled: 2,
+		},
+		"error": {
+			results: []testResult{
+				{
+					value: types.StringNull(),
+					diags: diag.Diagnostics{diag.NewErrorDiagnostic("test summary", "test detail")},
+				},
+				{value: types.StringValue("second")},
+			},
+			expectedValue: types.StringNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+			expectedCalled: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var called int
+
+			gotValue, gotDiags := fwdefault.FirstNonNull(testCase.results, func(_ int, result testResult) (types.String, diag.Diagnostics) {
+				called++
+
+				return result.value, result.diags
+			})
+
+			if diff := cmp.Diff(gotValue, testCase.expectedValue); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if called != testCase.expectedCalled {
+				t.Errorf("expected %d calls, got %d", testCase.expectedCalled, called)
+			}
+		})
+	}
+}
+
+func TestFirstNonNullDescription(t *testing.T) {
+	t.Parallel()
+
+	got := fwdefault.FirstNonNullDescription(context.Background(), []defaults.String{
+		stringdefault.StaticString("a"),
+		stringdefault.StaticString("b"),
+	})
+
+	expected := "first non-null of: value defaults to a; value defaults to b"
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwdefault
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+// References contains the reference values of one default value handler
+// combined by another default value handler.
+type References struct {
+	// Value is the request ReferenceValue of the handler.
+	Value attr.Value
+
+	// Values is the request ReferenceValues of the handler.
+	Values []attr.Value
+}
+
+// ReferencePaths returns the path expressions referenced by the given default
+// value handlers, in order, for a default value handler which combines them
+// and implements defaults.References. Handlers implementing defaults.Reference
+// contribute their path expression and handlers implementing
+// defaults.References contribute all of their path expressions.
+func ReferencePaths[T any](ctx context.Context, defaultValues []T) path.Expressions {
+	var expressions path.Expressions
+
+	for _, defaultValue := range defaultValues {
+		switch reference := any(defaultValue).(type) {
+		case defaults.Reference:
+			expressions = append(expressions, reference.ReferencePath(ctx))
+		case defaults.References:
+			expressions = append(expressions, reference.ReferencePaths(ctx)...)
+		}
+	}
+
+	return expressions
+}
+
+// SplitReferenceValues returns the reference values of each of the given
+// default value handlers from the request ReferenceValues of the handler
+// combining them, which are in the order returned by ReferencePaths.
+func SplitReferenceValues[T any](ctx context.Context, defaultValues []T, referenceValues []attr.Value) []References {
+	result := make([]References, len(defaultValues))
+
+	for i, defaultValue := range defaultValues {
+		switch reference := any(defaultValue).(type) {
+		case defaults.Reference:
+			if len(referenceValues) == 0 {
+				continue
+			}
+
+			result[i].Value = referenceValues[0]
+			referenceValues = referenceValues[1:]
+		case defaults.References:
+			n := min(len(reference.ReferencePaths(ctx)), len(referenceValues))
+
+			result[i].Values = referenceValues[:n:n]
+			referenceValues = referenceValues[n:]
+		}
+	}
+
+	return result
+}
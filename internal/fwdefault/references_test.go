@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSplitReferenceValues(t *testing.T) {
+	t.Parallel()
+
+	defaultValues := []defaults.String{
+		stringdefault.StaticString("test"),
+		stringdefault.FromSibling("first"),
+		stringdefault.FirstNonNull(
+			stringdefault.FromSibling("second"),
+			stringdefault.FromSibling("third"),
+		),
+		stringdefault.FromSibling("fourth"),
+	}
+
+	testCases := map[string]struct {
+		referenceValues []attr.Value
+		expected        []fwdefault.References
+	}{
+		"all": {
+			referenceValues: []attr.Value{
+				types.StringValue("first"),
+				types.StringValue("second"),
+				types.StringValue("third"),
+				types.StringValue("fourth"),
+			},
+			expected: []fwdefault.References{
+				{},
+				{Value: types.StringValue("first")},
+				{Values: []attr.Value{types.StringValue("second"), types.StringValue("third")}},
+				{Value: types.StringValue("fourth")},
+			},
+		},
+		"missing": {
+			referenceValues: []attr.Value{
+				types.StringValue("first"),
+				types.StringValue("second"),
+			},
+			expected: []fwdefault.References{
+				{},
+				{Value: types.StringValue("first")},
+				{Values: []attr.Value{types.StringValue("second")}},
+				{},
+			},
+		},
+		"none": {
+			expected: []fwdefault.References{
+				{},
+				{},
+				{},
+				{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwdefault.SplitReferenceValues(context.Background(), defaultValues, testCase.referenceValues)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
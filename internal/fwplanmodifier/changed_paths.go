@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ChangedPaths returns the paths matching the expression whose planned value
+// is not equal to the prior state value. The attribute path is used for
+// diagnostics, such as when the expression does not match any attribute.
+func ChangedPaths(ctx context.Context, attributePath path.Path, expression path.Expression, plan tfsdk.Plan, state tfsdk.State) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matchedPaths, matchDiags := plan.PathMatches(ctx, expression)
+
+	diags.Append(matchDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(matchedPaths) == 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Plan Modifier Path Expression",
+			"The attribute plan modifier path expression must match at least one attribute. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Path Expression: %s", expression),
+		)
+
+		return nil, diags
+	}
+
+	var changedPaths path.Paths
+
+	for _, matchedPath := range matchedPaths {
+		var planValue, stateValue attr.Value
+
+		diags.Append(plan.GetAttribute(ctx, matchedPath, &planValue)...)
+		diags.Append(state.GetAttribute(ctx, matchedPath, &stateValue)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if planValue.Equal(stateValue) {
+			continue
+		}
+
+		changedPaths.Append(matchedPath)
+	}
+
+	return changedPaths, diags
+}
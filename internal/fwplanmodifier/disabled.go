@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Disabled returns true if every bool attribute matching the expression is
+// planned as false or null. It returns false if any matched attribute is true
+// or unknown. The attribute path is used for diagnostics, such as when the
+// expression does not match any attribute or matches a non-bool attribute.
+func Disabled(ctx context.Context, attributePath path.Path, expression path.Expression, plan tfsdk.Plan) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matchedPaths, matchDiags := plan.PathMatches(ctx, expression)
+
+	diags.Append(matchDiags...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if len(matchedPaths) == 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Plan Modifier Path Expression",
+			"The attribute plan modifier path expression must match at least one attribute. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Path Expression: %s", expression),
+		)
+
+		return false, diags
+	}
+
+	for _, matchedPath := range matchedPaths {
+		var value attr.Value
+
+		diags.Append(plan.GetAttribute(ctx, matchedPath, &value)...)
+
+		if diags.HasError() {
+			return false, diags
+		}
+
+		// A null or unknown parent path may be matched instead of the bool
+		// attribute, which is handled the same as a null or unknown value.
+		if value.IsNull() {
+			continue
+		}
+
+		if value.IsUnknown() {
+			return false, diags
+		}
+
+		boolValuable, ok := value.(basetypes.BoolValuable)
+
+		if !ok {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid Plan Modifier Path Expression",
+				"The attribute plan modifier path expression must match bool attributes. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Path: %s\n", matchedPath)+
+					fmt.Sprintf("Value Type: %T", value),
+			)
+
+			return false, diags
+		}
+
+		boolValue, boolDiags := boolValuable.ToBoolValue(ctx)
+
+		diags.Append(boolDiags...)
+
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if boolValue.IsUnknown() || boolValue.ValueBool() {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
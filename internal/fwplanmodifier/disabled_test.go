@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestDisabled(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"feature": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testFeatureType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+		},
+	}
+
+	testPlan := func(enabled tftypes.Value, feature tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"feature": testFeatureType,
+						"name":    tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"enabled": enabled,
+					"feature": feature,
+					"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				},
+			),
+		}
+	}
+
+	testFeature := func(enabled tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testFeatureType, map[string]tftypes.Value{
+			"enabled": enabled,
+		})
+	}
+
+	testCases := map[string]struct {
+		expression    path.Expression
+		plan          tfsdk.Plan
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"true": {
+			expression: path.MatchRoot("enabled"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, true), tftypes.NewValue(testFeatureType, nil)),
+			expected:   false,
+		},
+		"false": {
+			expression: path.MatchRoot("enabled"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, false), tftypes.NewValue(testFeatureType, nil)),
+			expected:   true,
+		},
+		"null": {
+			expression: path.MatchRoot("enabled"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, nil), tftypes.NewValue(testFeatureType, nil)),
+			expected:   true,
+		},
+		"unknown": {
+			expression: path.MatchRoot("enabled"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), tftypes.NewValue(testFeatureType, nil)),
+			expected:   false,
+		},
+		"nested-false": {
+			expression: path.MatchRoot("feature").AtName("enabled"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, nil), testFeature(tftypes.NewValue(tftypes.Bool, false))),
+			expected:   true,
+		},
+		"nested-parent-null": {
+			expression: path.MatchRoot("feature").AtName("enabled"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, true), tftypes.NewValue(testFeatureType, nil)),
+			expected:   true,
+		},
+		"nested-parent-unknown": {
+			expression: path.MatchRoot("feature").AtName("enabled"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, nil), tftypes.NewValue(testFeatureType, tftypes.UnknownValue)),
+			expected:   false,
+		},
+		"multiple-false": {
+			expression: path.MatchRoot("enabled").Merge(path.MatchRoot("feature").AtName("enabled")),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, false), testFeature(tftypes.NewValue(tftypes.Bool, nil))),
+			expected:   true,
+		},
+		"multiple-one-true": {
+			expression: path.MatchRoot("enabled").Merge(path.MatchRoot("feature").AtName("enabled")),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, false), testFeature(tftypes.NewValue(tftypes.Bool, true))),
+			expected:   false,
+		},
+		"no-match": {
+			expression: path.MatchRoot("missing"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, false), tftypes.NewValue(testFeatureType, nil)),
+			expected:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: missing",
+				),
+			},
+		},
+		"invalid-value-type": {
+			expression: path.MatchRoot("name"),
+			plan:       testPlan(tftypes.NewValue(tftypes.Bool, false), tftypes.NewValue(testFeatureType, nil)),
+			expected:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Plan Modifier Path Expression",
+					"The attribute plan modifier path expression must match bool attributes. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Path: name\n"+
+						"Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := fwplanmodifier.Disabled(context.Background(), path.Root("test"), testCase.expression, testCase.plan)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwplanmodifier
+
+// StateForUnknownModifier is implemented by the built-in plan modifiers
+// which copy prior state values into unknown planned values, such as
+// UseStateForUnknown. This enables the framework to determine whether the
+// planned value of a Computed attribute is kept stable across updates.
+type StateForUnknownModifier interface {
+	// UsesStateForUnknown should return true if the plan modifier copies
+	// prior state values into unknown planned values.
+	UsesStateForUnknown() bool
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// UseStateForUnknownElementsValue returns the planned value modified by
+// UseStateForUnknownElements, or nil if the planned value is unchanged.
+func UseStateForUnknownElementsValue(ctx context.Context, plan attr.Value, state attr.Value, config attr.Value) (attr.Value, error) {
+	planValue, err := plan.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert planned value: %w", err)
+	}
+
+	stateValue, err := state.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert prior state value: %w", err)
+	}
+
+	configValue, err := config.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert configuration value: %w", err)
+	}
+
+	result := UseStateForUnknownElements(planValue, stateValue, configValue)
+
+	if result.Equal(planValue) {
+		return nil, nil
+	}
+
+	value, err := plan.Type(ctx).ValueFromTerraform(ctx, result)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert modified planned value: %w", err)
+	}
+
+	return value, nil
+}
+
+// UseStateForUnknownElements returns the planned value with each unknown
+// value, including those nested within collection elements and objects,
+// replaced by the known, non-null prior state value at the same location.
+// Unknown values are kept if the configuration value at the same location is
+// unknown.
+//
+// List and tuple elements are matched by index, map elements by key, and
+// object attributes by name. Set elements are matched by identity, to the
+// only prior state element which equals every known value of the planned
+// element. Set elements are not matched if the configuration value of the
+// set is not fully known.
+func UseStateForUnknownElements(plan tftypes.Value, state tftypes.Value, config tftypes.Value) tftypes.Value {
+	if plan.Type() == nil || state.Type() == nil || !plan.Type().Equal(state.Type()) {
+		return plan
+	}
+
+	if !plan.IsKnown() {
+		if config.Type() != nil && !config.IsKnown() {
+			return plan
+		}
+
+		if !state.IsKnown() || state.IsNull() {
+			return plan
+		}
+
+		return state
+	}
+
+	if plan.IsNull() || plan.IsFullyKnown() || !state.IsKnown() || state.IsNull() {
+		return plan
+	}
+
+	switch {
+	case plan.Type().Is(tftypes.Object{}):
+		return useStateForUnknownAttributes(plan, state, config)
+	case plan.Type().Is(tftypes.List{}), plan.Type().Is(tftypes.Tuple{}):
+		return useStateForUnknownIndexes(plan, state, config)
+	case plan.Type().Is(tftypes.Map{}):
+		return useStateForUnknownKeys(plan, state, config)
+	case plan.Type().Is(tftypes.Set{}):
+		return useStateForUnknownSetElements(plan, state, config)
+	}
+
+	return plan
+}
+
+// useStateForUnknownAttributes returns the planned object with unknown
+// values replaced by the prior state value of the same attribute.
+func useStateForUnknownAttributes(plan tftypes.Value, state tftypes.Value, config tftypes.Value) tftypes.Value {
+	var planAttributes, stateAttributes, configAttributes map[string]tftypes.Value
+
+	if err := plan.As(&planAttributes); err != nil {
+		return plan
+	}
+
+	if err := state.As(&stateAttributes); err != nil {
+		return plan
+	}
+
+	if knownNotNull(config) {
+		if err := config.As(&configAttributes); err != nil {
+			return plan
+		}
+	}
+
+	result := make(map[string]tftypes.Value, len(planAttributes))
+
+	for name, planAttribute := range planAttributes {
+		result[name] = UseStateForUnknownElements(planAttribute, stateAttributes[name], configAttributes[name])
+	}
+
+	return tftypes.NewValue(plan.Type(), result)
+}
+
+// useStateForUnknownIndexes returns the planned list or tuple with unknown
+// values replaced by the prior state value of the element at the same index.
+func useStateForUnknownIndexes(plan tftypes.Value, state tftypes.Value, config tftypes.Value) tftypes.Value {
+	var planElements, stateElements, configElements []tftypes.Value
+
+	if err := plan.As(&planElements); err != nil {
+		return plan
+	}
+
+	if err := state.As(&stateElements); err != nil {
+		return plan
+	}
+
+	if knownNotNull(config) {
+		if err := config.As(&configElements); err != nil {
+			return plan
+		}
+	}
+
+	result := make([]tftypes.Value, len(planElements))
+
+	for index, planElement := range planElements {
+		var stateElement, configElement tftypes.Value
+
+		if index < len(stateElements) {
+			stateElement = stateElements[index]
+		}
+
+		if index < len(configElements) {
+			configElement = configElements[index]
+		}
+
+		result[index] = UseStateForUnknownElements(planElement, stateElement, configElement)
+	}
+
+	return tftypes.NewValue(plan.Type(), result)
+}
+
+// useStateForUnknownKeys returns the planned map with unknown values
+// replaced by the prior state value of the element with the same key.
+func useStateForUnknownKeys(plan tftypes.Value, state tftypes.Value, config tftypes.Value) tftypes.Value {
+	var planElements, stateElements, configElements map[string]tftypes.Value
+
+	if err := plan.As(&planElements); err != nil {
+		return plan
+	}
+
+	if err := state.As(&stateElements); err != nil {
+		return plan
+	}
+
+	if knownNotNull(config) {
+		if err := config.As(&configElements); err != nil {
+			return plan
+		}
+	}
+
+	result := make(map[string]tftypes.Value, len(planElements))
+
+	for key, planElement := range planElements {
+		result[key] = UseStateForUnknownElements(planElement, stateElements[key], configElements[key])
+	}
+
+	return tftypes.NewValue(plan.Type(), result)
+}
+
+// useStateForUnknownSetElements returns the planned set with unknown values
+// replaced by the prior state values of the matching element. Each prior
+// state element is matched at most once.
+func useStateForUnknownSetElements(plan tftypes.Value, state tftypes.Value, config tftypes.Value) tftypes.Value {
+	// Unknown configuration values cannot be located within set elements,
+	// so the set is only modified when the configuration is fully known.
+	if config.Type() != nil && !config.IsFullyKnown() {
+		return plan
+	}
+
+	var planElements, stateElements []tftypes.Value
+
+	if err := plan.As(&planElements); err != nil {
+		return plan
+	}
+
+	if err := state.As(&stateElements); err != nil {
+		return plan
+	}
+
+	matched := make([]bool, len(stateElements))
+
+	// Fully known planned elements claim their equal prior state element
+	// first, so it cannot be matched to a partially unknown element.
+	for _, planElement := range planElements {
+		if !planElement.IsFullyKnown() {
+			continue
+		}
+
+		for index, stateElement := range stateElements {
+			if !matched[index] && planElement.Equal(stateElement) {
+				matched[index] = true
+
+				break
+			}
+		}
+	}
+
+	result := make([]tftypes.Value, len(planElements))
+
+	for planIndex, planElement := range planElements {
+		result[planIndex] = planElement
+
+		if planElement.IsFullyKnown() {
+			continue
+		}
+
+		match := -1
+
+		for index, stateElement := range stateElements {
+			if matched[index] || !knownValuesEqual(planElement, stateElement) {
+				continue
+			}
+
+			// Ambiguous matches are not modified.
+			if match != -1 {
+				match = -1
+
+				break
+			}
+
+			match = index
+		}
+
+		if match == -1 {
+			continue
+		}
+
+		matched[match] = true
+		result[planIndex] = UseStateForUnknownElements(planElement, stateElements[match], tftypes.Value{})
+	}
+
+	return tftypes.NewValue(plan.Type(), result)
+}
+
+// knownValuesEqual returns true if every known value of the planned value
+// equals the prior state value at the same location.
+func knownValuesEqual(plan tftypes.Value, state tftypes.Value) bool {
+	if !plan.IsKnown() {
+		return true
+	}
+
+	if plan.IsFullyKnown() {
+		return plan.Equal(state)
+	}
+
+	if state.Type() == nil || !plan.Type().Equal(state.Type()) || !knownNotNull(state) {
+		return false
+	}
+
+	switch {
+	case plan.Type().Is(tftypes.Object{}), plan.Type().Is(tftypes.Map{}):
+		var planValues, stateValues map[string]tftypes.Value
+
+		if plan.As(&planValues) != nil || state.As(&stateValues) != nil || len(planValues) != len(stateValues) {
+			return false
+		}
+
+		for key, planValue := range planValues {
+			stateValue, ok := stateValues[key]
+
+			if !ok || !knownValuesEqual(planValue, stateValue) {
+				return false
+			}
+		}
+
+		return true
+	case plan.Type().Is(tftypes.List{}), plan.Type().Is(tftypes.Tuple{}):
+		var planValues, stateValues []tftypes.Value
+
+		if plan.As(&planValues) != nil || state.As(&stateValues) != nil || len(planValues) != len(stateValues) {
+			return false
+		}
+
+		for index, planValue := range planValues {
+			if !knownValuesEqual(planValue, stateValues[index]) {
+				return false
+			}
+		}
+
+		return true
+	case plan.Type().Is(tftypes.Set{}):
+		var planValues, stateValues []tftypes.Value
+
+		if plan.As(&planValues) != nil || state.As(&stateValues) != nil || len(planValues) != len(stateValues) {
+			return false
+		}
+
+		for _, planValue := range planValues {
+			found := false
+
+			for _, stateValue := range stateValues {
+				if knownValuesEqual(planValue, stateValue) {
+					found = true
+
+					break
+				}
+			}
+
+			if !found {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// knownNotNull returns true if the value is set, known, and not null.
+func knownNotNull(value tftypes.Value) bool {
+	return value.Type() != nil && value.IsKnown() && !value.IsNull()
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwplanmodifier_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+)
+
+func TestUseStateForUnknownElements(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testObject := func(id tftypes.Value, tags tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+			"id":   id,
+			"tags": tags,
+		})
+	}
+
+	testTags := func(values ...interface{}) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+
+	testSetType := tftypes.Set{ElementType: testObjectType}
+
+	testCases := map[string]struct {
+		plan     tftypes.Value
+		state    tftypes.Value
+		config   tftypes.Value
+		expected tftypes.Value
+	}{
+		"known": {
+			plan:     testObject(tftypes.NewValue(tftypes.String, "plan"), testTags("a")),
+			state:    testObject(tftypes.NewValue(tftypes.String, "state"), testTags("b")),
+			config:   testObject(tftypes.NewValue(tftypes.String, "plan"), testTags("a")),
+			expected: testObject(tftypes.NewValue(tftypes.String, "plan"), testTags("a")),
+		},
+		"nested-list-element": {
+			plan:     testObject(tftypes.NewValue(tftypes.String, "test"), testTags("a", tftypes.UnknownValue)),
+			state:    testObject(tftypes.NewValue(tftypes.String, "test"), testTags("a", "b")),
+			config:   tftypes.NewValue(testObjectType, nil),
+			expected: testObject(tftypes.NewValue(tftypes.String, "test"), testTags("a", "b")),
+		},
+		"null-state-attribute": {
+			plan:     testObject(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), testTags("a")),
+			state:    testObject(tftypes.NewValue(tftypes.String, nil), testTags("a")),
+			config:   tftypes.NewValue(testObjectType, nil),
+			expected: testObject(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), testTags("a")),
+		},
+		"set-nested-list-element": {
+			plan: tftypes.NewValue(testSetType, []tftypes.Value{
+				testObject(tftypes.NewValue(tftypes.String, "one"), testTags(tftypes.UnknownValue)),
+				testObject(tftypes.NewValue(tftypes.String, "two"), testTags("c")),
+			}),
+			state: tftypes.NewValue(testSetType, []tftypes.Value{
+				testObject(tftypes.NewValue(tftypes.String, "two"), testTags("c")),
+				testObject(tftypes.NewValue(tftypes.String, "one"), testTags("a")),
+			}),
+			config: tftypes.NewValue(testSetType, nil),
+			expected: tftypes.NewValue(testSetType, []tftypes.Value{
+				testObject(tftypes.NewValue(tftypes.String, "one"), testTags("a")),
+				testObject(tftypes.NewValue(tftypes.String, "two"), testTags("c")),
+			}),
+		},
+		"set-unknown-config": {
+			plan: tftypes.NewValue(testSetType, []tftypes.Value{
+				testObject(tftypes.NewValue(tftypes.String, "one"), testTags(tftypes.UnknownValue)),
+			}),
+			state: tftypes.NewValue(testSetType, []tftypes.Value{
+				testObject(tftypes.NewValue(tftypes.String, "one"), testTags("a")),
+			}),
+			config: tftypes.NewValue(testSetType, []tftypes.Value{
+				testObject(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)),
+			}),
+			expected: tftypes.NewValue(testSetType, []tftypes.Value{
+				testObject(tftypes.NewValue(tftypes.String, "one"), testTags(tftypes.UnknownValue)),
+			}),
+		},
+		"type-mismatch": {
+			plan:     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			state:    tftypes.NewValue(tftypes.Number, 1),
+			config:   tftypes.NewValue(tftypes.String, nil),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwplanmodifier.UseStateForUnknownElements(testCase.plan, testCase.state, testCase.config)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -103,3 +103,16 @@ func AttributesEqual(a, b Attribute) bool {
 
 	return true
 }
+
+// AttributeWithNullEmptyEquivalent is an optional interface on Attribute which
+// declares that null and empty string values of a string attribute are
+// equivalent.
+type AttributeWithNullEmptyEquivalent interface {
+	Attribute
+
+	// IsNullEmptyEquivalent should return true if null and empty string
+	// values are equivalent. The framework then preserves the prior value
+	// when a new state value only differs from it by being null instead of
+	// an empty string, or vice versa.
+	IsNullEmptyEquivalent() bool
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// AttributeWithDefaultEnv is an optional interface on Attribute which enables
+// provider configuration values to fall back to environment variables.
+type AttributeWithDefaultEnv interface {
+	Attribute
+
+	// GetDefaultEnv should return the names of the environment variables to
+	// read, in order of precedence, if the configuration value is null.
+	GetDefaultEnv() []string
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeWithDeprecationReplacement is an optional interface on Attribute
+// which enables declaring the attribute which replaces a deprecated
+// attribute.
+type AttributeWithDeprecationReplacement interface {
+	Attribute
+
+	// GetDeprecationReplacement should return the path expression of the
+	// replacement attribute, relative to the attribute. A zero value
+	// expression means there is no replacement.
+	GetDeprecationReplacement() path.Expression
+}
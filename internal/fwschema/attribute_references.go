@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeWithReferences is an optional interface on Attribute which enables
+// declaring another attribute whose value the attribute must match.
+type AttributeWithReferences interface {
+	Attribute
+
+	// GetReferences should return the path expression of the referenced
+	// attribute, relative to the attribute. A zero value expression
+	// disables the reference.
+	GetReferences() path.Expression
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// AttributeWithStateTransform is an optional interface on Attribute which
+// enables resource state values to be transformed by the provider-defined
+// state transformer before they are saved in state.
+type AttributeWithStateTransform interface {
+	Attribute
+
+	// HasStateTransform should return true if the state value is
+	// transformed.
+	HasStateTransform() bool
+}
@@ -64,3 +64,19 @@ func AttributeDefaultTypeMismatchDiag(attributePath path.Path, expectedType attr
 			"The default value must match the type of the schema.",
 	)
 }
+
+// AttributeInvalidReferencesDiag returns an error diagnostic to provider
+// developers about a References field expression on an Attribute
+// implementation which does not match any attribute in the schema.
+func AttributeInvalidReferencesDiag(attributeExpression path.Expression, references path.Expression) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has a References field expression of %q, which does not match any attribute in the schema. ", attributeExpression, references)+
+			"The References field must match an attribute in the schema.",
+	)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwxschema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// MarkdownDescriptionTemplateData is the data available to MarkdownDescription
+// templates of attributes.
+type MarkdownDescriptionTemplateData struct {
+	// Computed is the IsComputed value of the attribute.
+	Computed bool
+
+	// Default is the MarkdownDescription of the attribute default value
+	// handler, if any, such as "value defaults to `example`".
+	Default string
+
+	// DeprecationMessage is the GetDeprecationMessage value of the
+	// attribute.
+	DeprecationMessage string
+
+	// Optional is the IsOptional value of the attribute.
+	Optional bool
+
+	// Required is the IsRequired value of the attribute.
+	Required bool
+
+	// Sensitive is the IsSensitive value of the attribute.
+	Sensitive bool
+
+	// ValidValues is the Markdown list of values from attribute validators
+	// implementing validator.ValidatorWithValidValues, such as
+	// "`one`, `two`, and `three`".
+	ValidValues string
+}
+
+// AttributeMarkdownDescription returns the MarkdownDescription of the
+// attribute. If the provider enabled MarkdownDescription templates in the
+// context, descriptions containing template actions are rendered as
+// text/template templates with MarkdownDescriptionTemplateData. Otherwise,
+// or if the template cannot be rendered, the description is returned
+// unchanged. Rendering failures log a warning.
+func AttributeMarkdownDescription(ctx context.Context, a fwschema.Attribute) string {
+	description := a.GetMarkdownDescription()
+
+	if !fwcontext.MarkdownDescriptionTemplates(ctx) || !strings.Contains(description, "{{") {
+		return description
+	}
+
+	tmpl, err := template.New("MarkdownDescription").Parse(description)
+
+	if err != nil {
+		logging.FrameworkWarn(ctx, "Unable to parse attribute MarkdownDescription template, using description unchanged", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return description
+	}
+
+	var result strings.Builder
+
+	err = tmpl.Execute(&result, markdownDescriptionTemplateData(ctx, a))
+
+	if err != nil {
+		logging.FrameworkWarn(ctx, "Unable to render attribute MarkdownDescription template, using description unchanged", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return description
+	}
+
+	return result.String()
+}
+
+// markdownDescriptionTemplateData returns the template data of the attribute.
+func markdownDescriptionTemplateData(ctx context.Context, a fwschema.Attribute) MarkdownDescriptionTemplateData {
+	data := MarkdownDescriptionTemplateData{
+		Computed:           a.IsComputed(),
+		DeprecationMessage: a.GetDeprecationMessage(),
+		Optional:           a.IsOptional(),
+		Required:           a.IsRequired(),
+		Sensitive:          a.IsSensitive(),
+	}
+
+	if defaultValue := attributeDefault(a); defaultValue != nil {
+		data.Default = defaultValue.MarkdownDescription(ctx)
+	}
+
+	var validValues []string
+
+	for _, v := range attributeValidators(a) {
+		if validatorWithValidValues, ok := v.(validator.ValidatorWithValidValues); ok {
+			for _, validValue := range validatorWithValidValues.ValidValues(ctx) {
+				validValues = append(validValues, fmt.Sprintf("`%s`", validValue))
+			}
+		}
+	}
+
+	data.ValidValues = joinMarkdownList(validValues)
+
+	return data
+}
+
+// attributeDefault returns the default value handler of the attribute, if
+// any.
+func attributeDefault(a fwschema.Attribute) defaults.Describer {
+	var result defaults.Describer
+
+	switch a := a.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		if d := a.BoolDefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithDynamicDefaultValue:
+		if d := a.DynamicDefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithFloat32DefaultValue:
+		if d := a.Float32DefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithFloat64DefaultValue:
+		if d := a.Float64DefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithInt32DefaultValue:
+		if d := a.Int32DefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithInt64DefaultValue:
+		if d := a.Int64DefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithListDefaultValue:
+		if d := a.ListDefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithMapDefaultValue:
+		if d := a.MapDefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithNumberDefaultValue:
+		if d := a.NumberDefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithObjectDefaultValue:
+		if d := a.ObjectDefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithSetDefaultValue:
+		if d := a.SetDefaultValue(); d != nil {
+			result = d
+		}
+	case fwschema.AttributeWithStringDefaultValue:
+		if d := a.StringDefaultValue(); d != nil {
+			result = d
+		}
+	}
+
+	return result
+}
+
+// attributeValidators returns the validators of the attribute.
+func attributeValidators(a fwschema.Attribute) []validator.Describer {
+	switch a := a.(type) {
+	case AttributeWithBoolValidators:
+		return describers(a.BoolValidators())
+	case AttributeWithDynamicValidators:
+		return describers(a.DynamicValidators())
+	case AttributeWithFloat32Validators:
+		return describers(a.Float32Validators())
+	case AttributeWithFloat64Validators:
+		return describers(a.Float64Validators())
+	case AttributeWithInt32Validators:
+		return describers(a.Int32Validators())
+	case AttributeWithInt64Validators:
+		return describers(a.Int64Validators())
+	case AttributeWithListValidators:
+		return describers(a.ListValidators())
+	case AttributeWithMapValidators:
+		return describers(a.MapValidators())
+	case AttributeWithNumberValidators:
+		return describers(a.NumberValidators())
+	case AttributeWithObjectValidators:
+		return describers(a.ObjectValidators())
+	case AttributeWithSetValidators:
+		return describers(a.SetValidators())
+	case AttributeWithStringValidators:
+		return describers(a.StringValidators())
+	default:
+		return nil
+	}
+}
+
+// describers converts typed validators into validator.Describer.
+func describers[V validator.Describer](validators []V) []validator.Describer {
+	result := make([]validator.Describer, 0, len(validators))
+
+	for _, v := range validators {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// joinMarkdownList returns an English joining of the values.
+func joinMarkdownList(values []string) string {
+	switch len(values) {
+	case 0:
+		return ""
+	case 1:
+		return values[0]
+	case 2:
+		return values[0] + " and " + values[1]
+	default:
+		return strings.Join(values[:len(values)-1], ", ") + ", and " + values[len(values)-1]
+	}
+}
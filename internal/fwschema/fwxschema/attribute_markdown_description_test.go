@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwxschema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/enum"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestAttributeMarkdownDescription(t *testing.T) {
+	t.Parallel()
+
+	testEnum := enum.Must(map[int]string{
+		1: "one",
+		2: "two",
+		3: "three",
+	})
+
+	testCases := map[string]struct {
+		attribute fwschema.Attribute
+		disabled  bool
+		expected  string
+	}{
+		"disabled": {
+			attribute: schema.StringAttribute{
+				Computed:            true,
+				Default:             stringdefault.StaticString("test"),
+				MarkdownDescription: "Test description, {{ .Default }}.",
+				Optional:            true,
+			},
+			disabled: true,
+			expected: "Test description, {{ .Default }}.",
+		},
+		"empty": {
+			attribute: schema.StringAttribute{
+				Optional: true,
+			},
+			expected: "",
+		},
+		"no-template": {
+			attribute: schema.StringAttribute{
+				MarkdownDescription: "Test description.",
+				Optional:            true,
+			},
+			expected: "Test description.",
+		},
+		"default": {
+			attribute: schema.StringAttribute{
+				Computed:            true,
+				Default:             stringdefault.StaticString("test"),
+				MarkdownDescription: "Test description, {{ .Default }}.",
+				Optional:            true,
+			},
+			expected: "Test description, value defaults to `test`.",
+		},
+		"default-none": {
+			attribute: schema.Int64Attribute{
+				MarkdownDescription: "Test description.{{ if .Default }} Defaults: {{ .Default }}.{{ end }}",
+				Optional:            true,
+			},
+			expected: "Test description.",
+		},
+		"default-int64": {
+			attribute: schema.Int64Attribute{
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				MarkdownDescription: "Test description, {{ .Default }}.",
+				Optional:            true,
+			},
+			expected: "Test description, value defaults to `5`.",
+		},
+		"valid-values": {
+			attribute: schema.StringAttribute{
+				MarkdownDescription: "Test description. Valid values are {{ .ValidValues }}.",
+				Optional:            true,
+				Validators: []validator.String{
+					testEnum.Validator(),
+				},
+			},
+			expected: "Test description. Valid values are `one`, `three`, and `two`.",
+		},
+		"deprecation-message": {
+			attribute: schema.StringAttribute{
+				DeprecationMessage:  "Use other_attribute instead.",
+				MarkdownDescription: "Test description.{{ with .DeprecationMessage }} **Deprecated:** {{ . }}{{ end }}",
+				Optional:            true,
+			},
+			expected: "Test description. **Deprecated:** Use other_attribute instead.",
+		},
+		"configurability": {
+			attribute: schema.StringAttribute{
+				MarkdownDescription: "Test description.{{ if .Required }} Required.{{ end }}{{ if .Sensitive }} Sensitive.{{ end }}",
+				Required:            true,
+				Sensitive:           true,
+			},
+			expected: "Test description. Required. Sensitive.",
+		},
+		"invalid-template": {
+			attribute: schema.StringAttribute{
+				MarkdownDescription: "Test description {{ .Default",
+				Optional:            true,
+			},
+			expected: "Test description {{ .Default",
+		},
+		"invalid-template-field": {
+			attribute: schema.StringAttribute{
+				MarkdownDescription: "Test description {{ .Missing }}",
+				Optional:            true,
+			},
+			expected: "Test description {{ .Missing }}",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := fwcontext.WithMarkdownDescriptionTemplates(context.Background(), !testCase.disabled)
+
+			got := fwxschema.AttributeMarkdownDescription(ctx, testCase.attribute)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -32,3 +32,18 @@ func NestedAttributesEqual(a, b NestedAttribute) bool {
 
 	return a.GetNestedObject().Equal(b.GetNestedObject())
 }
+
+// NestedAttributeWithPlanKnownObject is an optional interface on
+// NestedAttribute which enables planning a known object, rather than an
+// unknown object, when a computed single nested attribute has no
+// configuration value.
+type NestedAttributeWithPlanKnownObject interface {
+	NestedAttribute
+
+	// IsPlanKnownObject should return true if the planned value of the
+	// computed attribute should be a known object when there is no
+	// configuration value. Nested attributes of the object are then planned
+	// individually, using any default value, an unknown value if computed, or
+	// a null value otherwise.
+	IsPlanKnownObject() bool
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ChangedPaths returns the paths of all attribute and block values which
+// differ between the prior data and this data, after running any semantic
+// equality logic. Object attributes and map elements of changed values are
+// checked individually, so both the parent path and the nested paths which
+// changed are returned. List and set elements are not checked individually.
+// Unknown values are not considered changed, since the actual value is not
+// yet known.
+//
+// Paths are ordered by attribute name and map key, with parent paths before
+// their nested paths.
+func (d Data) ChangedPaths(ctx context.Context, prior Data) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var changedPaths path.Paths
+
+	names := make([]string, 0, len(d.Schema.GetAttributes())+len(d.Schema.GetBlocks()))
+
+	for name := range d.Schema.GetAttributes() {
+		names = append(names, name)
+	}
+
+	for name := range d.Schema.GetBlocks() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		attributePath := path.Root(name)
+
+		priorValue, priorValueDiags := prior.ValueAtPath(ctx, attributePath)
+
+		diags.Append(priorValueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		proposedValue, proposedValueDiags := d.ValueAtPath(ctx, attributePath)
+
+		diags.Append(proposedValueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(changedValuePaths(ctx, attributePath, priorValue, proposedValue, &changedPaths)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return changedPaths, diags
+}
+
+// changedValuePaths appends the given path to changedPaths if the values are
+// not semantically equal, then any changed nested object attribute and map
+// element paths.
+func changedValuePaths(ctx context.Context, valuePath path.Path, priorValue, proposedValue attr.Value, changedPaths *path.Paths) diag.Diagnostics {
+	if proposedValue.IsUnknown() {
+		return nil
+	}
+
+	semanticEqualityReq := ValueSemanticEqualityRequest{
+		Path:             valuePath,
+		PriorValue:       priorValue,
+		ProposedNewValue: proposedValue,
+	}
+	semanticEqualityResp := &ValueSemanticEqualityResponse{}
+
+	ValueSemanticEquality(ctx, semanticEqualityReq, semanticEqualityResp)
+
+	diags := semanticEqualityResp.Diagnostics
+
+	if diags.HasError() || semanticEqualityResp.NewValue.Equal(priorValue) {
+		return diags
+	}
+
+	changedPaths.Append(valuePath)
+
+	if priorValue.IsNull() || priorValue.IsUnknown() || proposedValue.IsNull() {
+		return diags
+	}
+
+	switch proposedValue.(type) {
+	case basetypes.ObjectValuable:
+		priorAttributes, proposedAttributes, objectDiags := changedValueObjectAttributes(ctx, priorValue, proposedValue)
+
+		diags.Append(objectDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, name := range changedValueSortedKeys(proposedAttributes) {
+			priorAttribute, ok := priorAttributes[name]
+
+			if !ok {
+				continue
+			}
+
+			diags.Append(changedValuePaths(ctx, valuePath.AtName(name), priorAttribute, proposedAttributes[name], changedPaths)...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+	case basetypes.MapValuable:
+		priorElements, proposedElements, mapDiags := changedValueMapElements(ctx, priorValue, proposedValue)
+
+		diags.Append(mapDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		keys := changedValueSortedKeys(proposedElements)
+
+		for key := range priorElements {
+			if _, ok := proposedElements[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			priorElement, priorOk := priorElements[key]
+			proposedElement, proposedOk := proposedElements[key]
+
+			if !priorOk || !proposedOk {
+				changedPaths.Append(valuePath.AtMapKey(key))
+
+				continue
+			}
+
+			diags.Append(changedValuePaths(ctx, valuePath.AtMapKey(key), priorElement, proposedElement, changedPaths)...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+func changedValueObjectAttributes(ctx context.Context, priorValue, proposedValue attr.Value) (map[string]attr.Value, map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorObject, priorDiags := priorValue.(basetypes.ObjectValuable).ToObjectValue(ctx)
+
+	diags.Append(priorDiags...)
+
+	proposedObject, proposedDiags := proposedValue.(basetypes.ObjectValuable).ToObjectValue(ctx)
+
+	diags.Append(proposedDiags...)
+
+	return priorObject.Attributes(), proposedObject.Attributes(), diags
+}
+
+func changedValueMapElements(ctx context.Context, priorValue, proposedValue attr.Value) (map[string]attr.Value, map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorMap, priorDiags := priorValue.(basetypes.MapValuable).ToMapValue(ctx)
+
+	diags.Append(priorDiags...)
+
+	proposedMap, proposedDiags := proposedValue.(basetypes.MapValuable).ToMapValue(ctx)
+
+	diags.Append(proposedDiags...)
+
+	return priorMap.Elements(), proposedMap.Elements(), diags
+}
+
+// changedValueSortedKeys returns the keys of the given map in sorted order.
+func changedValueSortedKeys(m map[string]attr.Value) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataChangedPaths(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"list": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"map": testschema.Attribute{
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"object": testschema.Attribute{
+				Type: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"nested_one": types.StringType,
+						"nested_two": types.StringType,
+					},
+				},
+				Optional: true,
+			},
+			"semantic": testschema.Attribute{
+				Type: testtypes.StringTypeWithSemanticEquals{
+					SemanticEquals: true,
+				},
+				Optional: true,
+			},
+			"string": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_one": tftypes.String,
+			"nested_two": tftypes.String,
+		},
+	}
+
+	testValue := func(list []string, mapValue map[string]string, objectNestedTwo string, semantic string, str interface{}) tftypes.Value {
+		listValues := make([]tftypes.Value, 0, len(list))
+
+		for _, element := range list {
+			listValues = append(listValues, tftypes.NewValue(tftypes.String, element))
+		}
+
+		mapValues := make(map[string]tftypes.Value, len(mapValue))
+
+		for key, element := range mapValue {
+			mapValues[key] = tftypes.NewValue(tftypes.String, element)
+		}
+
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, listValues),
+			"map":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, mapValues),
+			"object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"nested_one": tftypes.NewValue(tftypes.String, "one"),
+				"nested_two": tftypes.NewValue(tftypes.String, objectNestedTwo),
+			}),
+			"semantic": tftypes.NewValue(tftypes.String, semantic),
+			"string":   tftypes.NewValue(tftypes.String, str),
+		})
+	}
+
+	testCases := map[string]struct {
+		prior         tftypes.Value
+		proposed      tftypes.Value
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"no-changes": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			expected: nil,
+		},
+		"list": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"a", "b"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			expected: path.Paths{
+				path.Root("list"),
+			},
+		},
+		"map": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a", "b": "b", "c": "c"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"a"}, map[string]string{"a": "a", "b": "changed", "d": "d"}, "two", "semantic", "string"),
+			expected: path.Paths{
+				path.Root("map"),
+				path.Root("map").AtMapKey("b"),
+				path.Root("map").AtMapKey("c"),
+				path.Root("map").AtMapKey("d"),
+			},
+		},
+		"object": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"a"}, map[string]string{"a": "a"}, "changed", "semantic", "string"),
+			expected: path.Paths{
+				path.Root("object"),
+				path.Root("object").AtName("nested_two"),
+			},
+		},
+		"semantic-equality": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "SEMANTIC", "string"),
+			expected: nil,
+		},
+		"string": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "changed"),
+			expected: path.Paths{
+				path.Root("string"),
+			},
+		},
+		"string-null": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", nil),
+			expected: path.Paths{
+				path.Root("string"),
+			},
+		},
+		"string-unknown": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", tftypes.UnknownValue),
+			expected: nil,
+		},
+		"multiple": {
+			prior:    testValue([]string{"a"}, map[string]string{"a": "a"}, "two", "semantic", "string"),
+			proposed: testValue([]string{"b"}, map[string]string{"a": "a"}, "changed", "semantic", "changed"),
+			expected: path.Paths{
+				path.Root("list"),
+				path.Root("object"),
+				path.Root("object").AtName("nested_two"),
+				path.Root("string"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prior := fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testCase.prior,
+			}
+			proposed := fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionPlan,
+				Schema:         testSchema,
+				TerraformValue: testCase.proposed,
+			}
+
+			got, diags := proposed.ChangedPaths(context.Background(), prior)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -7,20 +7,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // TransformDefaults walks the schema and applies schema defined default values
-// when configRaw contains a null value at the same path.
-func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) diag.Diagnostics {
+// when configRaw contains a null value at the same path. The functions are
+// passed to default value handlers for calling provider-defined functions.
+func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value, functions function.Caller) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var err error
 
@@ -99,314 +105,670 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 		}
 
-		switch a := attrAtPath.(type) {
-		case fwschema.AttributeWithBoolDefaultValue:
-			defaultValue := a.BoolDefaultValue()
+		defaultValue, defaultValueDiags := d.attributeDefaultValue(ctx, configData, functions, attrAtPath, fwPath, nil)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		diags.Append(defaultValueDiags...)
 
-			req := defaults.BoolRequest{
-				Path: fwPath,
+		if defaultValue == nil {
+			if !tfTypeValue.IsNull() {
+				return tfTypeValue, nil
 			}
-			resp := defaults.BoolResponse{}
 
-			defaultValue.DefaultBool(ctx, req, &resp)
+			knownObjectValue, ok, knownObjectDiags := d.plannedKnownObjectValue(ctx, configData, functions, attrAtPath, fwPath, tfTypeValue.Type())
 
-			diags.Append(resp.Diagnostics...)
+			diags.Append(knownObjectDiags...)
 
-			if resp.Diagnostics.HasError() {
+			if !ok {
 				return tfTypeValue, nil
 			}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to planned known object: %s", fwPath, knownObjectValue))
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithFloat32DefaultValue:
-			defaultValue := a.Float32DefaultValue()
+			return knownObjectValue, nil
+		}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, defaultValue))
 
-			req := defaults.Float32Request{
-				Path: fwPath,
-			}
-			resp := defaults.Float32Response{}
+		return defaultValue.ToTerraformValue(ctx)
+	})
 
-			defaultValue.DefaultFloat32(ctx, req, &resp)
+	// The transform visits object attributes and map elements in an undefined
+	// order, so sort the diagnostics for deterministic responses.
+	sortDiagnosticsByPath(diags)
 
-			diags.Append(resp.Diagnostics...)
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/930
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"Error Handling Schema Defaults",
+			"An unexpected error occurred while handling schema default values. "+
+				"Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		))
+	}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+	return diags
+}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+// plannedKnownObjectValue returns the known object value for a computed single
+// nested attribute which enables planning a known object, instead of
+// Terraform's null value. Each nested attribute is set to its default value,
+// a further planned known object value, or otherwise a null value, which is
+// later marked as unknown if the nested attribute is computed. The returned
+// bool is false if the attribute does not enable planning a known object.
+func (d Data) plannedKnownObjectValue(ctx context.Context, configData Data, functions function.Caller, attribute fwschema.Attribute, fwPath path.Path, typ tftypes.Type) (tftypes.Value, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithFloat64DefaultValue:
-			defaultValue := a.Float64DefaultValue()
+	nestedAttribute, ok := attribute.(fwschema.NestedAttributeWithPlanKnownObject)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+	if !ok || !nestedAttribute.IsPlanKnownObject() || !nestedAttribute.IsComputed() {
+		return tftypes.Value{}, false, diags
+	}
 
-			req := defaults.Float64Request{
-				Path: fwPath,
-			}
-			resp := defaults.Float64Response{}
+	if nestedAttribute.GetNestingMode() != fwschema.NestingModeSingle {
+		return tftypes.Value{}, false, diags
+	}
 
-			defaultValue.DefaultFloat64(ctx, req, &resp)
+	objectType, ok := typ.(tftypes.Object)
 
-			diags.Append(resp.Diagnostics...)
+	if !ok {
+		return tftypes.Value{}, false, diags
+	}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+	nestedAttributes := nestedAttribute.GetNestedObject().GetAttributes()
+	objectValues := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+	for _, name := range sortedKeys(objectType.AttributeTypes) {
+		attributeType := objectType.AttributeTypes[name]
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithInt32DefaultValue:
-			defaultValue := a.Int32DefaultValue()
+		objectValues[name] = tftypes.NewValue(attributeType, nil)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		nestedAttr, ok := nestedAttributes[name]
 
-			req := defaults.Int32Request{
-				Path: fwPath,
-			}
-			resp := defaults.Int32Response{}
+		if !ok {
+			continue
+		}
 
-			defaultValue.DefaultInt32(ctx, req, &resp)
+		nestedPath := fwPath.AtName(name)
 
-			diags.Append(resp.Diagnostics...)
+		defaultValue, defaultValueDiags := d.attributeDefaultValue(ctx, configData, functions, nestedAttr, nestedPath, nil)
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		diags.Append(defaultValueDiags...)
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		if defaultValue != nil {
+			defaultTerraformValue, err := defaultValue.ToTerraformValue(ctx)
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithInt64DefaultValue:
-			defaultValue := a.Int64DefaultValue()
+			if err != nil {
+				diags.AddAttributeError(
+					nestedPath,
+					"Error Handling Schema Defaults",
+					"An unexpected error occurred while handling schema default values. "+
+						"Please report the following to the provider developer:\n\n"+
+						"Error: "+err.Error(),
+				)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
+				continue
 			}
 
-			req := defaults.Int64Request{
-				Path: fwPath,
-			}
-			resp := defaults.Int64Response{}
+			objectValues[name] = defaultTerraformValue
 
-			defaultValue.DefaultInt64(ctx, req, &resp)
+			continue
+		}
 
-			diags.Append(resp.Diagnostics...)
+		knownObjectValue, ok, knownObjectDiags := d.plannedKnownObjectValue(ctx, configData, functions, nestedAttr, nestedPath, attributeType)
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		diags.Append(knownObjectDiags...)
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		if ok {
+			objectValues[name] = knownObjectValue
+		}
+	}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithListDefaultValue:
-			defaultValue := a.ListDefaultValue()
+	return tftypes.NewValue(objectType, objectValues), true, diags
+}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+// attributeDefaultValue returns the default value of the given attribute, or
+// nil if the attribute has no default value or the default value could not be
+// determined. The visited paths are the attribute paths whose default value is
+// being determined, which is used to detect default value reference cycles.
+func (d Data) attributeDefaultValue(ctx context.Context, configData Data, functions function.Caller, attribute fwschema.Attribute, fwPath path.Path, visited path.Paths) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-			req := defaults.ListRequest{
-				Path: fwPath,
-			}
-			resp := defaults.ListResponse{}
+	visited = append(slices.Clone(visited), fwPath)
 
-			defaultValue.DefaultList(ctx, req, &resp)
+	switch a := attribute.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		defaultValue := a.BoolDefaultValue()
 
-			diags.Append(resp.Diagnostics...)
+		if defaultValue == nil {
+			return nil, diags
+		}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
 
-			if resp.PlanValue.ElementType(ctx) == nil {
-				logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+		diags.Append(referenceValueDiags...)
 
-				return tfTypeValue, nil
-			}
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		req := defaults.BoolRequest{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.BoolResponse{}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithMapDefaultValue:
-			defaultValue := a.MapDefaultValue()
+		defaultValue.DefaultBool(ctx, req, &resp)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
-			req := defaults.MapRequest{
-				Path: fwPath,
-			}
-			resp := defaults.MapResponse{}
+		diags.Append(resp.Diagnostics...)
 
-			defaultValue.DefaultMap(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
 
-			diags.Append(resp.Diagnostics...)
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithFloat32DefaultValue:
+		defaultValue := a.Float32DefaultValue()
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		if defaultValue == nil {
+			return nil, diags
+		}
 
-			if resp.PlanValue.ElementType(ctx) == nil {
-				logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
 
-				return tfTypeValue, nil
-			}
+		diags.Append(referenceValueDiags...)
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithNumberDefaultValue:
-			defaultValue := a.NumberDefaultValue()
+		req := defaults.Float32Request{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.Float32Response{}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		defaultValue.DefaultFloat32(ctx, req, &resp)
 
-			req := defaults.NumberRequest{
-				Path: fwPath,
-			}
-			resp := defaults.NumberResponse{}
+		diags.Append(resp.Diagnostics...)
 
-			defaultValue.DefaultNumber(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
 
-			diags.Append(resp.Diagnostics...)
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithFloat64DefaultValue:
+		defaultValue := a.Float64DefaultValue()
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		if defaultValue == nil {
+			return nil, diags
+		}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithObjectDefaultValue:
-			defaultValue := a.ObjectDefaultValue()
+		diags.Append(referenceValueDiags...)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
 
-			req := defaults.ObjectRequest{
-				Path: fwPath,
-			}
-			resp := defaults.ObjectResponse{}
+		req := defaults.Float64Request{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.Float64Response{}
 
-			defaultValue.DefaultObject(ctx, req, &resp)
+		defaultValue.DefaultFloat64(ctx, req, &resp)
 
-			diags.Append(resp.Diagnostics...)
+		diags.Append(resp.Diagnostics...)
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithInt32DefaultValue:
+		defaultValue := a.Int32DefaultValue()
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithSetDefaultValue:
-			defaultValue := a.SetDefaultValue()
+		if defaultValue == nil {
+			return nil, diags
+		}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
 
-			req := defaults.SetRequest{
-				Path: fwPath,
-			}
-			resp := defaults.SetResponse{}
+		diags.Append(referenceValueDiags...)
 
-			defaultValue.DefaultSet(ctx, req, &resp)
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
 
-			diags.Append(resp.Diagnostics...)
+		req := defaults.Int32Request{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.Int32Response{}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		defaultValue.DefaultInt32(ctx, req, &resp)
 
-			if resp.PlanValue.ElementType(ctx) == nil {
-				logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+		diags.Append(resp.Diagnostics...)
 
-				return tfTypeValue, nil
-			}
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithInt64DefaultValue:
+		defaultValue := a.Int64DefaultValue()
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithStringDefaultValue:
-			defaultValue := a.StringDefaultValue()
+		if defaultValue == nil {
+			return nil, diags
+		}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
 
-			req := defaults.StringRequest{
-				Path: fwPath,
-			}
-			resp := defaults.StringResponse{}
+		diags.Append(referenceValueDiags...)
 
-			defaultValue.DefaultString(ctx, req, &resp)
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
 
-			diags.Append(resp.Diagnostics...)
+		req := defaults.Int64Request{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.Int64Response{}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		defaultValue.DefaultInt64(ctx, req, &resp)
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		diags.Append(resp.Diagnostics...)
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithDynamicDefaultValue:
-			defaultValue := a.DynamicDefaultValue()
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithListDefaultValue:
+		defaultValue := a.ListDefaultValue()
 
-			req := defaults.DynamicRequest{
-				Path: fwPath,
-			}
-			resp := defaults.DynamicResponse{}
+		if defaultValue == nil {
+			return nil, diags
+		}
 
-			defaultValue.DefaultDynamic(ctx, req, &resp)
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
 
-			diags.Append(resp.Diagnostics...)
+		diags.Append(referenceValueDiags...)
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
+
+		req := defaults.ListRequest{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.ListResponse{}
+
+		defaultValue.DefaultList(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
+
+		if resp.PlanValue.ElementType(ctx) == nil {
+			logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+
+			return nil, diags
+		}
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithMapDefaultValue:
+		defaultValue := a.MapDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
+
+		diags.Append(referenceValueDiags...)
+
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
+
+		req := defaults.MapRequest{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.MapResponse{}
+
+		defaultValue.DefaultMap(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
+
+		if resp.PlanValue.ElementType(ctx) == nil {
+			logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+
+			return nil, diags
+		}
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithNumberDefaultValue:
+		defaultValue := a.NumberDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
+
+		diags.Append(referenceValueDiags...)
+
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
+
+		req := defaults.NumberRequest{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.NumberResponse{}
+
+		defaultValue.DefaultNumber(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithObjectDefaultValue:
+		defaultValue := a.ObjectDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
+
+		diags.Append(referenceValueDiags...)
+
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
+
+		req := defaults.ObjectRequest{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.ObjectResponse{}
+
+		defaultValue.DefaultObject(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithSetDefaultValue:
+		defaultValue := a.SetDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
+
+		diags.Append(referenceValueDiags...)
+
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
+
+		req := defaults.SetRequest{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.SetResponse{}
+
+		defaultValue.DefaultSet(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
+
+		if resp.PlanValue.ElementType(ctx) == nil {
+			logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+
+			return nil, diags
+		}
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithStringDefaultValue:
+		defaultValue := a.StringDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
+
+		diags.Append(referenceValueDiags...)
+
+		if referenceValueDiags.HasError() {
+			return nil, diags
+		}
+
+		req := defaults.StringRequest{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.StringResponse{}
+
+		defaultValue.DefaultString(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
+
+		return resp.PlanValue, diags
+	case fwschema.AttributeWithDynamicDefaultValue:
+		defaultValue := a.DynamicDefaultValue()
+
+		if defaultValue == nil {
+			return nil, diags
+		}
+
+		referenceValue, referenceValues, referenceValueDiags := d.defaultReferenceValues(ctx, configData, functions, fwPath, defaultValue, visited)
+
+		diags.Append(referenceValueDiags...)
 
-			return resp.PlanValue.ToTerraformValue(ctx)
+		if referenceValueDiags.HasError() {
+			return nil, diags
 		}
 
-		return tfTypeValue, nil
+		req := defaults.DynamicRequest{
+			Functions:       functions,
+			Path:            fwPath,
+			ReferenceValue:  referenceValue,
+			ReferenceValues: referenceValues,
+		}
+		resp := defaults.DynamicResponse{}
+
+		defaultValue.DefaultDynamic(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return nil, diags
+		}
+
+		return resp.PlanValue, diags
+	}
+
+	return nil, diags
+}
+
+// defaultReferenceValues returns the resolved values of the attributes
+// referenced by the given default value handler. The single value is returned
+// if the handler implements defaults.Reference and the multiple values are
+// returned if the handler implements defaults.References, such as handlers
+// combining other handlers with references.
+func (d Data) defaultReferenceValues(ctx context.Context, configData Data, functions function.Caller, fwPath path.Path, defaultValue any, visited path.Paths) (attr.Value, []attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch reference := defaultValue.(type) {
+	case defaults.Reference:
+		referenceValue, referenceValueDiags := d.defaultReferenceValue(ctx, configData, functions, fwPath, reference.ReferencePath(ctx), visited)
+
+		diags.Append(referenceValueDiags...)
+
+		return referenceValue, nil, diags
+	case defaults.References:
+		referencePaths := reference.ReferencePaths(ctx)
+		referenceValues := make([]attr.Value, 0, len(referencePaths))
+
+		for _, referencePath := range referencePaths {
+			referenceValue, referenceValueDiags := d.defaultReferenceValue(ctx, configData, functions, fwPath, referencePath, visited)
+
+			diags.Append(referenceValueDiags...)
+
+			if diags.HasError() {
+				return nil, nil, diags
+			}
+
+			referenceValues = append(referenceValues, referenceValue)
+		}
+
+		return nil, referenceValues, diags
+	}
+
+	return nil, nil, diags
+}
+
+// defaultReferenceValue returns the resolved value of the attribute matching
+// the reference path expression of a default value handler. The resolved
+// value is the configuration value, or if null, the default value of the
+// referenced attribute.
+func (d Data) defaultReferenceValue(ctx context.Context, configData Data, functions function.Caller, fwPath path.Path, expression path.Expression, visited path.Paths) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	referenceExpression := fwPath.Expression().Merge(expression).Resolve()
+
+	referencePaths, referencePathsDiags := configData.PathMatches(ctx, referenceExpression)
+
+	diags.Append(referencePathsDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(referencePaths) != 1 {
+		diags.AddAttributeError(
+			fwPath,
+			"Invalid Default Value Reference",
+			"The attribute default value references an expression which does not match exactly one attribute. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Expression: %s\n", referenceExpression)+
+				fmt.Sprintf("Matches: %d", len(referencePaths)),
+		)
+
+		return nil, diags
+	}
+
+	referencePath := referencePaths[0]
+
+	if visited.Contains(referencePath) {
+		diags.AddAttributeError(
+			fwPath,
+			"Invalid Default Value Reference",
+			"The attribute default value references an attribute whose default value references back to this attribute. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Reference Cycle: %s", append(slices.Clone(visited), referencePath)),
+		)
+
+		return nil, diags
+	}
+
+	configValue, configValueDiags := configData.ValueAtPath(ctx, referencePath)
+
+	diags.Append(configValueDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !configValue.IsNull() {
+		return configValue, diags
+	}
+
+	referenceAttribute, referenceAttributeDiags := d.Schema.AttributeAtPath(ctx, referencePath)
+
+	// Blocks and values inside attributes have no default values.
+	if referenceAttributeDiags.HasError() {
+		return configValue, diags
+	}
+
+	referenceDefaultValue, referenceDefaultValueDiags := d.attributeDefaultValue(ctx, configData, functions, referenceAttribute, referencePath, visited)
+
+	diags.Append(referenceDefaultValueDiags...)
+
+	if referenceDefaultValue == nil {
+		return configValue, diags
+	}
+
+	return referenceDefaultValue, diags
+}
+
+// sortDiagnosticsByPath sorts diagnostics with an attribute path by the path
+// string. Diagnostics without a path are sorted first, in their existing
+// order.
+func sortDiagnosticsByPath(diags diag.Diagnostics) {
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diagnosticPath(diags[i]) < diagnosticPath(diags[j])
 	})
+}
 
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/930
-	if err != nil {
-		diags.Append(diag.NewErrorDiagnostic(
-			"Error Handling Schema Defaults",
-			"An unexpected error occurred while handling schema default values. "+
-				"Please report the following to the provider developer:\n\n"+
-				"Error: "+err.Error(),
-		))
+// diagnosticPath returns the attribute path string of the diagnostic, if any.
+func diagnosticPath(d diag.Diagnostic) string {
+	diagWithPath, ok := d.(diag.DiagnosticWithPath)
+
+	if !ok {
+		return ""
 	}
 
-	return diags
+	return diagWithPath.Path().String()
 }
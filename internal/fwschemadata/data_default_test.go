@@ -42,6 +42,76 @@ func TestDataDefault(t *testing.T) {
 	var float32AttributeValue float32 = 1.2345
 	var float32DefaultValue float32 = 5.4321
 
+	blockObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string_attribute": tftypes.String,
+		},
+	}
+
+	blockSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"list_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"string_attribute": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("two"),
+						},
+					},
+				},
+			},
+			"set_block": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"string_attribute": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("two"),
+						},
+					},
+				},
+			},
+			"single_block": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"string_attribute": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("two"),
+					},
+				},
+			},
+		},
+	}
+
+	blockSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list_block":   tftypes.List{ElementType: blockObjectType},
+			"set_block":    tftypes.Set{ElementType: blockObjectType},
+			"single_block": blockObjectType,
+		},
+	}
+
+	blockValue := func(listValue, setValue, singleValue *string) tftypes.Value {
+		object := func(value *string) tftypes.Value {
+			var raw any
+
+			if value != nil {
+				raw = *value
+			}
+
+			return tftypes.NewValue(blockObjectType, map[string]tftypes.Value{
+				"string_attribute": tftypes.NewValue(tftypes.String, raw),
+			})
+		}
+
+		return tftypes.NewValue(blockSchemaType, map[string]tftypes.Value{
+			"list_block":   tftypes.NewValue(tftypes.List{ElementType: blockObjectType}, []tftypes.Value{object(listValue)}),
+			"set_block":    tftypes.NewValue(tftypes.Set{ElementType: blockObjectType}, []tftypes.Value{object(setValue)}),
+			"single_block": object(singleValue),
+		})
+	}
+
 	testCases := map[string]struct {
 		data          *fwschemadata.Data
 		rawConfig     tftypes.Value
@@ -5355,6 +5425,57 @@ func TestDataDefault(t *testing.T) {
 				),
 			},
 		},
+		"nested-block-attributes-null-modified-default": {
+			data: &fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionPlan,
+				Schema:         blockSchema,
+				TerraformValue: blockValue(nil, nil, nil),
+			},
+			rawConfig: blockValue(nil, nil, nil),
+			expected: &fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionPlan,
+				Schema:         blockSchema,
+				TerraformValue: blockValue(pointer("two"), pointer("two"), pointer("two")),
+			},
+		},
+		"nested-block-attributes-not-null-unmodified-default": {
+			data: &fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionPlan,
+				Schema:         blockSchema,
+				TerraformValue: blockValue(pointer("one"), pointer("one"), pointer("one")),
+			},
+			rawConfig: blockValue(pointer("one"), pointer("one"), pointer("one")),
+			expected: &fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionPlan,
+				Schema:         blockSchema,
+				TerraformValue: blockValue(pointer("one"), pointer("one"), pointer("one")),
+			},
+		},
+		"nested-block-null-unmodified": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      blockSchema,
+				TerraformValue: tftypes.NewValue(blockSchemaType, map[string]tftypes.Value{
+					"list_block":   tftypes.NewValue(tftypes.List{ElementType: blockObjectType}, []tftypes.Value{}),
+					"set_block":    tftypes.NewValue(tftypes.Set{ElementType: blockObjectType}, []tftypes.Value{}),
+					"single_block": tftypes.NewValue(blockObjectType, nil),
+				}),
+			},
+			rawConfig: tftypes.NewValue(blockSchemaType, map[string]tftypes.Value{
+				"list_block":   tftypes.NewValue(tftypes.List{ElementType: blockObjectType}, []tftypes.Value{}),
+				"set_block":    tftypes.NewValue(tftypes.Set{ElementType: blockObjectType}, []tftypes.Value{}),
+				"single_block": tftypes.NewValue(blockObjectType, nil),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      blockSchema,
+				TerraformValue: tftypes.NewValue(blockSchemaType, map[string]tftypes.Value{
+					"list_block":   tftypes.NewValue(tftypes.List{ElementType: blockObjectType}, []tftypes.Value{}),
+					"set_block":    tftypes.NewValue(tftypes.Set{ElementType: blockObjectType}, []tftypes.Value{}),
+					"single_block": tftypes.NewValue(blockObjectType, nil),
+				}),
+			},
+		},
 		"list-nested-attribute-not-null-unmodified-default": {
 			data: &fwschemadata.Data{
 				Description: fwschemadata.DataDescriptionState,
@@ -10394,7 +10515,723 @@ func TestDataDefault(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := testCase.data.TransformDefaults(context.Background(), testCase.rawConfig)
+			diags := testCase.data.TransformDefaults(context.Background(), testCase.rawConfig, nil)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.data, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataDefault_Reference(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.FromSibling("name"),
+			},
+			"short_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.FromSibling("display_name"),
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":         tftypes.String,
+			"display_name": tftypes.String,
+			"short_name":   tftypes.String,
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list": tftypes.List{
+				ElementType: testType,
+			},
+		},
+	}
+
+	testNestedSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"list": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: testSchema.Attributes,
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testPathNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"display_name": tftypes.String,
+		},
+	}
+
+	testPathType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"list": tftypes.List{
+				ElementType: testPathNestedType,
+			},
+		},
+	}
+
+	testPathSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"list": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"display_name": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromPath(path.MatchRoot("name")),
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testRelativeSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"list": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"display_name": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromPath(path.MatchRelative().AtParent().AtParent().AtParent().AtName("name")),
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testMultipleNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testMultipleType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list": tftypes.List{
+				ElementType: testMultipleNestedType,
+			},
+			"test": tftypes.String,
+		},
+	}
+
+	testMultipleSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"list": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"test": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.FromPath(path.MatchRoot("list").AtAnyListIndex().AtName("name")),
+			},
+		},
+	}
+
+	testFirstNonNullType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":         tftypes.String,
+			"display_name": tftypes.String,
+		},
+	}
+
+	testFirstNonNullSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default: stringdefault.FirstNonNull(
+					stringdefault.FromSibling("name"),
+					stringdefault.StaticString("test-static"),
+				),
+			},
+		},
+	}
+
+	testMismatchType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"test":    tftypes.String,
+		},
+	}
+
+	testMismatchSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"test": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.FromSibling("enabled"),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		data          *fwschemadata.Data
+		rawConfig     tftypes.Value
+		expected      *fwschemadata.Data
+		expectedDiags diag.Diagnostics
+	}{
+		"sibling-config": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, "test-name"),
+					"display_name": tftypes.NewValue(tftypes.String, nil),
+					"short_name":   tftypes.NewValue(tftypes.String, "test-short-name"),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name":         tftypes.NewValue(tftypes.String, "test-name"),
+				"display_name": tftypes.NewValue(tftypes.String, nil),
+				"short_name":   tftypes.NewValue(tftypes.String, "test-short-name"),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, "test-name"),
+					"display_name": tftypes.NewValue(tftypes.String, "test-name"),
+					"short_name":   tftypes.NewValue(tftypes.String, "test-short-name"),
+				}),
+			},
+		},
+		"sibling-default": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, "test-name"),
+					"display_name": tftypes.NewValue(tftypes.String, nil),
+					"short_name":   tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name":         tftypes.NewValue(tftypes.String, "test-name"),
+				"display_name": tftypes.NewValue(tftypes.String, nil),
+				"short_name":   tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, "test-name"),
+					"display_name": tftypes.NewValue(tftypes.String, "test-name"),
+					"short_name":   tftypes.NewValue(tftypes.String, "test-name"),
+				}),
+			},
+		},
+		"sibling-null": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, nil),
+					"display_name": tftypes.NewValue(tftypes.String, "test-prior-display-name"),
+					"short_name":   tftypes.NewValue(tftypes.String, "test-short-name"),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name":         tftypes.NewValue(tftypes.String, nil),
+				"display_name": tftypes.NewValue(tftypes.String, nil),
+				"short_name":   tftypes.NewValue(tftypes.String, "test-short-name"),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, nil),
+					"display_name": tftypes.NewValue(tftypes.String, nil),
+					"short_name":   tftypes.NewValue(tftypes.String, "test-short-name"),
+				}),
+			},
+		},
+		"nested": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testNestedSchema,
+				TerraformValue: tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"list": tftypes.NewValue(tftypes.List{ElementType: testType}, []tftypes.Value{
+						tftypes.NewValue(testType, map[string]tftypes.Value{
+							"name":         tftypes.NewValue(tftypes.String, "test-name-0"),
+							"display_name": tftypes.NewValue(tftypes.String, nil),
+							"short_name":   tftypes.NewValue(tftypes.String, "test-short-name-0"),
+						}),
+						tftypes.NewValue(testType, map[string]tftypes.Value{
+							"name":         tftypes.NewValue(tftypes.String, "test-name-1"),
+							"display_name": tftypes.NewValue(tftypes.String, nil),
+							"short_name":   tftypes.NewValue(tftypes.String, "test-short-name-1"),
+						}),
+					}),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: testType}, []tftypes.Value{
+					tftypes.NewValue(testType, map[string]tftypes.Value{
+						"name":         tftypes.NewValue(tftypes.String, "test-name-0"),
+						"display_name": tftypes.NewValue(tftypes.String, nil),
+						"short_name":   tftypes.NewValue(tftypes.String, "test-short-name-0"),
+					}),
+					tftypes.NewValue(testType, map[string]tftypes.Value{
+						"name":         tftypes.NewValue(tftypes.String, "test-name-1"),
+						"display_name": tftypes.NewValue(tftypes.String, nil),
+						"short_name":   tftypes.NewValue(tftypes.String, "test-short-name-1"),
+					}),
+				}),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testNestedSchema,
+				TerraformValue: tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"list": tftypes.NewValue(tftypes.List{ElementType: testType}, []tftypes.Value{
+						tftypes.NewValue(testType, map[string]tftypes.Value{
+							"name":         tftypes.NewValue(tftypes.String, "test-name-0"),
+							"display_name": tftypes.NewValue(tftypes.String, "test-name-0"),
+							"short_name":   tftypes.NewValue(tftypes.String, "test-short-name-0"),
+						}),
+						tftypes.NewValue(testType, map[string]tftypes.Value{
+							"name":         tftypes.NewValue(tftypes.String, "test-name-1"),
+							"display_name": tftypes.NewValue(tftypes.String, "test-name-1"),
+							"short_name":   tftypes.NewValue(tftypes.String, "test-short-name-1"),
+						}),
+					}),
+				}),
+			},
+		},
+		"cycle": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"first": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromSibling("second"),
+						},
+						"second": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromSibling("first"),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"first":  tftypes.String,
+						"second": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"first":  tftypes.NewValue(tftypes.String, nil),
+					"second": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+			},
+			rawConfig: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"first":  tftypes.String,
+					"second": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"first":  tftypes.NewValue(tftypes.String, nil),
+				"second": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"first": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromSibling("second"),
+						},
+						"second": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromSibling("first"),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"first":  tftypes.String,
+						"second": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"first":  tftypes.NewValue(tftypes.String, "test-value"),
+					"second": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+			},
+		},
+		"cycle-error": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"test": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromSibling("test"),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			rawConfig: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"test": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromSibling("test"),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Default Value Reference",
+					"The attribute default value references an attribute whose default value references back to this attribute. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Reference Cycle: [test,test]",
+				),
+			},
+		},
+		"path-root-from-nested": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testPathSchema,
+				TerraformValue: tftypes.NewValue(testPathType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-name"),
+					"list": tftypes.NewValue(tftypes.List{ElementType: testPathNestedType}, []tftypes.Value{
+						tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+							"display_name": tftypes.NewValue(tftypes.String, nil),
+						}),
+						tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+							"display_name": tftypes.NewValue(tftypes.String, "test-display-name"),
+						}),
+					}),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testPathType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test-name"),
+				"list": tftypes.NewValue(tftypes.List{ElementType: testPathNestedType}, []tftypes.Value{
+					tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+						"display_name": tftypes.NewValue(tftypes.String, nil),
+					}),
+					tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+						"display_name": tftypes.NewValue(tftypes.String, "test-display-name"),
+					}),
+				}),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testPathSchema,
+				TerraformValue: tftypes.NewValue(testPathType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-name"),
+					"list": tftypes.NewValue(tftypes.List{ElementType: testPathNestedType}, []tftypes.Value{
+						tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+							"display_name": tftypes.NewValue(tftypes.String, "test-name"),
+						}),
+						tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+							"display_name": tftypes.NewValue(tftypes.String, "test-display-name"),
+						}),
+					}),
+				}),
+			},
+		},
+		"path-relative-from-nested": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testRelativeSchema,
+				TerraformValue: tftypes.NewValue(testPathType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-name"),
+					"list": tftypes.NewValue(tftypes.List{ElementType: testPathNestedType}, []tftypes.Value{
+						tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+							"display_name": tftypes.NewValue(tftypes.String, nil),
+						}),
+					}),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testPathType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test-name"),
+				"list": tftypes.NewValue(tftypes.List{ElementType: testPathNestedType}, []tftypes.Value{
+					tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+						"display_name": tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testRelativeSchema,
+				TerraformValue: tftypes.NewValue(testPathType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test-name"),
+					"list": tftypes.NewValue(tftypes.List{ElementType: testPathNestedType}, []tftypes.Value{
+						tftypes.NewValue(testPathNestedType, map[string]tftypes.Value{
+							"display_name": tftypes.NewValue(tftypes.String, "test-name"),
+						}),
+					}),
+				}),
+			},
+		},
+		"first-non-null-reference": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testFirstNonNullSchema,
+				TerraformValue: tftypes.NewValue(testFirstNonNullType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, "test-name"),
+					"display_name": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testFirstNonNullType, map[string]tftypes.Value{
+				"name":         tftypes.NewValue(tftypes.String, "test-name"),
+				"display_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testFirstNonNullSchema,
+				TerraformValue: tftypes.NewValue(testFirstNonNullType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, "test-name"),
+					"display_name": tftypes.NewValue(tftypes.String, "test-name"),
+				}),
+			},
+		},
+		"first-non-null-reference-null": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testFirstNonNullSchema,
+				TerraformValue: tftypes.NewValue(testFirstNonNullType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, nil),
+					"display_name": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testFirstNonNullType, map[string]tftypes.Value{
+				"name":         tftypes.NewValue(tftypes.String, nil),
+				"display_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testFirstNonNullSchema,
+				TerraformValue: tftypes.NewValue(testFirstNonNullType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, nil),
+					"display_name": tftypes.NewValue(tftypes.String, "test-static"),
+				}),
+			},
+		},
+		"multiple-matches": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testMultipleSchema,
+				TerraformValue: tftypes.NewValue(testMultipleType, map[string]tftypes.Value{
+					"list": tftypes.NewValue(tftypes.List{ElementType: testMultipleNestedType}, []tftypes.Value{
+						tftypes.NewValue(testMultipleNestedType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "test-name-0"),
+						}),
+						tftypes.NewValue(testMultipleNestedType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "test-name-1"),
+						}),
+					}),
+					"test": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testMultipleType, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: testMultipleNestedType}, []tftypes.Value{
+					tftypes.NewValue(testMultipleNestedType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "test-name-0"),
+					}),
+					tftypes.NewValue(testMultipleNestedType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "test-name-1"),
+					}),
+				}),
+				"test": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testMultipleSchema,
+				TerraformValue: tftypes.NewValue(testMultipleType, map[string]tftypes.Value{
+					"list": tftypes.NewValue(tftypes.List{ElementType: testMultipleNestedType}, []tftypes.Value{
+						tftypes.NewValue(testMultipleNestedType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "test-name-0"),
+						}),
+						tftypes.NewValue(testMultipleNestedType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "test-name-1"),
+						}),
+					}),
+					"test": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Default Value Reference",
+					"The attribute default value references an expression which does not match exactly one attribute. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Expression: list[*].name\n"+
+						"Matches: 2",
+				),
+			},
+		},
+		"reference-type-mismatch": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testMismatchSchema,
+				TerraformValue: tftypes.NewValue(testMismatchType, map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"test":    tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testMismatchType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"test":    tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testMismatchSchema,
+				TerraformValue: tftypes.NewValue(testMismatchType, map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"test":    tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Default Value Reference",
+					"The attribute default value references an attribute with an unexpected value type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Expected: string value\n"+
+						"Got: basetypes.BoolValue",
+				),
+			},
+		},
+		"invalid-reference": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"test": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromSibling("missing"),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			rawConfig: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"test": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.FromSibling("missing"),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: missing",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.data.TransformDefaults(context.Background(), testCase.rawConfig, nil)
 
 			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics difference: %s", diff)
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ExpandDiagnosticPathExpressions returns the given diagnostics with each
+// diag.DiagnosticWithPathExpression replaced by one diag.DiagnosticWithPath
+// per path matching the expression in the data. Diagnostics with expressions
+// that do not match any paths, or are invalid for the schema, are returned
+// unmodified.
+func (d Data) ExpandDiagnosticPathExpressions(ctx context.Context, diags diag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	for _, diagnostic := range diags {
+		diagWithPathExpression, ok := diagnostic.(diag.DiagnosticWithPathExpression)
+
+		if !ok {
+			result.Append(diagnostic)
+
+			continue
+		}
+
+		matchedPaths, matchedPathsDiags := d.PathMatches(ctx, diagWithPathExpression.PathExpression().Resolve())
+
+		if matchedPathsDiags.HasError() || len(matchedPaths) == 0 {
+			result.Append(diagnostic)
+
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			result.Append(diag.WithPath(matchedPath, diagWithPathExpression))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataExpandDiagnosticPathExpressions(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_list": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"test_child": testschema.Attribute{
+							Type: types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeList,
+			},
+			"test_string": testschema.Attribute{
+				Type: types.StringType,
+			},
+		},
+	}
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_child": tftypes.String,
+		},
+	}
+	testValue := tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"test_list":   tftypes.List{ElementType: testObjectType},
+				"test_string": tftypes.String,
+			},
+		},
+		map[string]tftypes.Value{
+			"test_list": tftypes.NewValue(
+				tftypes.List{ElementType: testObjectType},
+				[]tftypes.Value{
+					tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+						"test_child": tftypes.NewValue(tftypes.String, "one"),
+					}),
+					tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+						"test_child": tftypes.NewValue(tftypes.String, "two"),
+					}),
+				},
+			),
+			"test_string": tftypes.NewValue(tftypes.String, "test-value"),
+		},
+	)
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"no-expressions": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_string"), "test summary", "test detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_string"), "test summary", "test detail"),
+			},
+		},
+		"expression-single-match": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("test_string"), "test summary", "test detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_string"), "test summary", "test detail"),
+			},
+		},
+		"expression-multiple-matches": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnosticForExpression(
+					path.MatchRoot("test_list").AtAnyListIndex().AtName("test_child"),
+					"test summary",
+					"test detail",
+				),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test_list").AtListIndex(0).AtName("test_child"), "test summary", "test detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test_list").AtListIndex(1).AtName("test_child"), "test summary", "test detail"),
+			},
+		},
+		"expression-invalid": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("not_test"), "test summary", "test detail"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnosticForExpression(path.MatchRoot("not_test"), "test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			}
+
+			got := data.ExpandDiagnosticPathExpressions(context.Background(), testCase.diags)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
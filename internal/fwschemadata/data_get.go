@@ -15,3 +15,9 @@ import (
 func (d Data) Get(ctx context.Context, target any) diag.Diagnostics {
 	return reflect.Into(ctx, d.Schema.Type(), d.TerraformValue, target, reflect.Options{}, path.Empty())
 }
+
+// GetWithOptions populates the struct passed as `target` with the entire
+// state, using the given reflection options.
+func (d Data) GetWithOptions(ctx context.Context, target any, opts reflect.Options) diag.Diagnostics {
+	return reflect.Into(ctx, d.Schema.Type(), d.TerraformValue, target, opts, path.Empty())
+}
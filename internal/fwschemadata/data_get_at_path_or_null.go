@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	reflectpkg "reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// GetAtPathOrNull retrieves the attribute found at `path` and populates the
+// `target` with the value, returning true, if the value and all its parents
+// are known and not null. Otherwise, including when a collection element or
+// map key does not exist, the `target` is set to its zero value and false is
+// returned without diagnostics. For `*attr.Value` targets, the zero value is
+// the null value of the attribute type.
+//
+// Diagnostics are still returned if the path is not valid for the schema or
+// the value cannot be populated into the target.
+func (d Data) GetAtPathOrNull(ctx context.Context, schemaPath path.Path, target any) (bool, diag.Diagnostics) {
+	ctx = logging.FrameworkWithAttributePath(ctx, schemaPath.String())
+
+	var diags diag.Diagnostics
+
+	targetValue := reflectpkg.ValueOf(target)
+
+	if targetValue.Kind() != reflectpkg.Pointer || targetValue.IsNil() {
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Target must be a non-nil pointer, got: %T", target),
+		)
+		return false, diags
+	}
+
+	tftypesPath, tftypesPathDiags := totftypes.AttributePath(ctx, schemaPath)
+
+	diags.Append(tftypesPathDiags...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	attrType, err := d.Schema.TypeAtTerraformPath(ctx, tftypesPath)
+
+	if err != nil {
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+		return false, diags
+	}
+
+	tfValue, err := d.TerraformValueAtTerraformPath(ctx, tftypesPath)
+
+	// Null and unknown parents, along with missing collection elements, are
+	// invalid steps.
+	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to retrieve an attribute value from the given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return false, diags
+	}
+
+	if err == nil && tfValue.IsKnown() && !tfValue.IsNull() {
+		diags.Append(d.GetAtPath(ctx, schemaPath, target)...)
+
+		return !diags.HasError(), diags
+	}
+
+	if !reflect.IsGenericAttrValue(ctx, target) {
+		targetValue.Elem().Set(reflectpkg.Zero(targetValue.Elem().Type()))
+
+		return false, diags
+	}
+
+	nullValue, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+
+	if err != nil {
+		diags.AddAttributeError(
+			schemaPath,
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to create a null attribute value from the given path. "+
+				"Please report the following to the provider developer:\n\n"+
+				"Type: "+attrType.String()+"\n"+
+				"Error:"+err.Error(),
+		)
+		return false, diags
+	}
+
+	//nolint:forcetypeassert // Type assertion is guaranteed by the above `reflect.IsGenericAttrValue` function
+	*(target.(*attr.Value)) = nullValue
+
+	return false, diags
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataGetAtPathOrNull(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"list": testschema.Attribute{
+				Optional: true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"object": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list": tftypes.List{
+				ElementType: tftypes.String,
+			},
+			"object": testNestedType,
+		},
+	}
+
+	testValue := func(list tftypes.Value, object tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"list":   list,
+			"object": object,
+		})
+	}
+
+	testNullList := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+
+	testKnownList := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "test-element"),
+	})
+
+	testKnownObject := tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+		"nested": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testCases := map[string]struct {
+		data          fwschemadata.Data
+		path          path.Path
+		target        any
+		expected      any
+		expectedFound bool
+		expectedDiags diag.Diagnostics
+	}{
+		"invalid-path": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testNullList, tftypes.NewValue(testNestedType, nil)),
+			},
+			path:     path.Root("not-test"),
+			target:   new(string),
+			expected: new(string),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("not-test"),
+					"Data Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"not-test\") still remains in the path: could not find attribute or block \"not-test\" in schema",
+				),
+			},
+		},
+		"invalid-target": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testNullList, tftypes.NewValue(testNestedType, nil)),
+			},
+			path:     path.Root("object").AtName("nested"),
+			target:   types.String{},
+			expected: types.String{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("object").AtName("nested"),
+					"Data Read Error",
+					"An unexpected error was encountered trying to read an attribute from the data. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Target must be a non-nil pointer, got: basetypes.StringValue",
+				),
+			},
+		},
+		"null-root": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: tftypes.NewValue(testType, nil),
+			},
+			path:     path.Root("object").AtName("nested"),
+			target:   new(types.String),
+			expected: new(types.String),
+		},
+		"null-parent-attr-value": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testNullList, tftypes.NewValue(testNestedType, nil)),
+			},
+			path:     path.Root("object").AtName("nested"),
+			target:   new(attr.Value),
+			expected: pointer(attr.Value(types.StringNull())),
+		},
+		"null-parent-primitive": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testNullList, tftypes.NewValue(testNestedType, nil)),
+			},
+			path:     path.Root("object").AtName("nested"),
+			target:   pointer("previous-value"),
+			expected: new(string),
+		},
+		"null-parent-string": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testNullList, tftypes.NewValue(testNestedType, nil)),
+			},
+			path:     path.Root("object").AtName("nested"),
+			target:   pointer(types.StringValue("previous-value")),
+			expected: pointer(types.StringNull()),
+		},
+		"null-value": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: testValue(testNullList, tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.String, nil),
+				})),
+			},
+			path:     path.Root("object").AtName("nested"),
+			target:   new(string),
+			expected: new(string),
+		},
+		"unknown-parent": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testNullList, tftypes.NewValue(testNestedType, tftypes.UnknownValue)),
+			},
+			path:     path.Root("object").AtName("nested"),
+			target:   new(types.String),
+			expected: new(types.String),
+		},
+		"unknown-value": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: testValue(testNullList, tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				})),
+			},
+			path:     path.Root("object").AtName("nested"),
+			target:   new(attr.Value),
+			expected: pointer(attr.Value(types.StringNull())),
+		},
+		"missing-element": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testKnownList, testKnownObject),
+			},
+			path:     path.Root("list").AtListIndex(1),
+			target:   new(string),
+			expected: new(string),
+		},
+		"known-element": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testKnownList, testKnownObject),
+			},
+			path:          path.Root("list").AtListIndex(0),
+			target:        new(string),
+			expected:      pointer("test-element"),
+			expectedFound: true,
+		},
+		"known-value": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue(testKnownList, testKnownObject),
+			},
+			path:          path.Root("object").AtName("nested"),
+			target:        new(types.String),
+			expected:      pointer(types.StringValue("test-value")),
+			expectedFound: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			found, diags := tc.data.GetAtPathOrNull(context.Background(), tc.path, tc.target)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if found != tc.expectedFound {
+				t.Errorf("expected found %t, got %t", tc.expectedFound, found)
+			}
+
+			if diff := cmp.Diff(tc.target, tc.expected, cmp.Transformer("types", func(in *types.String) types.String { return *in })); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
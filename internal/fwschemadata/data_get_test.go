@@ -28,6 +28,11 @@ func TestDataGet(t *testing.T) {
 
 	var float32Value float32 = 1.2
 
+	// CommonModel represents a model fragment shared across data models.
+	type CommonModel struct {
+		Tags types.Map `tfsdk:"tags"`
+	}
+
 	testCases := map[string]struct {
 		data          fwschemadata.Data
 		target        any
@@ -193,6 +198,57 @@ func TestDataGet(t *testing.T) {
 				testtypes.TestWarningDiagnostic(path.Root("string")),
 			},
 		},
+		"embedded-struct": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"string": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+						"tags": testschema.Attribute{
+							Optional: true,
+							Type:     types.MapType{ElemType: types.StringType},
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"string": tftypes.String,
+							"tags":   tftypes.Map{ElementType: tftypes.String},
+						},
+					},
+					map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, "test"),
+						"tags": tftypes.NewValue(
+							tftypes.Map{ElementType: tftypes.String},
+							map[string]tftypes.Value{
+								"key": tftypes.NewValue(tftypes.String, "value"),
+							},
+						),
+					},
+				),
+			},
+			target: new(struct {
+				CommonModel
+				String types.String `tfsdk:"string"`
+			}),
+			expected: &struct {
+				CommonModel
+				String types.String `tfsdk:"string"`
+			}{
+				CommonModel: CommonModel{
+					Tags: types.MapValueMust(
+						types.StringType,
+						map[string]attr.Value{
+							"key": types.StringValue("value"),
+						},
+					),
+				},
+				String: types.StringValue("test"),
+			},
+		},
 		"multiple-attributes": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
@@ -3309,6 +3365,84 @@ func TestDataGet(t *testing.T) {
 				),
 			},
 		},
+		"ListType-*[]string-null": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"list": testschema.Attribute{
+							Optional: true,
+							Type: types.ListType{
+								ElemType: types.StringType,
+							},
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"list": tftypes.List{
+								ElementType: tftypes.String,
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"list": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.String,
+							},
+							nil,
+						),
+					},
+				),
+			},
+			target: new(struct {
+				List *[]string `tfsdk:"list"`
+			}),
+			expected: &struct {
+				List *[]string `tfsdk:"list"`
+			}{
+				List: nil,
+			},
+		},
+		"ListType-*[]string-empty": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"list": testschema.Attribute{
+							Optional: true,
+							Type: types.ListType{
+								ElemType: types.StringType,
+							},
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"list": tftypes.List{
+								ElementType: tftypes.String,
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"list": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.String,
+							},
+							[]tftypes.Value{},
+						),
+					},
+				),
+			},
+			target: new(struct {
+				List *[]string `tfsdk:"list"`
+			}),
+			expected: &struct {
+				List *[]string `tfsdk:"list"`
+			}{
+				List: &[]string{},
+			},
+		},
 		"ListType-[]string-value": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
@@ -7641,6 +7775,68 @@ func TestDataGet(t *testing.T) {
 				String: types.StringValue("test"),
 			},
 		},
+		"StringType-custom-type-value": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"string": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"string": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, "test"),
+					},
+				),
+			},
+			target: new(struct {
+				String testtypes.StringValueWithSemanticEquals `tfsdk:"string"`
+			}),
+			expected: &struct {
+				String testtypes.StringValueWithSemanticEquals `tfsdk:"string"`
+			}{
+				String: testtypes.StringValueWithSemanticEquals{
+					StringValue: types.StringValue("test"),
+				},
+			},
+		},
+		"StringTypeWithSemanticEquals-types.string-value": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"string": testschema.Attribute{
+							Optional: true,
+							Type:     testtypes.StringTypeWithSemanticEquals{},
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"string": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"string": tftypes.NewValue(tftypes.String, "test"),
+					},
+				),
+			},
+			target: new(struct {
+				String types.String `tfsdk:"string"`
+			}),
+			expected: &struct {
+				String types.String `tfsdk:"string"`
+			}{
+				String: types.StringValue("test"),
+			},
+		},
 		"StringType-*string-null": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// RemoveAtPath removes the value at `path`.
+//
+// The attribute path must be valid with the current schema. Object attributes
+// and blocks cannot be removed from their parent object, so they are set to
+// null instead. Map keys, list elements, and set elements are removed from
+// their parent collection, with later list elements shifting down by one
+// index. If there is no value at the path, including when a parent value is
+// null, this is a no-op.
+func (d *Data) RemoveAtPath(ctx context.Context, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
+
+	tftypesPath, tftypesPathDiags := totftypes.AttributePath(ctx, path)
+
+	diags.Append(tftypesPathDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := d.Schema.TypeAtTerraformPath(ctx, tftypesPath)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	exists, existsDiags := d.PathExists(ctx, path)
+
+	diags.Append(existsDiags...)
+
+	if diags.HasError() || !exists {
+		return diags
+	}
+
+	var elementStep bool
+
+	switch tftypesPath.LastStep().(type) {
+	case tftypes.ElementKeyInt, tftypes.ElementKeyString, tftypes.ElementKeyValue:
+		elementStep = true
+	}
+
+	if !elementStep {
+		diags.Append(d.SetNullAtPath(ctx, path)...)
+
+		return diags
+	}
+
+	parentTftypesPath := tftypesPath.WithoutLastStep()
+	parentValue, err := d.TerraformValueAtTerraformPath(ctx, parentTftypesPath)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.ParentPath(),
+			d.Description.Title()+" Read Error",
+			"An unexpected error was encountered trying to read an attribute from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	newParentValue, err := removeTerraformValueElement(parentValue, tftypesPath.LastStep())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to remove an element from the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	d.TerraformValue, err = tftypes.Transform(d.TerraformValue, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if p.Equal(parentTftypesPath) {
+			return newParentValue, nil
+		}
+
+		return v, nil
+	})
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: Cannot transform data: "+err.Error(),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// removeTerraformValueElement returns a copy of the given list, map, or set
+// value without the element identified by the given step.
+func removeTerraformValueElement(parentValue tftypes.Value, step tftypes.AttributePathStep) (tftypes.Value, error) {
+	parentType := parentValue.Type()
+
+	switch step := step.(type) {
+	case tftypes.ElementKeyInt:
+		var elements []tftypes.Value
+
+		if err := parentValue.As(&elements); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		if int64(step) < 0 || int64(step) >= int64(len(elements)) {
+			return tftypes.Value{}, fmt.Errorf("element index %d out of range for length %d", step, len(elements))
+		}
+
+		newElements := make([]tftypes.Value, 0, len(elements)-1)
+		newElements = append(newElements, elements[:step]...)
+		newElements = append(newElements, elements[step+1:]...)
+
+		return tftypes.NewValue(parentType, newElements), nil
+	case tftypes.ElementKeyString:
+		var elements map[string]tftypes.Value
+
+		if err := parentValue.As(&elements); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		newElements := make(map[string]tftypes.Value, len(elements))
+
+		for key, element := range elements {
+			if key == string(step) {
+				continue
+			}
+
+			newElements[key] = element
+		}
+
+		return tftypes.NewValue(parentType, newElements), nil
+	case tftypes.ElementKeyValue:
+		var elements []tftypes.Value
+
+		if err := parentValue.As(&elements); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		newElements := make([]tftypes.Value, 0, len(elements))
+
+		for _, element := range elements {
+			if element.Equal(tftypes.Value(step)) {
+				continue
+			}
+
+			newElements = append(newElements, element)
+		}
+
+		return tftypes.NewValue(parentType, newElements), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported element step type: %T", step)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataRemoveAtPath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"string": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"list": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"map": testschema.Attribute{
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"set": testschema.Attribute{
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+
+	testListType := tftypes.List{ElementType: tftypes.String}
+	testMapType := tftypes.Map{ElementType: tftypes.String}
+	testSetType := tftypes.Set{ElementType: tftypes.String}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"string": tftypes.String,
+			"list":   testListType,
+			"map":    testMapType,
+			"set":    testSetType,
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"string": tftypes.NewValue(tftypes.String, "test"),
+		"list": tftypes.NewValue(testListType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "zero"),
+			tftypes.NewValue(tftypes.String, "one"),
+			tftypes.NewValue(tftypes.String, "two"),
+		}),
+		"map": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+			"key1": tftypes.NewValue(tftypes.String, "value1"),
+			"key2": tftypes.NewValue(tftypes.String, "value2"),
+		}),
+		"set": tftypes.NewValue(testSetType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "one"),
+			tftypes.NewValue(tftypes.String, "two"),
+		}),
+	})
+
+	testCases := map[string]struct {
+		data          fwschemadata.Data
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"attribute": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			path: path.Root("string"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, nil),
+				"list": tftypes.NewValue(testListType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "zero"),
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+				"map": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"key1": tftypes.NewValue(tftypes.String, "value1"),
+					"key2": tftypes.NewValue(tftypes.String, "value2"),
+				}),
+				"set": tftypes.NewValue(testSetType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+		},
+		"list-element": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			path: path.Root("list").AtListIndex(1),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "test"),
+				"list": tftypes.NewValue(testListType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "zero"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+				"map": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"key1": tftypes.NewValue(tftypes.String, "value1"),
+					"key2": tftypes.NewValue(tftypes.String, "value2"),
+				}),
+				"set": tftypes.NewValue(testSetType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+		},
+		"list-element-missing": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			path:     path.Root("list").AtListIndex(5),
+			expected: testValue,
+		},
+		"map-key": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			path: path.Root("map").AtMapKey("key1"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "test"),
+				"list": tftypes.NewValue(testListType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "zero"),
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+				"map": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"key2": tftypes.NewValue(tftypes.String, "value2"),
+				}),
+				"set": tftypes.NewValue(testSetType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+		},
+		"map-key-missing": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			path:     path.Root("map").AtMapKey("key3"),
+			expected: testValue,
+		},
+		"set-element": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			path: path.Root("set").AtSetValue(types.StringValue("one")),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "test"),
+				"list": tftypes.NewValue(testListType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "zero"),
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+				"map": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"key1": tftypes.NewValue(tftypes.String, "value1"),
+					"key2": tftypes.NewValue(tftypes.String, "value2"),
+				}),
+				"set": tftypes.NewValue(testSetType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+		},
+		"null-parent": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"string": tftypes.NewValue(tftypes.String, nil),
+					"list":   tftypes.NewValue(testListType, nil),
+					"map":    tftypes.NewValue(testMapType, nil),
+					"set":    tftypes.NewValue(testSetType, nil),
+				}),
+			},
+			path: path.Root("map").AtMapKey("key1"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, nil),
+				"list":   tftypes.NewValue(testListType, nil),
+				"map":    tftypes.NewValue(testMapType, nil),
+				"set":    tftypes.NewValue(testSetType, nil),
+			}),
+		},
+		"invalid-path": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			path:     path.Root("other"),
+			expected: testValue,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"State Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.data.RemoveAtPath(context.Background(), testCase.path)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
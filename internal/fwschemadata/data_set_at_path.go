@@ -27,16 +27,31 @@ import (
 //
 // Lists can only have the next element added according to the current length.
 func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
+	tfVal, diags := d.validatedTerraformValueAtPath(ctx, path, val)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(d.setValidatedTerraformValueAtPath(ctx, path, tfVal)...)
+
+	return diags
+}
+
+// validatedTerraformValueAtPath converts the supplied Go value into the
+// terraform-plugin-go value for the attribute at `path`, including any
+// attribute type or value validation.
+func (d Data) validatedTerraformValueAtPath(ctx context.Context, path path.Path, val interface{}) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	tftypesPath, tftypesPathDiags := totftypes.AttributePath(ctx, path)
 
 	diags.Append(tftypesPathDiags...)
 
 	if diags.HasError() {
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	attrType, err := d.Schema.TypeAtTerraformPath(ctx, tftypesPath)
@@ -48,7 +63,7 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 				"Error: "+err.Error(),
 		)
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	// MAINTAINER NOTE: The call to reflect.FromValue() checks for whether the type implements
@@ -57,7 +72,7 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 	diags.Append(newValDiags...)
 
 	if diags.HasError() {
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	tfVal, err := newVal.ToTerraformValue(ctx)
@@ -69,7 +84,7 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 				"Error: Cannot run ToTerraformValue on new data value: "+err.Error(),
 		)
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	switch t := newVal.(type) {
@@ -91,7 +106,7 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 		diags.Append(resp.Diagnostics...)
 
 		if diags.HasError() {
-			return diags
+			return tftypes.Value{}, diags
 		}
 	default:
 		//nolint:staticcheck // xattr.TypeWithValidate is deprecated, but we still need to support it.
@@ -104,18 +119,25 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 			logging.FrameworkTrace(ctx, "Called provider defined Type Validate")
 
 			if diags.HasError() {
-				return diags
+				return tftypes.Value{}, diags
 			}
 		}
 	}
 
-	transformFunc, transformFuncDiags := d.SetAtPathTransformFunc(ctx, path, tfVal, nil)
-	diags.Append(transformFuncDiags...)
+	return tfVal, diags
+}
+
+// setValidatedTerraformValueAtPath sets the already converted and validated
+// terraform-plugin-go value at `path`.
+func (d *Data) setValidatedTerraformValueAtPath(ctx context.Context, path path.Path, tfVal tftypes.Value) diag.Diagnostics {
+	transformFunc, diags := d.SetAtPathTransformFunc(ctx, path, tfVal, nil)
 
 	if diags.HasError() {
 		return diags
 	}
 
+	var err error
+
 	d.TerraformValue, err = tftypes.Transform(d.TerraformValue, transformFunc)
 
 	if err != nil {
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// SetNullAtPath sets the attribute at `path` to a null value of the schema
+// type at that path.
+//
+// The attribute path must be valid with the current schema. If the attribute
+// path does not have a value, it will be added, including any parent
+// attribute paths as necessary.
+func (d *Data) SetNullAtPath(ctx context.Context, path path.Path) diag.Diagnostics {
+	return d.setTerraformValueAtPath(ctx, path, nil)
+}
+
+// SetUnknownAtPath sets the attribute at `path` to an unknown value of the
+// schema type at that path.
+//
+// The attribute path must be valid with the current schema. If the attribute
+// path does not have a value, it will be added, including any parent
+// attribute paths as necessary.
+func (d *Data) SetUnknownAtPath(ctx context.Context, path path.Path) diag.Diagnostics {
+	return d.setTerraformValueAtPath(ctx, path, tftypes.UnknownValue)
+}
+
+// setTerraformValueAtPath sets the attribute at `path` to a value of the
+// schema type at that path, created with the given tftypes.NewValue value.
+func (d *Data) setTerraformValueAtPath(ctx context.Context, path path.Path, value any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
+
+	tftypesPath, tftypesPathDiags := totftypes.AttributePath(ctx, path)
+
+	diags.Append(tftypesPathDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	attrType, err := d.Schema.TypeAtTerraformPath(ctx, tftypesPath)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	tfVal := tftypes.NewValue(attrType.TerraformType(ctx), value)
+
+	transformFunc, transformFuncDiags := d.SetAtPathTransformFunc(ctx, path, tfVal, nil)
+	diags.Append(transformFuncDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.TerraformValue, err = tftypes.Transform(d.TerraformValue, transformFunc)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: Cannot transform data: "+err.Error(),
+		)
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataSetNullAtPath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"nested": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"id": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test":   tftypes.String,
+			"nested": testNestedType,
+		},
+	}
+
+	type testCase struct {
+		data          fwschemadata.Data
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		"overwrite-String": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test":   tftypes.NewValue(tftypes.String, "originalvalue"),
+					"nested": tftypes.NewValue(testNestedType, nil),
+				}),
+				Schema: testSchema,
+			},
+			path: path.Root("test"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test":   tftypes.NewValue(tftypes.String, nil),
+				"nested": tftypes.NewValue(testNestedType, nil),
+			}),
+		},
+		"overwrite-Object": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+					"nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "originalid"),
+					}),
+				}),
+				Schema: testSchema,
+			},
+			path: path.Root("nested"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test":   tftypes.NewValue(tftypes.String, "originalvalue"),
+				"nested": tftypes.NewValue(testNestedType, nil),
+			}),
+		},
+		"overwrite-Object-attribute": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+					"nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "originalid"),
+					}),
+				}),
+				Schema: testSchema,
+			},
+			path: path.Root("nested").AtName("id"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+				"nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+		},
+		"add-Object-attribute-null-parent": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test":   tftypes.NewValue(tftypes.String, "originalvalue"),
+					"nested": tftypes.NewValue(testNestedType, nil),
+				}),
+				Schema: testSchema,
+			},
+			path: path.Root("nested").AtName("id"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+				"nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+		},
+		"invalid-path": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test":   tftypes.NewValue(tftypes.String, "originalvalue"),
+					"nested": tftypes.NewValue(testNestedType, nil),
+				}),
+				Schema: testSchema,
+			},
+			path: path.Root("other"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test":   tftypes.NewValue(tftypes.String, "originalvalue"),
+				"nested": tftypes.NewValue(testNestedType, nil),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"Plan Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.data.SetNullAtPath(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.data.TerraformValue, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataSetUnknownAtPath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"list": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Computed: true,
+			},
+		},
+	}
+
+	testListType := tftypes.List{
+		ElementType: tftypes.String,
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+			"list": testListType,
+		},
+	}
+
+	type testCase struct {
+		data          fwschemadata.Data
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		"overwrite-String": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+					"list": tftypes.NewValue(testListType, nil),
+				}),
+				Schema: testSchema,
+			},
+			path: path.Root("test"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"list": tftypes.NewValue(testListType, nil),
+			}),
+		},
+		"overwrite-List": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+					"list": tftypes.NewValue(testListType, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "originalelement"),
+					}),
+				}),
+				Schema: testSchema,
+			},
+			path: path.Root("list"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+				"list": tftypes.NewValue(testListType, tftypes.UnknownValue),
+			}),
+		},
+		"overwrite-List-element": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+					"list": tftypes.NewValue(testListType, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "originalelement"),
+					}),
+				}),
+				Schema: testSchema,
+			},
+			path: path.Root("list").AtListIndex(0),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+				"list": tftypes.NewValue(testListType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			}),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.data.SetUnknownAtPath(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.data.TerraformValue, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
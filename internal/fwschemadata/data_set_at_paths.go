@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// PathValue is an attribute path and the value to set at that path.
+type PathValue struct {
+	// Path is the attribute path to set.
+	Path path.Path
+
+	// Value is the value to set at Path.
+	Value attr.Value
+}
+
+// SetAtPaths sets the attribute at each path using the supplied values.
+//
+// Each path must only be given once. Every value is converted and validated
+// before any value is set, and the diagnostics of all paths are returned
+// together. Values are then set in path order, where parent paths are set
+// before their children and list elements are set in index order. If any
+// path returns an error diagnostic, the data is left unmodified.
+func (d *Data) SetAtPaths(ctx context.Context, values []PathValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sorted := make([]PathValue, len(values))
+
+	copy(sorted, values)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return pathLess(sorted[i].Path, sorted[j].Path)
+	})
+
+	tfVals := make([]tftypes.Value, len(sorted))
+
+	for i, pathValue := range sorted {
+		if i > 0 && pathValue.Path.Equal(sorted[i-1].Path) {
+			diags.AddAttributeError(
+				pathValue.Path,
+				d.Description.Title()+" Write Error",
+				"An unexpected error was encountered trying to write attributes to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Error: Path was given multiple times: "+pathValue.Path.String(),
+			)
+			continue
+		}
+
+		pathCtx := logging.FrameworkWithAttributePath(ctx, pathValue.Path.String())
+
+		tfVal, tfValDiags := d.validatedTerraformValueAtPath(pathCtx, pathValue.Path, pathValue.Value)
+
+		diags.Append(tfValDiags...)
+
+		tfVals[i] = tfVal
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	updated := *d
+
+	for i, pathValue := range sorted {
+		pathCtx := logging.FrameworkWithAttributePath(ctx, pathValue.Path.String())
+
+		diags.Append(updated.setValidatedTerraformValueAtPath(pathCtx, pathValue.Path, tfVals[i])...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.TerraformValue = updated.TerraformValue
+
+	return diags
+}
+
+// pathLess returns true if path a should be set before path b. Shorter paths
+// sort first when one path is the parent of the other, list and other integer
+// element keys sort numerically, and all other steps sort by their string
+// representation.
+func pathLess(a, b path.Path) bool {
+	aSteps, bSteps := a.Steps(), b.Steps()
+
+	for i := 0; i < len(aSteps) && i < len(bSteps); i++ {
+		if aSteps[i].Equal(bSteps[i]) {
+			continue
+		}
+
+		aIndex, aOk := aSteps[i].(path.PathStepElementKeyInt)
+		bIndex, bOk := bSteps[i].(path.PathStepElementKeyInt)
+
+		if aOk && bOk {
+			return aIndex < bIndex
+		}
+
+		return aSteps[i].String() < bSteps[i].String()
+	}
+
+	return len(aSteps) < len(bSteps)
+}
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataSetAtPaths(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"error": testschema.Attribute{
+				Optional: true,
+				Type:     testtypes.StringTypeWithValidateError{},
+			},
+			"list": testschema.Attribute{
+				Optional: true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"object": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+			"string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"warning": testschema.Attribute{
+				Optional: true,
+				Type:     testtypes.StringTypeWithValidateWarning{},
+			},
+		},
+	}
+
+	testListType := tftypes.List{
+		ElementType: tftypes.String,
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"error":   tftypes.String,
+			"list":    testListType,
+			"object":  testNestedType,
+			"string":  tftypes.String,
+			"warning": tftypes.String,
+		},
+	}
+
+	testNullValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"error":   tftypes.NewValue(tftypes.String, nil),
+		"list":    tftypes.NewValue(testListType, nil),
+		"object":  tftypes.NewValue(testNestedType, nil),
+		"string":  tftypes.NewValue(tftypes.String, nil),
+		"warning": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testEmptyListValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"error":   tftypes.NewValue(tftypes.String, nil),
+		"list":    tftypes.NewValue(testListType, []tftypes.Value{}),
+		"object":  tftypes.NewValue(testNestedType, nil),
+		"string":  tftypes.NewValue(tftypes.String, nil),
+		"warning": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testCases := map[string]struct {
+		data          fwschemadata.Data
+		values        []fwschemadata.PathValue
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testNullValue,
+			},
+			expected: testNullValue,
+		},
+		"multiple": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testNullValue,
+			},
+			values: []fwschemadata.PathValue{
+				{
+					Path:  path.Root("list").AtListIndex(1),
+					Value: types.StringValue("test-element-1"),
+				},
+				{
+					Path:  path.Root("object").AtName("nested"),
+					Value: types.StringValue("test-nested"),
+				},
+				{
+					Path:  path.Root("string"),
+					Value: types.StringValue("test-string"),
+				},
+				{
+					Path:  path.Root("list").AtListIndex(0),
+					Value: types.StringValue("test-element-0"),
+				},
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"error": tftypes.NewValue(tftypes.String, nil),
+				"list": tftypes.NewValue(testListType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test-element-0"),
+					tftypes.NewValue(tftypes.String, "test-element-1"),
+				}),
+				"object": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.String, "test-nested"),
+				}),
+				"string":  tftypes.NewValue(tftypes.String, "test-string"),
+				"warning": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"duplicate-path": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testNullValue,
+			},
+			values: []fwschemadata.PathValue{
+				{
+					Path:  path.Root("string"),
+					Value: types.StringValue("test-string-1"),
+				},
+				{
+					Path:  path.Root("string"),
+					Value: types.StringValue("test-string-2"),
+				},
+			},
+			expected: testNullValue,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("string"),
+					"Data Write Error",
+					"An unexpected error was encountered trying to write attributes to the data. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: Path was given multiple times: string",
+				),
+			},
+		},
+		"errors": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testNullValue,
+			},
+			values: []fwschemadata.PathValue{
+				{
+					Path:  path.Root("string"),
+					Value: types.StringValue("test-string"),
+				},
+				{
+					Path:  path.Root("not-test"),
+					Value: types.StringValue("test-string"),
+				},
+				{
+					Path:  path.Root("error"),
+					Value: testtypes.String{InternalString: types.StringValue("test-error"), CreatedBy: testtypes.StringTypeWithValidateError{}},
+				},
+			},
+			expected: testNullValue,
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestErrorDiagnostic(path.Root("error")),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("not-test"),
+					"Data Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"not-test\") still remains in the path: could not find attribute or block \"not-test\" in schema",
+				),
+			},
+		},
+		"list-element-out-of-range": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testEmptyListValue,
+			},
+			values: []fwschemadata.PathValue{
+				{
+					Path:  path.Root("string"),
+					Value: types.StringValue("test-string"),
+				},
+				{
+					Path:  path.Root("list").AtListIndex(1),
+					Value: types.StringValue("test-element-1"),
+				},
+			},
+			expected: testEmptyListValue,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
+				),
+			},
+		},
+		"warnings": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testNullValue,
+			},
+			values: []fwschemadata.PathValue{
+				{
+					Path:  path.Root("warning"),
+					Value: testtypes.String{InternalString: types.StringValue("test-warning"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+				},
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"error":   tftypes.NewValue(tftypes.String, nil),
+				"list":    tftypes.NewValue(testListType, nil),
+				"object":  tftypes.NewValue(testNestedType, nil),
+				"string":  tftypes.NewValue(tftypes.String, nil),
+				"warning": tftypes.NewValue(tftypes.String, "test-warning"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestWarningDiagnostic(path.Root("warning")),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.data.SetAtPaths(context.Background(), tc.values)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.data.TerraformValue, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
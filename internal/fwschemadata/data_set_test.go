@@ -22,6 +22,11 @@ import (
 func TestDataSet(t *testing.T) {
 	t.Parallel()
 
+	// CommonModel represents a model fragment shared across data models.
+	type CommonModel struct {
+		Tags map[string]string `tfsdk:"tags"`
+	}
+
 	type testCase struct {
 		data          fwschemadata.Data
 		val           any
@@ -61,6 +66,56 @@ func TestDataSet(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
 		},
+		"write-embedded-struct": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+						"tags": tftypes.Map{ElementType: tftypes.String},
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+					"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+						"tags": testschema.Attribute{
+							Type:     types.MapType{ElemType: types.StringType},
+							Optional: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				CommonModel
+				Name string `tfsdk:"name"`
+			}{
+				CommonModel: CommonModel{
+					Tags: map[string]string{
+						"key": "value",
+					},
+				},
+				Name: "newvalue",
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+					"tags": tftypes.Map{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "newvalue"),
+				"tags": tftypes.NewValue(
+					tftypes.Map{ElementType: tftypes.String},
+					map[string]tftypes.Value{
+						"key": tftypes.NewValue(tftypes.String, "value"),
+					},
+				),
+			}),
+		},
 		"write-dynamic": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// WalkControl determines how Walk proceeds after visiting a value.
+type WalkControl int
+
+const (
+	// WalkContinue continues the walk, including any nested values.
+	WalkContinue WalkControl = iota
+
+	// WalkSkipChildren continues the walk, excluding any nested values.
+	WalkSkipChildren
+
+	// WalkStop ends the walk.
+	WalkStop
+)
+
+// WalkFunc is called by Walk for each value.
+type WalkFunc func(path.Path, attr.Value) (WalkControl, diag.Diagnostics)
+
+// WalkStep is the schema and value information for a value visited by
+// WalkWithSchema.
+type WalkStep struct {
+	// Path is the location of the value in the schema.
+	Path path.Path
+
+	// Value is the value at Path.
+	Value attr.Value
+
+	// Attribute is the schema attribute at Path, or nil if Path is a block
+	// or collection element.
+	Attribute fwschema.Attribute
+
+	// Block is the schema block at Path, or nil if Path is an attribute or
+	// collection element.
+	Block fwschema.Block
+}
+
+// WalkWithSchemaFunc is called by WalkWithSchema for each value.
+type WalkWithSchemaFunc func(WalkStep) (WalkControl, diag.Diagnostics)
+
+// walkStepFunc is called by walk for each value.
+type walkStepFunc func(*tftypes.AttributePath, path.Path, attr.Value) (WalkControl, diag.Diagnostics)
+
+// Walk calls the given function for each attribute, block, and collection
+// element value in the data, parents before their nested values. Object
+// attributes and map elements are visited in key order. The root object is
+// not visited. Values nested under null or unknown values are not visited,
+// nor are values nested under dynamic values, since they have no schema type
+// information.
+//
+// The walk ends early if the function returns WalkStop or error diagnostics.
+func (d Data) Walk(ctx context.Context, walkFunc WalkFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	stepFunc := func(_ *tftypes.AttributePath, p path.Path, v attr.Value) (WalkControl, diag.Diagnostics) {
+		return walkFunc(p, v)
+	}
+
+	d.walk(ctx, tftypes.NewAttributePath(), d.TerraformValue, stepFunc, &diags)
+
+	return diags
+}
+
+// WalkWithSchema is the same as Walk, except the function also receives the
+// schema attribute or block of each value.
+func (d Data) WalkWithSchema(ctx context.Context, walkFunc WalkWithSchemaFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	stepFunc := func(tfTypePath *tftypes.AttributePath, p path.Path, v attr.Value) (WalkControl, diag.Diagnostics) {
+		step := WalkStep{
+			Path:  p,
+			Value: v,
+		}
+
+		// Collection element paths have no attribute or block.
+		if _, ok := tfTypePath.LastStep().(tftypes.AttributeName); ok {
+			schemaElement, _, err := tftypes.WalkAttributePath(d.Schema, tfTypePath)
+
+			if err == nil {
+				switch schemaElement := schemaElement.(type) {
+				case fwschema.Attribute:
+					step.Attribute = schemaElement
+				case fwschema.Block:
+					step.Block = schemaElement
+				}
+			}
+		}
+
+		return walkFunc(step)
+	}
+
+	d.walk(ctx, tftypes.NewAttributePath(), d.TerraformValue, stepFunc, &diags)
+
+	return diags
+}
+
+// walk visits the given value, unless it is the root value, then its nested
+// values. It returns false if the walk should end.
+func (d Data) walk(ctx context.Context, tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value, walkFunc walkStepFunc, diags *diag.Diagnostics) bool {
+	if len(tfTypePath.Steps()) > 0 {
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
+
+		diags.Append(fwPathDiags...)
+
+		if diags.HasError() {
+			return false
+		}
+
+		value, valueDiags := d.ValueAtPath(ctx, fwPath)
+
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return false
+		}
+
+		control, walkFuncDiags := walkFunc(tfTypePath, fwPath, value)
+
+		diags.Append(walkFuncDiags...)
+
+		if diags.HasError() {
+			return false
+		}
+
+		switch control {
+		case WalkStop:
+			return false
+		case WalkSkipChildren:
+			return true
+		}
+
+		if _, ok := value.(basetypes.DynamicValuable); ok {
+			return true
+		}
+	}
+
+	if tfTypeValue.IsNull() || !tfTypeValue.IsKnown() {
+		return true
+	}
+
+	switch tfTypeValue.Type().(type) {
+	case tftypes.List, tftypes.Tuple:
+		var elements []tftypes.Value
+
+		_ = tfTypeValue.As(&elements)
+
+		for index, element := range elements {
+			if !d.walk(ctx, tfTypePath.WithElementKeyInt(index), element, walkFunc, diags) {
+				return false
+			}
+		}
+	case tftypes.Set:
+		var elements []tftypes.Value
+
+		_ = tfTypeValue.As(&elements)
+
+		for _, element := range elements {
+			if !d.walk(ctx, tfTypePath.WithElementKeyValue(element), element, walkFunc, diags) {
+				return false
+			}
+		}
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+
+		_ = tfTypeValue.As(&elements)
+
+		for _, key := range sortedKeys(elements) {
+			if !d.walk(ctx, tfTypePath.WithElementKeyString(key), elements[key], walkFunc, diags) {
+				return false
+			}
+		}
+	case tftypes.Object:
+		var attributes map[string]tftypes.Value
+
+		_ = tfTypeValue.As(&attributes)
+
+		for _, name := range sortedKeys(attributes) {
+			if !d.walk(ctx, tfTypePath.WithAttributeName(name), attributes[name], walkFunc, diags) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
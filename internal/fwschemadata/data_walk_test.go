@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataWalk(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"dynamic": testschema.Attribute{
+				Type:     types.DynamicType,
+				Optional: true,
+			},
+			"list": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"string": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testListType := tftypes.List{ElementType: tftypes.String}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"dynamic": tftypes.DynamicPseudoType,
+			"list":    testListType,
+			"string":  tftypes.String,
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"dynamic": tftypes.NewValue(testListType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "dynamic-element"),
+		}),
+		"list": tftypes.NewValue(testListType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "zero"),
+			tftypes.NewValue(tftypes.String, "one"),
+		}),
+		"string": tftypes.NewValue(tftypes.String, "test"),
+	})
+
+	testListValue := types.ListValueMust(
+		types.StringType,
+		[]attr.Value{
+			types.StringValue("zero"),
+			types.StringValue("one"),
+		},
+	)
+
+	type visit struct {
+		Path  path.Path
+		Value attr.Value
+	}
+
+	testCases := map[string]struct {
+		data           fwschemadata.Data
+		walkFunc       func(path.Path, attr.Value) (fwschemadata.WalkControl, diag.Diagnostics)
+		expectedVisits []visit
+		expectedDiags  diag.Diagnostics
+	}{
+		"continue": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			walkFunc: func(path.Path, attr.Value) (fwschemadata.WalkControl, diag.Diagnostics) {
+				return fwschemadata.WalkContinue, nil
+			},
+			expectedVisits: []visit{
+				{
+					Path: path.Root("dynamic"),
+					Value: types.DynamicValue(types.ListValueMust(
+						types.StringType,
+						[]attr.Value{
+							types.StringValue("dynamic-element"),
+						},
+					)),
+				},
+				{
+					Path:  path.Root("list"),
+					Value: testListValue,
+				},
+				{
+					Path:  path.Root("list").AtListIndex(0),
+					Value: types.StringValue("zero"),
+				},
+				{
+					Path:  path.Root("list").AtListIndex(1),
+					Value: types.StringValue("one"),
+				},
+				{
+					Path:  path.Root("string"),
+					Value: types.StringValue("test"),
+				},
+			},
+		},
+		"null-parent": {
+			data: fwschemadata.Data{
+				Schema: testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"dynamic": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+					"list":    tftypes.NewValue(testListType, nil),
+					"string":  tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+			walkFunc: func(path.Path, attr.Value) (fwschemadata.WalkControl, diag.Diagnostics) {
+				return fwschemadata.WalkContinue, nil
+			},
+			expectedVisits: []visit{
+				{
+					Path:  path.Root("dynamic"),
+					Value: types.DynamicNull(),
+				},
+				{
+					Path:  path.Root("list"),
+					Value: types.ListNull(types.StringType),
+				},
+				{
+					Path:  path.Root("string"),
+					Value: types.StringNull(),
+				},
+			},
+		},
+		"skip-children": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			walkFunc: func(p path.Path, _ attr.Value) (fwschemadata.WalkControl, diag.Diagnostics) {
+				if p.Equal(path.Root("list")) {
+					return fwschemadata.WalkSkipChildren, nil
+				}
+
+				return fwschemadata.WalkContinue, nil
+			},
+			expectedVisits: []visit{
+				{
+					Path: path.Root("dynamic"),
+					Value: types.DynamicValue(types.ListValueMust(
+						types.StringType,
+						[]attr.Value{
+							types.StringValue("dynamic-element"),
+						},
+					)),
+				},
+				{
+					Path:  path.Root("list"),
+					Value: testListValue,
+				},
+				{
+					Path:  path.Root("string"),
+					Value: types.StringValue("test"),
+				},
+			},
+		},
+		"stop": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			walkFunc: func(p path.Path, _ attr.Value) (fwschemadata.WalkControl, diag.Diagnostics) {
+				if p.Equal(path.Root("list").AtListIndex(0)) {
+					return fwschemadata.WalkStop, nil
+				}
+
+				return fwschemadata.WalkContinue, nil
+			},
+			expectedVisits: []visit{
+				{
+					Path: path.Root("dynamic"),
+					Value: types.DynamicValue(types.ListValueMust(
+						types.StringType,
+						[]attr.Value{
+							types.StringValue("dynamic-element"),
+						},
+					)),
+				},
+				{
+					Path:  path.Root("list"),
+					Value: testListValue,
+				},
+				{
+					Path:  path.Root("list").AtListIndex(0),
+					Value: types.StringValue("zero"),
+				},
+			},
+		},
+		"diagnostics": {
+			data: fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testValue,
+			},
+			walkFunc: func(p path.Path, _ attr.Value) (fwschemadata.WalkControl, diag.Diagnostics) {
+				var diags diag.Diagnostics
+
+				diags.AddAttributeWarning(p, "test warning summary", "test warning detail")
+
+				if p.Equal(path.Root("list")) {
+					diags.AddAttributeError(p, "test error summary", "test error detail")
+				}
+
+				return fwschemadata.WalkContinue, diags
+			},
+			expectedVisits: []visit{
+				{
+					Path: path.Root("dynamic"),
+					Value: types.DynamicValue(types.ListValueMust(
+						types.StringType,
+						[]attr.Value{
+							types.StringValue("dynamic-element"),
+						},
+					)),
+				},
+				{
+					Path:  path.Root("list"),
+					Value: testListValue,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("dynamic"), "test warning summary", "test warning detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("list"), "test warning summary", "test warning detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("list"), "test error summary", "test error detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []visit
+
+			diags := testCase.data.Walk(context.Background(), func(p path.Path, v attr.Value) (fwschemadata.WalkControl, diag.Diagnostics) {
+				got = append(got, visit{Path: p, Value: v})
+
+				return testCase.walkFunc(p, v)
+			})
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedVisits); diff != "" {
+				t.Errorf("unexpected visits difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataWalkWithSchema(t *testing.T) {
+	t.Parallel()
+
+	testNestedNameAttribute := schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+	}
+
+	testNestedAttribute := schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": testNestedNameAttribute,
+			},
+		},
+		Optional: true,
+	}
+
+	testBlockAttribute := schema.StringAttribute{
+		Optional: true,
+	}
+
+	testBlock := schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"block_attribute": testBlockAttribute,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"nested": testNestedAttribute,
+		},
+		Blocks: map[string]schema.Block{
+			"block": testBlock,
+		},
+	}
+
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testBlockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"block_attribute": tftypes.String,
+		},
+	}
+
+	testValue := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"block":  testBlockType,
+			"nested": tftypes.List{ElementType: testNestedObjectType},
+		},
+	}, map[string]tftypes.Value{
+		"block": tftypes.NewValue(testBlockType, map[string]tftypes.Value{
+			"block_attribute": tftypes.NewValue(tftypes.String, "test-block-value"),
+		}),
+		"nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+			tftypes.NewValue(testNestedObjectType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test-name"),
+			}),
+		}),
+	})
+
+	testNestedObjectValue := types.ObjectValueMust(
+		map[string]attr.Type{
+			"name": types.StringType,
+		},
+		map[string]attr.Value{
+			"name": types.StringValue("test-name"),
+		},
+	)
+
+	data := fwschemadata.Data{
+		Schema:         testSchema,
+		TerraformValue: testValue,
+	}
+
+	var got []fwschemadata.WalkStep
+
+	diags := data.WalkWithSchema(context.Background(), func(step fwschemadata.WalkStep) (fwschemadata.WalkControl, diag.Diagnostics) {
+		got = append(got, step)
+
+		return fwschemadata.WalkContinue, nil
+	})
+
+	if len(diags) > 0 {
+		t.Errorf("unexpected diagnostics: %s", diags)
+	}
+
+	expected := []fwschemadata.WalkStep{
+		{
+			Path: path.Root("block"),
+			Value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"block_attribute": types.StringType,
+				},
+				map[string]attr.Value{
+					"block_attribute": types.StringValue("test-block-value"),
+				},
+			),
+			Block: testBlock,
+		},
+		{
+			Path:      path.Root("block").AtName("block_attribute"),
+			Value:     types.StringValue("test-block-value"),
+			Attribute: testBlockAttribute,
+		},
+		{
+			Path: path.Root("nested"),
+			Value: types.ListValueMust(
+				types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+					},
+				},
+				[]attr.Value{testNestedObjectValue},
+			),
+			Attribute: testNestedAttribute,
+		},
+		{
+			Path:  path.Root("nested").AtListIndex(0),
+			Value: testNestedObjectValue,
+		},
+		{
+			Path:      path.Root("nested").AtListIndex(0).AtName("name"),
+			Value:     types.StringValue("test-name"),
+			Attribute: testNestedNameAttribute,
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected steps difference: %s", diff)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ValidateReferences returns an error diagnostic for each attribute in the
+// schema which implements fwschema.AttributeWithReferences and whose
+// references expression does not match any attribute in the schema. Nested
+// attributes and blocks are included.
+func ValidateReferences(ctx context.Context, schema fwschema.Schema) diag.Diagnostics {
+	data := Data{
+		Schema: schema,
+	}
+
+	var diags diag.Diagnostics
+
+	for _, name := range sortedKeys(schema.GetAttributes()) {
+		diags.Append(data.validateAttributeReferences(ctx, path.MatchRoot(name), schema.GetAttributes()[name])...)
+	}
+
+	for _, name := range sortedKeys(schema.GetBlocks()) {
+		diags.Append(data.validateBlockReferences(ctx, path.MatchRoot(name), schema.GetBlocks()[name])...)
+	}
+
+	return diags
+}
+
+// validateAttributeReferences validates the references of the attribute and
+// any nested attributes.
+func (d Data) validateAttributeReferences(ctx context.Context, expression path.Expression, attribute fwschema.Attribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if attributeWithReferences, ok := attribute.(fwschema.AttributeWithReferences); ok {
+		references := attributeWithReferences.GetReferences()
+
+		if !references.Equal(path.Expression{}) && !d.ValidPathExpression(ctx, expression.Merge(references)) {
+			diags.Append(fwschema.AttributeInvalidReferencesDiag(expression, references))
+		}
+	}
+
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok || nestedAttribute.GetNestedObject() == nil {
+		return diags
+	}
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeList:
+		expression = expression.AtAnyListIndex()
+	case fwschema.NestingModeMap:
+		expression = expression.AtAnyMapKey()
+	case fwschema.NestingModeSet:
+		expression = expression.AtAnySetValue()
+	}
+
+	nestedAttributes := nestedAttribute.GetNestedObject().GetAttributes()
+
+	for _, name := range sortedKeys(nestedAttributes) {
+		diags.Append(d.validateAttributeReferences(ctx, expression.AtName(name), nestedAttributes[name])...)
+	}
+
+	return diags
+}
+
+// validateBlockReferences validates the references of any attributes nested
+// within the block.
+func (d Data) validateBlockReferences(ctx context.Context, expression path.Expression, block fwschema.Block) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch block.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		expression = expression.AtAnyListIndex()
+	case fwschema.BlockNestingModeSet:
+		expression = expression.AtAnySetValue()
+	}
+
+	nestedObject := block.GetNestedObject()
+
+	for _, name := range sortedKeys(nestedObject.GetAttributes()) {
+		diags.Append(d.validateAttributeReferences(ctx, expression.AtName(name), nestedObject.GetAttributes()[name])...)
+	}
+
+	for _, name := range sortedKeys(nestedObject.GetBlocks()) {
+		diags.Append(d.validateBlockReferences(ctx, expression.AtName(name), nestedObject.GetBlocks()[name])...)
+	}
+
+	return diags
+}
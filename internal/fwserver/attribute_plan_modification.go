@@ -482,7 +482,8 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 
 		planElements := planMap.Elements()
 
-		for key, planElem := range planElements {
+		for _, key := range sortedKeys(planElements) {
+			planElem := planElements[key]
 			attrPath := req.AttributePath.AtMapKey(key)
 
 			configObject, diags := mapElemObject(ctx, attrPath, configMap, key, fwschemadata.DataDescriptionConfiguration)
@@ -788,7 +789,7 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.BoolPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.BoolPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.BoolResponse{
@@ -824,6 +825,8 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -948,7 +951,7 @@ func AttributePlanModifyFloat32(ctx context.Context, attribute fwxschema.Attribu
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.Float32PlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.Float32PlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.Float32Response{
@@ -984,6 +987,8 @@ func AttributePlanModifyFloat32(ctx context.Context, attribute fwxschema.Attribu
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -1108,7 +1113,7 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.Float64PlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.Float64PlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.Float64Response{
@@ -1144,6 +1149,8 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -1268,7 +1275,7 @@ func AttributePlanModifyInt32(ctx context.Context, attribute fwxschema.Attribute
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.Int32PlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.Int32PlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.Int32Response{
@@ -1304,6 +1311,8 @@ func AttributePlanModifyInt32(ctx context.Context, attribute fwxschema.Attribute
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -1428,7 +1437,7 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.Int64PlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.Int64PlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.Int64Response{
@@ -1464,6 +1473,8 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -1588,7 +1599,7 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.ListPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.ListPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ListResponse{
@@ -1624,6 +1635,8 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -1748,7 +1761,7 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.MapPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.MapPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.MapResponse{
@@ -1784,6 +1797,8 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -1908,7 +1923,7 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.NumberPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.NumberPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.NumberResponse{
@@ -1944,6 +1959,8 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -2068,7 +2085,7 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.ObjectPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.ObjectPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ObjectResponse{
@@ -2104,6 +2121,8 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -2228,7 +2247,7 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.SetPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.SetPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.SetResponse{
@@ -2264,6 +2283,8 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -2388,7 +2409,7 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.StringPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.StringPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.StringResponse{
@@ -2424,6 +2445,8 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -2548,7 +2571,7 @@ func AttributePlanModifyDynamic(ctx context.Context, attribute fwxschema.Attribu
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range attribute.DynamicPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(attribute.DynamicPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.DynamicResponse{
@@ -2584,6 +2607,8 @@ func AttributePlanModifyDynamic(ctx context.Context, attribute fwxschema.Attribu
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -2607,7 +2632,7 @@ func AttributePlanModifyDynamic(ctx context.Context, attribute fwxschema.Attribu
 
 func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttributeObject, req planmodifier.ObjectRequest, resp *ModifyAttributePlanResponse) {
 	if objectWithPlanModifiers, ok := o.(fwxschema.NestedAttributeObjectWithPlanModifiers); ok {
-		for _, objectPlanModifier := range objectWithPlanModifiers.ObjectPlanModifiers() {
+		for _, objectPlanModifier := range orderedPlanModifiers(objectWithPlanModifiers.ObjectPlanModifiers()) {
 			// Instantiate a new response for each request to prevent plan modifiers
 			// from modifying or removing diagnostics.
 			planModifyResp := &planmodifier.ObjectResponse{
@@ -2642,6 +2667,8 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 				resp.RequiresReplace.Append(req.Path)
 			}
 
+			resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 			// only on new errors
 			if planModifyResp.Diagnostics.HasError() {
 				return
@@ -2662,7 +2689,8 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 
 	newPlanValueAttributes := req.PlanValue.Attributes()
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	for _, nestedName := range sortedKeys(o.GetAttributes()) {
+		nestedAttr := o.GetAttributes()[nestedName]
 		nestedAttrConfig, diags := objectAttributeValue(ctx, req.ConfigValue, nestedName, fwschemadata.DataDescriptionConfiguration)
 
 		resp.Diagnostics.Append(diags...)
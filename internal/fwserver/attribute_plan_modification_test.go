@@ -9812,6 +9812,67 @@ func TestAttributePlanModifyObject(t *testing.T) {
 				},
 			},
 		},
+		"response-requiresreplacepaths-add": {
+			attribute: testschema.AttributeWithObjectPlanModifiers{
+				PlanModifiers: []planmodifier.Object{
+					testplanmodifier.Object{
+						PlanModifyObjectMethod: func(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+							resp.RequiresReplacePaths = path.Paths{path.Root("other")}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("testvalue"),
+					},
+				),
+				AttributePlan: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("testvalue"),
+					},
+				),
+				AttributeState: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("oldtestvalue"),
+					},
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("testvalue"),
+					},
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.ObjectValueMust(
+					map[string]attr.Type{
+						"testattr": types.StringType,
+					},
+					map[string]attr.Value{
+						"testattr": types.StringValue("testvalue"),
+					},
+				),
+				RequiresReplace: path.Paths{
+					path.Root("other"),
+				},
+			},
+		},
 		"response-requiresreplace-false": {
 			attribute: testschema.AttributeWithObjectPlanModifiers{
 				PlanModifiers: []planmodifier.Object{
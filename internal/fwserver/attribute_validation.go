@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -34,6 +35,9 @@ type ValidateAttributeRequest struct {
 
 	// Config contains the entire configuration of the data source, provider, or resource.
 	Config tfsdk.Config
+
+	// Functions calls the pure provider-defined functions of the provider.
+	Functions function.Caller
 }
 
 // ValidateAttributeResponse represents a response to a
@@ -142,11 +146,7 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 		// Dynamic values need to perform more logic to check the config value for null/unknown-ness
 		dynamicValuable, ok := attributeConfig.(basetypes.DynamicValuable)
 		if !ok {
-			resp.Diagnostics.AddAttributeWarning(
-				req.AttributePath,
-				"Attribute Deprecated",
-				a.GetDeprecationMessage(),
-			)
+			resp.Diagnostics.Append(attributeDeprecationDiag(a, req.AttributePath))
 			return
 		}
 
@@ -159,15 +159,33 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 		// For dynamic values, it's possible to be known when only the type is known.
 		// The underlying value can still be null or unknown, so check for that here
 		if !dynamicConfigVal.IsUnderlyingValueNull() && !dynamicConfigVal.IsUnderlyingValueUnknown() {
-			resp.Diagnostics.AddAttributeWarning(
-				req.AttributePath,
-				"Attribute Deprecated",
-				a.GetDeprecationMessage(),
-			)
+			resp.Diagnostics.Append(attributeDeprecationDiag(a, req.AttributePath))
 		}
 	}
 }
 
+// attributeDeprecationDiag returns the warning diagnostic for a configured
+// deprecated attribute. If the attribute declares a replacement attribute,
+// the diagnostic is a diag.AttributeDeprecationDiagnostic which exposes the
+// replacement path expression.
+func attributeDeprecationDiag(a fwschema.Attribute, attributePath path.Path) diag.Diagnostic {
+	attributeWithReplacement, ok := a.(fwschema.AttributeWithDeprecationReplacement)
+
+	if !ok || attributeWithReplacement.GetDeprecationReplacement().Equal(path.Expression{}) {
+		return diag.NewAttributeWarningDiagnostic(
+			attributePath,
+			"Attribute Deprecated",
+			a.GetDeprecationMessage(),
+		)
+	}
+
+	return diag.NewAttributeDeprecationDiagnostic(
+		attributePath,
+		attributeWithReplacement.GetDeprecationReplacement(),
+		a.GetDeprecationMessage(),
+	)
+}
+
 // AttributeValidateBool performs all types.Bool validation.
 func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWithBoolValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.BoolValuable until custom types cannot re-implement
@@ -201,6 +219,7 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 
 	validateReq := validator.BoolRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -266,6 +285,7 @@ func AttributeValidateFloat32(ctx context.Context, attribute fwxschema.Attribute
 
 	validateReq := validator.Float32Request{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -331,6 +351,7 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 
 	validateReq := validator.Float64Request{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -396,6 +417,7 @@ func AttributeValidateInt32(ctx context.Context, attribute fwxschema.AttributeWi
 
 	validateReq := validator.Int32Request{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -461,6 +483,7 @@ func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWi
 
 	validateReq := validator.Int64Request{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -526,6 +549,7 @@ func AttributeValidateList(ctx context.Context, attribute fwxschema.AttributeWit
 
 	validateReq := validator.ListRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -591,6 +615,7 @@ func AttributeValidateMap(ctx context.Context, attribute fwxschema.AttributeWith
 
 	validateReq := validator.MapRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -656,6 +681,7 @@ func AttributeValidateNumber(ctx context.Context, attribute fwxschema.AttributeW
 
 	validateReq := validator.NumberRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -721,6 +747,7 @@ func AttributeValidateObject(ctx context.Context, attribute fwxschema.AttributeW
 
 	validateReq := validator.ObjectRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -786,6 +813,7 @@ func AttributeValidateSet(ctx context.Context, attribute fwxschema.AttributeWith
 
 	validateReq := validator.SetRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -851,6 +879,7 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 
 	validateReq := validator.StringRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -916,6 +945,7 @@ func AttributeValidateDynamic(ctx context.Context, attribute fwxschema.Attribute
 
 	validateReq := validator.DynamicRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -992,6 +1022,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 				AttributePath:           req.AttributePath.AtListIndex(idx),
 				AttributePathExpression: req.AttributePathExpression.AtListIndex(idx),
 				Config:                  req.Config,
+				Functions:               req.Functions,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
@@ -1026,6 +1057,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 				AttributePath:           req.AttributePath.AtSetValue(value),
 				AttributePathExpression: req.AttributePathExpression.AtSetValue(value),
 				Config:                  req.Config,
+				Functions:               req.Functions,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
@@ -1054,12 +1086,16 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			return
 		}
 
-		for key, value := range m.Elements() {
+		elements := m.Elements()
+
+		for _, key := range sortedKeys(elements) {
+			value := elements[key]
 			nestedAttributeObjectReq := ValidateAttributeRequest{
 				AttributeConfig:         value,
 				AttributePath:           req.AttributePath.AtMapKey(key),
 				AttributePathExpression: req.AttributePathExpression.AtMapKey(key),
 				Config:                  req.Config,
+				Functions:               req.Functions,
 			}
 			nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
@@ -1097,6 +1133,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a fwschema.Attribute
 			AttributePath:           req.AttributePath,
 			AttributePathExpression: req.AttributePathExpression,
 			Config:                  req.Config,
+			Functions:               req.Functions,
 		}
 		nestedAttributeObjectResp := &ValidateAttributeResponse{}
 
@@ -1145,6 +1182,7 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 
 		validateReq := validator.ObjectRequest{
 			Config:         req.Config,
+			Functions:      req.Functions,
 			ConfigValue:    object,
 			Path:           req.AttributePath,
 			PathExpression: req.AttributePathExpression,
@@ -1177,11 +1215,13 @@ func NestedAttributeObjectValidate(ctx context.Context, o fwschema.NestedAttribu
 		}
 	}
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	for _, nestedName := range sortedKeys(o.GetAttributes()) {
+		nestedAttr := o.GetAttributes()[nestedName]
 		nestedAttrReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
 			Config:                  req.Config,
+			Functions:               req.Functions,
 		}
 		nestedAttrResp := &ValidateAttributeResponse{}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -386,6 +387,115 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"deprecation-replacement-known": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test":             tftypes.String,
+							"test_replacement": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test":             tftypes.NewValue(tftypes.String, "testvalue"),
+						"test_replacement": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": resourceschema.StringAttribute{
+								Optional:               true,
+								DeprecationMessage:     "Use test_replacement instead.",
+								DeprecationReplacement: path.MatchRoot("test_replacement"),
+							},
+							"test_replacement": resourceschema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeDeprecationDiagnostic(
+						path.Root("test"),
+						path.MatchRoot("test_replacement"),
+						"Use test_replacement instead.",
+					),
+				},
+			},
+		},
+		"deprecation-replacement-known-nested-relative": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test").AtListIndex(0).AtName("nested"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested":             tftypes.String,
+										"nested_replacement": tftypes.String,
+									},
+								},
+							},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested":             tftypes.String,
+										"nested_replacement": tftypes.String,
+									},
+								},
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested":             tftypes.String,
+											"nested_replacement": tftypes.String,
+										},
+									},
+									map[string]tftypes.Value{
+										"nested":             tftypes.NewValue(tftypes.String, "testvalue"),
+										"nested_replacement": tftypes.NewValue(tftypes.String, nil),
+									},
+								),
+							},
+						),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": resourceschema.ListNestedAttribute{
+								NestedObject: resourceschema.NestedAttributeObject{
+									Attributes: map[string]resourceschema.Attribute{
+										"nested": resourceschema.StringAttribute{
+											Optional:               true,
+											DeprecationMessage:     "Use nested_replacement instead.",
+											DeprecationReplacement: path.MatchRelative().AtParent().AtName("nested_replacement"),
+										},
+										"nested_replacement": resourceschema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeDeprecationDiagnostic(
+						path.Root("test").AtListIndex(0).AtName("nested"),
+						path.MatchRelative().AtParent().AtName("nested_replacement"),
+						"Use nested_replacement instead.",
+					),
+				},
+			},
+		},
 		"deprecation-message-known-dynamic": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -551,7 +551,7 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range block.ListPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(block.ListPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ListResponse{
@@ -587,6 +587,8 @@ func BlockPlanModifyList(ctx context.Context, block fwxschema.BlockWithListPlanM
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -711,7 +713,7 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range block.ObjectPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(block.ObjectPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ObjectResponse{
@@ -747,6 +749,8 @@ func BlockPlanModifyObject(ctx context.Context, block fwxschema.BlockWithObjectP
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -871,7 +875,7 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 		StateValue:     stateValue,
 	}
 
-	for _, planModifier := range block.SetPlanModifiers() {
+	for _, planModifier := range orderedPlanModifiers(block.SetPlanModifiers()) {
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.SetResponse{
@@ -907,6 +911,8 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 			resp.RequiresReplace.Append(req.AttributePath)
 		}
 
+		resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 		// Only on new errors.
 		if planModifyResp.Diagnostics.HasError() {
 			return
@@ -930,7 +936,7 @@ func BlockPlanModifySet(ctx context.Context, block fwxschema.BlockWithSetPlanMod
 
 func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObject, req planmodifier.ObjectRequest, resp *ModifyAttributePlanResponse) {
 	if objectWithPlanModifiers, ok := o.(fwxschema.NestedBlockObjectWithPlanModifiers); ok {
-		for _, objectPlanModifier := range objectWithPlanModifiers.ObjectPlanModifiers() {
+		for _, objectPlanModifier := range orderedPlanModifiers(objectWithPlanModifiers.ObjectPlanModifiers()) {
 			// Instantiate a new response for each request to prevent plan modifiers
 			// from modifying or removing diagnostics.
 			planModifyResp := &planmodifier.ObjectResponse{
@@ -965,6 +971,8 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 				resp.RequiresReplace.Append(req.Path)
 			}
 
+			resp.RequiresReplace.Append(planModifyResp.RequiresReplacePaths...)
+
 			// only on new errors
 			if planModifyResp.Diagnostics.HasError() {
 				return
@@ -972,9 +980,21 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 		}
 	}
 
+	// If the nested object itself is null or unknown, skip calling nested
+	// attribute and block plan modifiers, matching nested attribute objects.
+	// Otherwise, rebuilding the object from its attributes would errantly
+	// convert a null or unknown object, such as a single nested block which
+	// is not present in the configuration, into a known object.
+	//
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/993
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
 	newPlanValueAttributes := req.PlanValue.Attributes()
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	for _, nestedName := range sortedKeys(o.GetAttributes()) {
+		nestedAttr := o.GetAttributes()[nestedName]
 		nestedAttrConfig, diags := objectAttributeValue(ctx, req.ConfigValue, nestedName, fwschemadata.DataDescriptionConfiguration)
 
 		resp.Diagnostics.Append(diags...)
@@ -1024,7 +1044,8 @@ func NestedBlockObjectPlanModify(ctx context.Context, o fwschema.NestedBlockObje
 		resp.RequiresReplace.Append(nestedAttrResp.RequiresReplace...)
 	}
 
-	for nestedName, nestedBlock := range o.GetBlocks() {
+	for _, nestedName := range sortedKeys(o.GetBlocks()) {
+		nestedBlock := o.GetBlocks()[nestedName]
 		nestedBlockConfig, diags := objectAttributeValue(ctx, req.ConfigValue, nestedName, fwschemadata.DataDescriptionConfiguration)
 
 		resp.Diagnostics.Append(diags...)
@@ -6864,6 +6864,56 @@ func TestNestedBlockObjectPlanModify(t *testing.T) {
 				AttributePlan: fwValue,
 			},
 		},
+		"request-planvalue-null": {
+			object: testschema.NestedBlockObjectWithPlanModifiers{
+				Attributes: map[string]fwschema.Attribute{
+					"testattr": testschema.AttributeWithStringPlanModifiers{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							testplanmodifier.String{
+								PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+									resp.Diagnostics.AddError(
+										"Unexpected String Plan Modifier Call",
+										"Nested attribute plan modifiers should not be called for a null object.",
+									)
+								},
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					testplanmodifier.Object{
+						PlanModifyObjectMethod: func(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+							got := req.PlanValue
+							expected := types.ObjectNull(map[string]attr.Type{"testattr": types.StringType})
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected ObjectRequest.PlanValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: planmodifier.ObjectRequest{
+				Config:         testConfig,
+				ConfigValue:    types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Plan:           testPlan,
+				PlanValue:      types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				State:          testState,
+				StateValue:     types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+			},
+		},
 		"request-private": {
 			object: testschema.NestedBlockObjectWithPlanModifiers{
 				PlanModifiers: []planmodifier.Object{
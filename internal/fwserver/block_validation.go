@@ -77,6 +77,7 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 				AttributePath:           req.AttributePath.AtListIndex(idx),
 				AttributePathExpression: req.AttributePathExpression.AtListIndex(idx),
 				Config:                  req.Config,
+				Functions:               req.Functions,
 			}
 			nestedBlockObjectResp := &ValidateAttributeResponse{}
 
@@ -111,6 +112,7 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 				AttributePath:           req.AttributePath.AtSetValue(value),
 				AttributePathExpression: req.AttributePathExpression.AtSetValue(value),
 				Config:                  req.Config,
+				Functions:               req.Functions,
 			}
 			nestedBlockObjectResp := &ValidateAttributeResponse{}
 
@@ -144,6 +146,7 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 			AttributePath:           req.AttributePath,
 			AttributePathExpression: req.AttributePathExpression,
 			Config:                  req.Config,
+			Functions:               req.Functions,
 		}
 		nestedBlockObjectResp := &ValidateAttributeResponse{}
 
@@ -204,6 +207,7 @@ func BlockValidateList(ctx context.Context, block fwxschema.BlockWithListValidat
 
 	validateReq := validator.ListRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -269,6 +273,7 @@ func BlockValidateObject(ctx context.Context, block fwxschema.BlockWithObjectVal
 
 	validateReq := validator.ObjectRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -334,6 +339,7 @@ func BlockValidateSet(ctx context.Context, block fwxschema.BlockWithSetValidator
 
 	validateReq := validator.SetRequest{
 		Config:         req.Config,
+		Functions:      req.Functions,
 		ConfigValue:    configValue,
 		Path:           req.AttributePath,
 		PathExpression: req.AttributePathExpression,
@@ -396,6 +402,7 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 
 		validateReq := validator.ObjectRequest{
 			Config:         req.Config,
+			Functions:      req.Functions,
 			ConfigValue:    object,
 			Path:           req.AttributePath,
 			PathExpression: req.AttributePathExpression,
@@ -428,11 +435,13 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 		}
 	}
 
-	for nestedName, nestedAttr := range o.GetAttributes() {
+	for _, nestedName := range sortedKeys(o.GetAttributes()) {
+		nestedAttr := o.GetAttributes()[nestedName]
 		nestedAttrReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
 			Config:                  req.Config,
+			Functions:               req.Functions,
 		}
 		nestedAttrResp := &ValidateAttributeResponse{}
 
@@ -441,11 +450,13 @@ func NestedBlockObjectValidate(ctx context.Context, o fwschema.NestedBlockObject
 		resp.Diagnostics.Append(nestedAttrResp.Diagnostics...)
 	}
 
-	for nestedName, nestedBlock := range o.GetBlocks() {
+	for _, nestedName := range sortedKeys(o.GetBlocks()) {
+		nestedBlock := o.GetBlocks()[nestedName]
 		nestedBlockReq := ValidateAttributeRequest{
 			AttributePath:           req.AttributePath.AtName(nestedName),
 			AttributePathExpression: req.AttributePathExpression.AtName(nestedName),
 			Config:                  req.Config,
+			Functions:               req.Functions,
 		}
 		nestedBlockResp := &ValidateAttributeResponse{}
 
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// DeferredExplanationDiagnostics returns a warning diagnostic containing the
+// deferred response explanation, if any, so practitioners can determine why
+// the change was deferred. The subject is the kind of deferred type, such as
+// resource.
+func DeferredExplanationDiagnostics(ctx context.Context, subject string, reason fmt.Stringer, explanation string) diag.Diagnostics {
+	if explanation == "" {
+		return nil
+	}
+
+	logging.FrameworkDebug(ctx, "Deferred response includes explanation",
+		map[string]interface{}{
+			logging.KeyDeferredReason:      reason.String(),
+			logging.KeyDeferredExplanation: explanation,
+		},
+	)
+
+	return diag.Diagnostics{
+		diag.NewWarningDiagnostic(
+			"Deferred Change Explanation",
+			fmt.Sprintf("The provider deferred changes to this %s.\n\n", subject)+
+				fmt.Sprintf("Reason: %s\n", reason)+
+				fmt.Sprintf("Explanation: %s", explanation),
+		),
+	}
+}
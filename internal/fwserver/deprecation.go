@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// deprecationMessage returns the practitioner-facing deprecation message for
+// a resource or data source type, based on its structured deprecation
+// metadata. The kind should be "resource" or "data source".
+func deprecationMessage(kind string, typeName string, message string, replacementTypeName string, removalVersion string) string {
+	var b strings.Builder
+
+	if removalVersion != "" {
+		fmt.Fprintf(&b, "The %s %s type is deprecated and will be removed in version %s.", typeName, kind, removalVersion)
+	} else {
+		fmt.Fprintf(&b, "The %s %s type is deprecated.", typeName, kind)
+	}
+
+	if replacementTypeName != "" {
+		fmt.Fprintf(&b, " Use the %s %s type instead.", replacementTypeName, kind)
+	}
+
+	if message != "" {
+		b.WriteString(" ")
+		b.WriteString(message)
+	}
+
+	return b.String()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 func attributePlanModificationTypableError(schemaPath path.Path, value attr.Value) diag.Diagnostic {
@@ -25,6 +26,18 @@ func attributePlanModificationTypableError(schemaPath path.Path, value attr.Valu
 	)
 }
 
+// expandConfigDiagnosticPathExpressions returns the diagnostics with any
+// path expressions expanded into matching paths of the configuration.
+func expandConfigDiagnosticPathExpressions(ctx context.Context, config tfsdk.Config, diags diag.Diagnostics) diag.Diagnostics {
+	configData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         config.Schema,
+		TerraformValue: config.Raw,
+	}
+
+	return configData.ExpandDiagnosticPathExpressions(ctx, diags)
+}
+
 func schemaDataValueError(ctx context.Context, value attr.Value, description fwschemadata.DataDescription, err error) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		description.Title()+" Value Error",
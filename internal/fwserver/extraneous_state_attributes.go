@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// extraneousStateAttributesDiags returns diagnostics for attributes in the
+// raw state JSON which are not defined in the schema type, according to the
+// resource behavior.
+func extraneousStateAttributesDiags(ctx context.Context, behavior resource.ExtraneousStateAttributesBehavior, rawState *tfprotov6.RawState, schemaType tftypes.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if behavior == resource.ExtraneousStateAttributesIgnore || rawState == nil || len(rawState.JSON) == 0 {
+		return diags
+	}
+
+	attributePaths := extraneousJSONAttributePaths(rawState.JSON, schemaType, "")
+
+	if len(attributePaths) == 0 {
+		return diags
+	}
+
+	sort.Strings(attributePaths)
+
+	logging.FrameworkDebug(ctx, "Prior state contains attributes not defined in the resource schema", map[string]interface{}{
+		"attributes": attributePaths,
+		"behavior":   behavior.String(),
+	})
+
+	attributeList := "- " + strings.Join(attributePaths, "\n- ")
+
+	switch behavior {
+	case resource.ExtraneousStateAttributesWarn:
+		diags.AddWarning(
+			"Extraneous Resource State Attributes Removed",
+			"The prior resource state contains attributes which are not defined in the resource schema, "+
+				"such as after downgrading the provider to a version without the attributes. "+
+				"The attributes were removed from the resource state:\n\n"+attributeList,
+		)
+	case resource.ExtraneousStateAttributesError:
+		diags.AddError(
+			"Extraneous Resource State Attributes",
+			"The prior resource state contains attributes which are not defined in the resource schema, "+
+				"such as after downgrading the provider to a version without the attributes. "+
+				"To prevent losing data, upgrade the provider to a version which defines the attributes:\n\n"+attributeList,
+		)
+	}
+
+	return diags
+}
+
+// extraneousJSONAttributePaths returns the paths of object attributes in the
+// JSON which are not defined in the type. JSON which cannot be decoded is
+// skipped, since the unmarshalling of the state reports those errors.
+func extraneousJSONAttributePaths(data json.RawMessage, typ tftypes.Type, parentPath string) []string {
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var result []string
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		var attributes map[string]json.RawMessage
+
+		if err := json.Unmarshal(data, &attributes); err != nil {
+			return nil
+		}
+
+		for name, value := range attributes {
+			attributePath := name
+
+			if parentPath != "" {
+				attributePath = parentPath + "." + name
+			}
+
+			attributeType, ok := typ.AttributeTypes[name]
+
+			if !ok {
+				result = append(result, attributePath)
+
+				continue
+			}
+
+			result = append(result, extraneousJSONAttributePaths(value, attributeType, attributePath)...)
+		}
+	case tftypes.List:
+		result = extraneousJSONElementPaths(data, typ.ElementType, parentPath)
+	case tftypes.Set:
+		result = extraneousJSONElementPaths(data, typ.ElementType, parentPath)
+	case tftypes.Map:
+		var elements map[string]json.RawMessage
+
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil
+		}
+
+		for key, value := range elements {
+			result = append(result, extraneousJSONAttributePaths(value, typ.ElementType, fmt.Sprintf("%s[%q]", parentPath, key))...)
+		}
+	case tftypes.Tuple:
+		var elements []json.RawMessage
+
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil
+		}
+
+		for index, value := range elements {
+			if index >= len(typ.ElementTypes) {
+				break
+			}
+
+			result = append(result, extraneousJSONAttributePaths(value, typ.ElementTypes[index], fmt.Sprintf("%s[%d]", parentPath, index))...)
+		}
+	}
+
+	return result
+}
+
+// extraneousJSONElementPaths returns the extraneous attribute paths of each
+// list or set element in the JSON.
+func extraneousJSONElementPaths(data json.RawMessage, elementType tftypes.Type, parentPath string) []string {
+	var elements []json.RawMessage
+
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return nil
+	}
+
+	var result []string
+
+	for index, value := range elements {
+		result = append(result, extraneousJSONAttributePaths(value, elementType, fmt.Sprintf("%s[%d]", parentPath, index))...)
+	}
+
+	return result
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExtraneousJSONAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list":   tftypes.List{ElementType: nestedType},
+			"map":    tftypes.Map{ElementType: nestedType},
+			"object": nestedType,
+			"set":    tftypes.Set{ElementType: nestedType},
+			"string": tftypes.String,
+			"tuple":  tftypes.Tuple{ElementTypes: []tftypes.Type{nestedType}},
+		},
+	}
+
+	testCases := map[string]struct {
+		json     string
+		expected []string
+	}{
+		"none": {
+			json: `{"list":[{"name":"a"}],"object":{"name":"b"},"string":"c"}`,
+		},
+		"null": {
+			json: `null`,
+		},
+		"invalid": {
+			json: `{"list":"invalid"}`,
+		},
+		"root": {
+			json:     `{"string":"c","extra":"d"}`,
+			expected: []string{"extra"},
+		},
+		"nested": {
+			json: `{` +
+				`"list":[{"name":"a","extra":1},{"name":"b"}],` +
+				`"map":{"key":{"extra":true}},` +
+				`"object":{"extra":null},` +
+				`"set":[{"extra":"a"}],` +
+				`"tuple":[{"extra":"a"}]` +
+				`}`,
+			expected: []string{
+				"list[0].extra",
+				`map["key"].extra`,
+				"object.extra",
+				"set[0].extra",
+				"tuple[0].extra",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := extraneousJSONAttributePaths([]byte(testCase.json), testType, "")
+
+			sort.Strings(got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
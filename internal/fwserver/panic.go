@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// sensitiveValueReplacement replaces sensitive values in panic messages and
+// stack traces.
+const sensitiveValueReplacement = "(sensitive value)"
+
+// stackTraceArguments matches the function arguments of a stack trace frame,
+// such as "(0xc000123456, {0x1, 0x2})", which can contain raw data words.
+var stackTraceArguments = regexp.MustCompile(`\(([^()]*)\)$`)
+
+// panicRedactMessage can be given as request data to recoverPanic and
+// recoverFunctionPanic to remove the whole panic message, such as for
+// ephemeral resources where sensitive data is not limited to the request.
+type panicRedactMessage struct{}
+
+// recoverPanic converts a panic in provider-defined logic into an error
+// diagnostic, so the provider server can continue serving other requests.
+// The panic message and stack trace have sensitive attribute values from the
+// given request data scrubbed. It must be called directly with defer.
+//
+// Valid data types are *tfsdk.Config, *tfsdk.Plan, *tfsdk.State,
+// function.ArgumentsData, and panicRedactMessage.
+func recoverPanic(ctx context.Context, rpc string, diags *diag.Diagnostics, data ...any) {
+	r := recover()
+
+	if r == nil {
+		return
+	}
+
+	diags.AddError("Provider Panic", panicDetail(ctx, rpc, r, data...))
+}
+
+// recoverFunctionPanic is the equivalent of recoverPanic for functions, which
+// return a function error instead of diagnostics. All argument values are
+// considered sensitive, since function parameters have no sensitivity. It
+// must be called directly with defer.
+func recoverFunctionPanic(ctx context.Context, funcErr **function.FuncError, arguments function.ArgumentsData) {
+	r := recover()
+
+	if r == nil {
+		return
+	}
+
+	*funcErr = function.ConcatFuncErrors(*funcErr, function.NewFuncError(
+		"Provider Panic: "+panicDetail(ctx, "CallFunction", r, arguments),
+	))
+}
+
+// panicDetail returns the error details for the recovered panic value and
+// logs the scrubbed panic message.
+func panicDetail(ctx context.Context, rpc string, r any, data ...any) string {
+	sensitiveValues, redactMessage := panicSensitiveValues(ctx, data...)
+	message := sensitiveValueReplacement
+
+	if !redactMessage {
+		message = scrubSensitiveValues(fmt.Sprint(r), sensitiveValues)
+	}
+
+	stack := scrubSensitiveValues(scrubStackTraceArguments(string(debug.Stack())), sensitiveValues)
+
+	logging.FrameworkError(ctx, "Recovered from panic in provider defined logic", map[string]interface{}{logging.KeyError: message})
+
+	return fmt.Sprintf("The provider panicked while handling the %s request. ", rpc) +
+		"This is always an issue with the provider and should be reported to the provider developers. " +
+		"Sensitive values have been removed from the following details.\n\n" +
+		fmt.Sprintf("Panic: %s\n\nStack Trace:\n%s", message, stack)
+}
+
+// panicSensitiveValues returns all known, non-empty string values of
+// sensitive attributes in the data, longest first. The returned bool is true
+// if the whole panic message must be removed, since the data contains known
+// sensitive values, such as numbers and booleans, which cannot be reliably
+// scrubbed from text.
+func panicSensitiveValues(ctx context.Context, data ...any) ([]string, bool) {
+	var values []string
+	var redactMessage bool
+
+	for _, d := range data {
+		var raw tftypes.Value
+		var schema fwschema.Schema
+
+		switch d := d.(type) {
+		case *tfsdk.Config:
+			if d == nil {
+				continue
+			}
+
+			raw, schema = d.Raw, d.Schema
+		case *tfsdk.Plan:
+			if d == nil {
+				continue
+			}
+
+			raw, schema = d.Raw, d.Schema
+		case *tfsdk.State:
+			if d == nil {
+				continue
+			}
+
+			raw, schema = d.Raw, d.Schema
+		case function.ArgumentsData:
+			for _, argument := range functionArgumentValues(ctx, d) {
+				if argument == nil {
+					continue
+				}
+
+				tfValue, err := argument.ToTerraformValue(ctx)
+
+				if err != nil {
+					redactMessage = true
+
+					continue
+				}
+
+				stringValues, ok := tftypesSensitiveValues(tfValue)
+
+				values = append(values, stringValues...)
+				redactMessage = redactMessage || !ok
+			}
+
+			continue
+		case panicRedactMessage:
+			redactMessage = true
+
+			continue
+		default:
+			continue
+		}
+
+		if schema == nil {
+			continue
+		}
+
+		_ = tftypes.Walk(raw, func(tfPath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+			attribute, err := schema.AttributeAtTerraformPath(ctx, tfPath)
+
+			if err != nil || !attribute.IsSensitive() {
+				return true, nil
+			}
+
+			stringValues, ok := tftypesSensitiveValues(value)
+
+			values = append(values, stringValues...)
+			redactMessage = redactMessage || !ok
+
+			return false, nil
+		})
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+
+	return values, redactMessage
+}
+
+// tftypesSensitiveValues returns all known, non-empty string values within
+// the value, including nested values. The returned bool is false if the value
+// contains other known primitive values, which cannot be reliably scrubbed.
+func tftypesSensitiveValues(value tftypes.Value) ([]string, bool) {
+	var values []string
+	scrubbable := true
+
+	_ = tftypes.Walk(value, func(_ *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if !value.IsKnown() || value.IsNull() {
+			return true, nil
+		}
+
+		if !value.Type().Is(tftypes.String) {
+			if value.Type().Is(tftypes.Bool) || value.Type().Is(tftypes.Number) {
+				scrubbable = false
+			}
+
+			return true, nil
+		}
+
+		var s string
+
+		if err := value.As(&s); err == nil && s != "" {
+			values = append(values, s)
+		}
+
+		return true, nil
+	})
+
+	return values, scrubbable
+}
+
+// scrubSensitiveValues replaces all occurrences of the sensitive values.
+func scrubSensitiveValues(s string, sensitiveValues []string) string {
+	for _, sensitiveValue := range sensitiveValues {
+		s = strings.ReplaceAll(s, sensitiveValue, sensitiveValueReplacement)
+	}
+
+	return s
+}
+
+// scrubStackTraceArguments removes function arguments from stack trace
+// frames, since the raw argument data words can contain sensitive data.
+func scrubStackTraceArguments(stack string) string {
+	lines := strings.Split(stack, "\n")
+
+	for i, line := range lines {
+		// File and line number lines are indented.
+		if strings.HasPrefix(line, "\t") {
+			continue
+		}
+
+		lines[i] = stackTraceArguments.ReplaceAllString(line, "(...)")
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestServerReadResource_panic(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testState := &tfsdk.State{
+		Raw: tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"password": tftypes.NewValue(tftypes.String, "hunter2"),
+			"username": tftypes.NewValue(tftypes.String, "admin"),
+		}),
+		Schema: testSchema,
+	}
+
+	server := &Server{
+		Provider: &testprovider.Provider{},
+	}
+	resp := &ReadResourceResponse{}
+
+	server.ReadResource(context.Background(), &ReadResourceRequest{
+		CurrentState: testState,
+		Resource: &testprovider.Resource{
+			ReadMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+				panic("unable to login as admin with hunter2")
+			},
+		},
+	}, resp)
+
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got: %v", resp.Diagnostics)
+	}
+
+	got := resp.Diagnostics[0]
+
+	if got.Severity() != diag.SeverityError || got.Summary() != "Provider Panic" {
+		t.Errorf("unexpected diagnostic: %s: %s", got.Severity(), got.Summary())
+	}
+
+	if !strings.Contains(got.Detail(), "Panic: unable to login as admin with (sensitive value)") {
+		t.Errorf("expected scrubbed panic message in detail, got: %s", got.Detail())
+	}
+
+	if strings.Contains(got.Detail(), "hunter2") {
+		t.Errorf("expected no sensitive value in detail, got: %s", got.Detail())
+	}
+
+	if !strings.Contains(got.Detail(), "TestServerReadResource_panic") {
+		t.Errorf("expected stack trace in detail, got: %s", got.Detail())
+	}
+}
+
+func TestServerConfigureProvider_panic(t *testing.T) {
+	t.Parallel()
+
+	testSchema := providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"pin": providerschema.Int64Attribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	server := &Server{
+		Provider: &testprovider.Provider{
+			ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+				panic("invalid pin 1234")
+			},
+		},
+	}
+	resp := &provider.ConfigureResponse{}
+
+	server.ConfigureProvider(context.Background(), &provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+				"pin": tftypes.NewValue(tftypes.Number, 1234),
+			}),
+			Schema: testSchema,
+		},
+	}, resp)
+
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got: %v", resp.Diagnostics)
+	}
+
+	got := resp.Diagnostics[0]
+
+	if !strings.Contains(got.Detail(), "Panic: (sensitive value)\n") {
+		t.Errorf("expected removed panic message in detail, got: %s", got.Detail())
+	}
+
+	if strings.Contains(got.Detail(), "1234") {
+		t.Errorf("expected no sensitive value in detail, got: %s", got.Detail())
+	}
+}
+
+func TestServerCallFunction_panic(t *testing.T) {
+	t.Parallel()
+
+	server := &Server{
+		Provider: &testprovider.Provider{},
+	}
+	resp := &CallFunctionResponse{}
+
+	server.CallFunction(context.Background(), &CallFunctionRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("hunter2")}),
+		Function: &testprovider.Function{
+			RunMethod: func(_ context.Context, _ function.RunRequest, _ *function.RunResponse) {
+				panic("unable to parse hunter2")
+			},
+		},
+		FunctionDefinition: function.Definition{
+			Return: function.StringReturn{},
+		},
+		FunctionName: "test",
+	}, resp)
+
+	if resp.Error == nil {
+		t.Fatal("expected function error")
+	}
+
+	if !strings.Contains(resp.Error.Text, "Panic: unable to parse (sensitive value)") {
+		t.Errorf("expected scrubbed panic message in error, got: %s", resp.Error.Text)
+	}
+
+	if strings.Contains(resp.Error.Text, "hunter2") {
+		t.Errorf("expected no sensitive value in error, got: %s", resp.Error.Text)
+	}
+}
+
+func TestServerOpenEphemeralResource_panic(t *testing.T) {
+	t.Parallel()
+
+	testSchema := ephemeralschema.Schema{
+		Attributes: map[string]ephemeralschema.Attribute{
+			"name": ephemeralschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	server := &Server{
+		Provider: &testprovider.Provider{},
+	}
+	resp := &OpenEphemeralResourceResponse{}
+
+	server.OpenEphemeralResource(context.Background(), &OpenEphemeralResourceRequest{
+		Config: &tfsdk.Config{
+			Raw: tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			Schema: testSchema,
+		},
+		EphemeralResourceSchema: testSchema,
+		EphemeralResource: &testprovider.EphemeralResource{
+			OpenMethod: func(_ context.Context, _ ephemeral.OpenRequest, _ *ephemeral.OpenResponse) {
+				panic("unexpected token hunter2")
+			},
+		},
+	}, resp)
+
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got: %v", resp.Diagnostics)
+	}
+
+	got := resp.Diagnostics[0]
+
+	if !strings.Contains(got.Detail(), "Panic: (sensitive value)\n") {
+		t.Errorf("expected removed panic message in detail, got: %s", got.Detail())
+	}
+
+	if strings.Contains(got.Detail(), "hunter2") {
+		t.Errorf("expected no sensitive value in detail, got: %s", got.Detail())
+	}
+}
+
+func TestScrubStackTraceArguments(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stack    string
+		expected string
+	}{
+		"no-arguments": {
+			stack:    "goroutine 1 [running]:\nmain.main()\n\t/tmp/main.go:5 +0x1d",
+			expected: "goroutine 1 [running]:\nmain.main(...)\n\t/tmp/main.go:5 +0x1d",
+		},
+		"arguments": {
+			stack:    "example.com/pkg.(*Thing).Read(0xc000012345, {0x1, 0x2}, 0x3)\n\t/tmp/pkg.go:10 +0x2a",
+			expected: "example.com/pkg.(*Thing).Read(...)\n\t/tmp/pkg.go:10 +0x2a",
+		},
+		"panic": {
+			stack:    "panic({0x1024e0, 0x14e1f0})\n\t/usr/local/go/src/runtime/panic.go:770 +0x124",
+			expected: "panic(...)\n\t/usr/local/go/src/runtime/panic.go:770 +0x124",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := scrubStackTraceArguments(testCase.stack)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// PlannedStateConsistency returns an error diagnostic for each value of the
+// new state that is inconsistent with the planned state, following the same
+// rules Terraform applies after the ApplyResourceChange RPC. The operation is
+// used in diagnostic details, such as Create or Update.
+//
+// Terraform requires that:
+//
+//   - Known planned values are unchanged in the new state, including null
+//     values.
+//   - Unknown planned values are any value in the new state.
+//
+// Sets containing unknown values are skipped, since Terraform cannot
+// correlate their elements between the planned and new state.
+func PlannedStateConsistency(ctx context.Context, schema fwschema.Schema, operation string, plannedState tftypes.Value, newState tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A null planned state is only used for resource destruction, which has
+	// no new state to verify.
+	if plannedState.IsNull() {
+		return diags
+	}
+
+	for _, inconsistentPath := range inconsistentPlannedValuePaths(tftypes.NewAttributePath(), plannedState, newState) {
+		values := "Values are omitted since the value is sensitive."
+
+		if !isSensitiveTerraformPath(ctx, schema, inconsistentPath) {
+			values = fmt.Sprintf(
+				"Planned Value: %s\nNew State Value: %s",
+				terraformValueAtPathString(plannedState, inconsistentPath),
+				terraformValueAtPathString(newState, inconsistentPath),
+			)
+		}
+
+		logging.FrameworkError(
+			ctx,
+			"Provider produced inconsistent result after "+operation,
+			map[string]interface{}{
+				logging.KeyAttributePath: inconsistentPath.String(),
+			},
+		)
+
+		detail := fmt.Sprintf("After the resource %s logic, the new state value does not match the planned value. ", operation) +
+			"Terraform will return an error about the provider producing an inconsistent result. " +
+			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+			"Known planned values must be saved into the new state unchanged. " +
+			"Provider developers should either set the new state value to the planned value, " +
+			"or mark the value as unknown during planning, such as by not using a plan modifier or default value which sets it.\n\n" +
+			values
+
+		attributePath, pathDiags := fromtftypes.AttributePath(ctx, inconsistentPath, schema)
+
+		if pathDiags.HasError() {
+			diags.AddError(
+				"Provider Produced Inconsistent Result",
+				fmt.Sprintf("Attribute Path: %s\n\n", inconsistentPath)+detail,
+			)
+
+			continue
+		}
+
+		diags.AddAttributeError(attributePath, "Provider Produced Inconsistent Result", detail)
+	}
+
+	return diags
+}
+
+// inconsistentPlannedValuePaths returns the paths of all values in the new
+// state which are inconsistent with the planned state.
+func inconsistentPlannedValuePaths(tfPath *tftypes.AttributePath, planned tftypes.Value, actual tftypes.Value) []*tftypes.AttributePath {
+	if !planned.IsKnown() {
+		return nil
+	}
+
+	if planned.IsNull() || actual.IsNull() || !actual.IsKnown() {
+		if planned.IsNull() && actual.IsNull() {
+			return nil
+		}
+
+		return []*tftypes.AttributePath{tfPath}
+	}
+
+	if !planned.Type().Equal(actual.Type()) {
+		return []*tftypes.AttributePath{tfPath}
+	}
+
+	switch {
+	case planned.Type().Is(tftypes.Object{}):
+		var plannedAttributes, actualAttributes map[string]tftypes.Value
+
+		// Errors are not possible as the types were checked.
+		_ = planned.As(&plannedAttributes)
+		_ = actual.As(&actualAttributes)
+
+		var result []*tftypes.AttributePath
+
+		for _, name := range sortedKeys(plannedAttributes) {
+			result = append(result, inconsistentPlannedValuePaths(tfPath.WithAttributeName(name), plannedAttributes[name], actualAttributes[name])...)
+		}
+
+		return result
+	case planned.Type().Is(tftypes.Map{}):
+		var plannedElements, actualElements map[string]tftypes.Value
+
+		_ = planned.As(&plannedElements)
+		_ = actual.As(&actualElements)
+
+		var result []*tftypes.AttributePath
+
+		for _, key := range sortedKeys(plannedElements) {
+			actualElement, ok := actualElements[key]
+
+			if !ok {
+				result = append(result, tfPath.WithElementKeyString(key))
+
+				continue
+			}
+
+			result = append(result, inconsistentPlannedValuePaths(tfPath.WithElementKeyString(key), plannedElements[key], actualElement)...)
+		}
+
+		for _, key := range sortedKeys(actualElements) {
+			if _, ok := plannedElements[key]; !ok {
+				result = append(result, tfPath.WithElementKeyString(key))
+			}
+		}
+
+		return result
+	case planned.Type().Is(tftypes.List{}), planned.Type().Is(tftypes.Tuple{}):
+		var plannedElements, actualElements []tftypes.Value
+
+		_ = planned.As(&plannedElements)
+		_ = actual.As(&actualElements)
+
+		if len(plannedElements) != len(actualElements) {
+			return []*tftypes.AttributePath{tfPath}
+		}
+
+		var result []*tftypes.AttributePath
+
+		for index := range plannedElements {
+			result = append(result, inconsistentPlannedValuePaths(tfPath.WithElementKeyInt(index), plannedElements[index], actualElements[index])...)
+		}
+
+		return result
+	case planned.Type().Is(tftypes.Set{}):
+		if !planned.IsFullyKnown() || planned.Equal(actual) {
+			return nil
+		}
+
+		return []*tftypes.AttributePath{tfPath}
+	default:
+		if planned.Equal(actual) {
+			return nil
+		}
+
+		return []*tftypes.AttributePath{tfPath}
+	}
+}
+
+// isSensitiveTerraformPath returns true if the attribute at the path, or any
+// parent attribute, is sensitive.
+func isSensitiveTerraformPath(ctx context.Context, schema fwschema.Schema, tfPath *tftypes.AttributePath) bool {
+	steps := tfPath.Steps()
+
+	for i := 1; i <= len(steps); i++ {
+		attribute, err := schema.AttributeAtTerraformPath(ctx, tftypes.NewAttributePathWithSteps(steps[:i]))
+
+		// Paths which are not attributes, such as blocks or elements, cannot
+		// be sensitive themselves.
+		if err != nil {
+			continue
+		}
+
+		if attribute.IsSensitive() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// terraformValueAtPathString returns the string representation of the value
+// at the path, for diagnostics.
+func terraformValueAtPathString(value tftypes.Value, tfPath *tftypes.AttributePath) string {
+	valueAtPath, _, err := tftypes.WalkAttributePath(value, tfPath)
+
+	if err != nil {
+		return "(missing)"
+	}
+
+	return fmt.Sprintf("%s", valueAtPath)
+}
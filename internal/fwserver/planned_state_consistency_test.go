@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlannedStateConsistency(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_string": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_sensitive": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"test_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"test_map": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"test_set": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"test_single_nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_nested_string": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested_string": tftypes.String,
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string":        tftypes.String,
+			"test_sensitive":     tftypes.String,
+			"test_list":          tftypes.List{ElementType: tftypes.String},
+			"test_map":           tftypes.Map{ElementType: tftypes.String},
+			"test_set":           tftypes.Set{ElementType: tftypes.String},
+			"test_single_nested": testNestedType,
+		},
+	}
+
+	// testValue returns a value with the given attribute values, otherwise
+	// consistent values.
+	testValue := func(values map[string]tftypes.Value) tftypes.Value {
+		attributes := map[string]tftypes.Value{
+			"test_string":    tftypes.NewValue(tftypes.String, "test-value"),
+			"test_sensitive": tftypes.NewValue(tftypes.String, "test-value"),
+			"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			"test_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"test-key": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			"test_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			"test_single_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+				"test_nested_string": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+		}
+
+		for name, value := range values {
+			attributes[name] = value
+		}
+
+		return tftypes.NewValue(testSchemaType, attributes)
+	}
+
+	testCases := map[string]struct {
+		plannedState tftypes.Value
+		newState     tftypes.Value
+		expected     diag.Diagnostics
+	}{
+		"consistent": {
+			plannedState: testValue(nil),
+			newState:     testValue(nil),
+		},
+		"planned-null": {
+			plannedState: tftypes.NewValue(testSchemaType, nil),
+			newState:     testValue(nil),
+		},
+		"planned-unknown": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"test_list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			}),
+			newState: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"string-changed": {
+			plannedState: testValue(nil),
+			newState: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, "test-new-value"),
+			}),
+			expected: diag.Diagnostics{
+				testInconsistentResultDiagnostic(path.Root("test_string"), "Create", `tftypes.String<"test-value">`, `tftypes.String<"test-new-value">`),
+			},
+		},
+		"string-null": {
+			plannedState: testValue(nil),
+			newState: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: diag.Diagnostics{
+				testInconsistentResultDiagnostic(path.Root("test_string"), "Create", `tftypes.String<"test-value">`, "tftypes.String<null>"),
+			},
+		},
+		"sensitive-changed": {
+			plannedState: testValue(nil),
+			newState: testValue(map[string]tftypes.Value{
+				"test_sensitive": tftypes.NewValue(tftypes.String, "test-new-value"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_sensitive"),
+					"Provider Produced Inconsistent Result",
+					testInconsistentResultDetail("Create")+"Values are omitted since the value is sensitive.",
+				),
+			},
+		},
+		"list-element-changed": {
+			plannedState: testValue(nil),
+			newState: testValue(map[string]tftypes.Value{
+				"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test-new-value"),
+				}),
+			}),
+			expected: diag.Diagnostics{
+				testInconsistentResultDiagnostic(path.Root("test_list").AtListIndex(0), "Create", `tftypes.String<"test-value">`, `tftypes.String<"test-new-value">`),
+			},
+		},
+		"list-length-changed": {
+			plannedState: testValue(nil),
+			newState: testValue(map[string]tftypes.Value{
+				"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+			}),
+			expected: diag.Diagnostics{
+				testInconsistentResultDiagnostic(path.Root("test_list"), "Create", `tftypes.List[tftypes.String]<tftypes.String<"test-value">>`, "tftypes.List[tftypes.String]<>"),
+			},
+		},
+		"map-key-added": {
+			plannedState: testValue(nil),
+			newState: testValue(map[string]tftypes.Value{
+				"test_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"test-key":       tftypes.NewValue(tftypes.String, "test-value"),
+					"test-other-key": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+			}),
+			expected: diag.Diagnostics{
+				testInconsistentResultDiagnostic(path.Root("test_map").AtMapKey("test-other-key"), "Create", "(missing)", `tftypes.String<"test-value">`),
+			},
+		},
+		"set-changed": {
+			plannedState: testValue(nil),
+			newState: testValue(map[string]tftypes.Value{
+				"test_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test-new-value"),
+				}),
+			}),
+			expected: diag.Diagnostics{
+				testInconsistentResultDiagnostic(path.Root("test_set"), "Create", `tftypes.Set[tftypes.String]<tftypes.String<"test-value">>`, `tftypes.Set[tftypes.String]<tftypes.String<"test-new-value">>`),
+			},
+		},
+		"set-partially-unknown": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			}),
+			newState: testValue(map[string]tftypes.Value{
+				"test_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "test-new-value"),
+					tftypes.NewValue(tftypes.String, "test-other-value"),
+				}),
+			}),
+		},
+		"nested-attribute-changed": {
+			plannedState: testValue(nil),
+			newState: testValue(map[string]tftypes.Value{
+				"test_single_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"test_nested_string": tftypes.NewValue(tftypes.String, "test-new-value"),
+				}),
+			}),
+			expected: diag.Diagnostics{
+				testInconsistentResultDiagnostic(path.Root("test_single_nested").AtName("test_nested_string"), "Create", `tftypes.String<"test-value">`, `tftypes.String<"test-new-value">`),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwserver.PlannedStateConsistency(context.Background(), testSchema, "Create", testCase.plannedState, testCase.newState)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testInconsistentResultDetail returns the diagnostic detail for a new state
+// value which does not match the planned value, without the values.
+func testInconsistentResultDetail(operation string) string {
+	return "After the resource " + operation + " logic, the new state value does not match the planned value. " +
+		"Terraform will return an error about the provider producing an inconsistent result. " +
+		"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+		"Known planned values must be saved into the new state unchanged. " +
+		"Provider developers should either set the new state value to the planned value, " +
+		"or mark the value as unknown during planning, such as by not using a plan modifier or default value which sets it.\n\n"
+}
+
+// testInconsistentResultDiagnostic returns the diagnostic for a new state
+// value which does not match the planned value.
+func testInconsistentResultDiagnostic(attributePath path.Path, operation string, plannedValue string, newStateValue string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Provider Produced Inconsistent Result",
+		testInconsistentResultDetail(operation)+"Planned Value: "+plannedValue+"\nNew State Value: "+newStateValue,
+	)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// PlannedStateReferences returns an error diagnostic for each planned value
+// of an attribute implementing fwschema.AttributeWithReferences which does
+// not match the planned value of a referenced attribute. Null and unknown
+// values, including values containing unknown values, are not compared as
+// they cannot be meaningfully checked during planning.
+func PlannedStateReferences(ctx context.Context, schema fwschema.Schema, plannedState tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A null planned state is only used for resource destruction, which has
+	// no values to verify.
+	if plannedState.Raw.IsNull() {
+		return diags
+	}
+
+	var referencingPaths []*tftypes.AttributePath
+
+	err := tftypes.Walk(plannedState.Raw, func(tfPath *tftypes.AttributePath, _ tftypes.Value) (bool, error) {
+		attribute, err := schema.AttributeAtTerraformPath(ctx, tfPath)
+
+		// Paths which are not attributes, such as the resource itself,
+		// blocks, or elements, are descended to find nested attributes.
+		if err != nil {
+			return true, nil
+		}
+
+		attributeWithReferences, ok := attribute.(fwschema.AttributeWithReferences)
+
+		if ok && !attributeWithReferences.GetReferences().Equal(path.Expression{}) {
+			referencingPaths = append(referencingPaths, tfPath)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Error Verifying Attribute References",
+			"There was an unexpected error walking the planned state. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	// Walking the planned state is not ordered, so sort the paths to return
+	// diagnostics deterministically.
+	sort.Slice(referencingPaths, func(i, j int) bool {
+		return referencingPaths[i].String() < referencingPaths[j].String()
+	})
+
+	for _, tfPath := range referencingPaths {
+		diags.Append(plannedStateReferencesAtPath(ctx, schema, plannedState, tfPath)...)
+	}
+
+	return diags
+}
+
+// plannedStateReferencesAtPath compares the planned value at the path with
+// the planned values of its referenced attributes.
+func plannedStateReferencesAtPath(ctx context.Context, schema fwschema.Schema, plannedState tfsdk.State, tfPath *tftypes.AttributePath) diag.Diagnostics {
+	attributePath, diags := fromtftypes.AttributePath(ctx, tfPath, schema)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	attribute, err := schema.AttributeAtTerraformPath(ctx, tfPath)
+
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Error Verifying Attribute References",
+			"There was an unexpected error finding the attribute in the schema. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	attributeWithReferences, ok := attribute.(fwschema.AttributeWithReferences)
+
+	if !ok {
+		return diags
+	}
+
+	plannedValue, valueDiags := plannedStateReferencesValue(ctx, plannedState, attributePath)
+
+	diags.Append(valueDiags...)
+
+	if diags.HasError() || plannedValue.IsNull() || !plannedValue.IsFullyKnown() {
+		return diags
+	}
+
+	references := attributeWithReferences.GetReferences()
+	referencedPaths, matchesDiags := plannedState.PathMatches(ctx, attributePath.Expression().Merge(references))
+
+	diags.Append(matchesDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, referencedPath := range referencedPaths {
+		referencedValue, valueDiags := plannedStateReferencesValue(ctx, plannedState, referencedPath)
+
+		diags.Append(valueDiags...)
+
+		if valueDiags.HasError() || referencedValue.IsNull() || !referencedValue.IsFullyKnown() {
+			continue
+		}
+
+		if plannedValue.Equal(referencedValue) {
+			continue
+		}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Planned value does not match referenced attribute value",
+			map[string]interface{}{
+				logging.KeyAttributePath: attributePath.String(),
+			},
+		)
+
+		values := "Values are omitted since the value is sensitive."
+		referencedTfPath, tfPathDiags := totftypes.AttributePath(ctx, referencedPath)
+
+		if !tfPathDiags.HasError() && !isSensitiveTerraformPath(ctx, schema, tfPath) && !isSensitiveTerraformPath(ctx, schema, referencedTfPath) {
+			values = fmt.Sprintf("Planned Value: %s\nReferenced Value: %s", plannedValue, referencedValue)
+		}
+
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Reference Value",
+			fmt.Sprintf("The planned value of this attribute must match the planned value of %s, as declared by the References field in the schema.\n\n", referencedPath)+
+				values,
+		)
+	}
+
+	return diags
+}
+
+// plannedStateReferencesValue returns the Terraform value at the path of the
+// planned state.
+func plannedStateReferencesValue(ctx context.Context, plannedState tfsdk.State, attributePath path.Path) (tftypes.Value, diag.Diagnostics) {
+	var value attr.Value
+
+	diags := plannedState.GetAttribute(ctx, attributePath, &value)
+
+	if diags.HasError() {
+		return tftypes.Value{}, diags
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Error Verifying Attribute References",
+			"There was an unexpected error converting the planned value. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+	}
+
+	return tfValue, diags
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestPlannedStateReferences(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_name": schema.StringAttribute{
+				Required: true,
+			},
+			"test_id": schema.StringAttribute{
+				Computed:   true,
+				References: path.MatchRoot("test_name"),
+			},
+			"test_secret": schema.StringAttribute{
+				Computed:   true,
+				Sensitive:  true,
+				References: path.MatchRoot("test_name"),
+			},
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_nested_name": schema.StringAttribute{
+							Required: true,
+						},
+						"test_nested_id": schema.StringAttribute{
+							Computed:   true,
+							References: path.MatchRelative().AtParent().AtName("test_nested_name"),
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested_name": tftypes.String,
+			"test_nested_id":   tftypes.String,
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_name":        tftypes.String,
+			"test_id":          tftypes.String,
+			"test_secret":      tftypes.String,
+			"test_list_nested": tftypes.List{ElementType: testNestedType},
+		},
+	}
+
+	// testValue returns a value with the given attribute values, otherwise
+	// matching values.
+	testValue := func(values map[string]tftypes.Value) tftypes.Value {
+		attributes := map[string]tftypes.Value{
+			"test_name":   tftypes.NewValue(tftypes.String, "test-value"),
+			"test_id":     tftypes.NewValue(tftypes.String, "test-value"),
+			"test_secret": tftypes.NewValue(tftypes.String, "test-value"),
+			"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedType}, []tftypes.Value{
+				tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"test_nested_name": tftypes.NewValue(tftypes.String, "test-nested-value"),
+					"test_nested_id":   tftypes.NewValue(tftypes.String, "test-nested-value"),
+				}),
+			}),
+		}
+
+		for name, value := range values {
+			attributes[name] = value
+		}
+
+		return tftypes.NewValue(testSchemaType, attributes)
+	}
+
+	testCases := map[string]struct {
+		plannedState tftypes.Value
+		expected     diag.Diagnostics
+	}{
+		"matching": {
+			plannedState: testValue(nil),
+		},
+		"planned-null": {
+			plannedState: tftypes.NewValue(testSchemaType, nil),
+		},
+		"null": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_id": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"unknown": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"referenced-unknown": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"mismatch": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_id": tftypes.NewValue(tftypes.String, "test-other-value"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_id"),
+					"Invalid Attribute Reference Value",
+					"The planned value of this attribute must match the planned value of test_name, as declared by the References field in the schema.\n\n"+
+						"Planned Value: tftypes.String<\"test-other-value\">\n"+
+						"Referenced Value: tftypes.String<\"test-value\">",
+				),
+			},
+		},
+		"mismatch-sensitive": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_secret": tftypes.NewValue(tftypes.String, "test-other-value"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_secret"),
+					"Invalid Attribute Reference Value",
+					"The planned value of this attribute must match the planned value of test_name, as declared by the References field in the schema.\n\n"+
+						"Values are omitted since the value is sensitive.",
+				),
+			},
+		},
+		"mismatch-nested": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedType}, []tftypes.Value{
+					tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"test_nested_name": tftypes.NewValue(tftypes.String, "test-nested-value"),
+						"test_nested_id":   tftypes.NewValue(tftypes.String, "test-nested-value"),
+					}),
+					tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"test_nested_name": tftypes.NewValue(tftypes.String, "test-nested-value"),
+						"test_nested_id":   tftypes.NewValue(tftypes.String, "test-other-value"),
+					}),
+				}),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list_nested").AtListIndex(1).AtName("test_nested_id"),
+					"Invalid Attribute Reference Value",
+					"The planned value of this attribute must match the planned value of test_list_nested[1].test_nested_name, as declared by the References field in the schema.\n\n"+
+						"Planned Value: tftypes.String<\"test-other-value\">\n"+
+						"Referenced Value: tftypes.String<\"test-nested-value\">",
+				),
+			},
+		},
+		"mismatch-multiple": {
+			plannedState: testValue(map[string]tftypes.Value{
+				"test_id":     tftypes.NewValue(tftypes.String, "test-other-value"),
+				"test_secret": tftypes.NewValue(tftypes.String, "test-other-value"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_id"),
+					"Invalid Attribute Reference Value",
+					"The planned value of this attribute must match the planned value of test_name, as declared by the References field in the schema.\n\n"+
+						"Planned Value: tftypes.String<\"test-other-value\">\n"+
+						"Referenced Value: tftypes.String<\"test-value\">",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_secret"),
+					"Invalid Attribute Reference Value",
+					"The planned value of this attribute must match the planned value of test_name, as declared by the References field in the schema.\n\n"+
+						"Values are omitted since the value is sensitive.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plannedState := tfsdk.State{
+				Raw:    testCase.plannedState,
+				Schema: testSchema,
+			}
+
+			got := fwserver.PlannedStateReferences(context.Background(), testSchema, plannedState)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
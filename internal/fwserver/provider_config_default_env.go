@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ProviderConfigDefaultEnv returns the provider configuration with null
+// values of attributes implementing fwschema.AttributeWithDefaultEnv set
+// from the first of their environment variables which is set and not empty.
+// Attributes nested under null values are not set.
+func ProviderConfigDefaultEnv(ctx context.Context, config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.Schema == nil || config.Raw.Type() == nil || config.Raw.IsNull() {
+		return config, diags
+	}
+
+	raw, err := tftypes.Transform(config.Raw, func(tfPath *tftypes.AttributePath, tfValue tftypes.Value) (tftypes.Value, error) {
+		if !tfValue.IsNull() {
+			return tfValue, nil
+		}
+
+		attribute, err := config.Schema.AttributeAtTerraformPath(ctx, tfPath)
+
+		// Paths which are not attributes, such as elements, have no
+		// environment variables.
+		if err != nil {
+			return tfValue, nil
+		}
+
+		attributeWithDefaultEnv, ok := attribute.(fwschema.AttributeWithDefaultEnv)
+
+		if !ok {
+			return tfValue, nil
+		}
+
+		name, value := defaultEnvValue(attributeWithDefaultEnv.GetDefaultEnv())
+
+		if name == "" {
+			return tfValue, nil
+		}
+
+		attributePath, pathDiags := fromtftypes.AttributePath(ctx, tfPath, config.Schema)
+
+		diags.Append(pathDiags...)
+
+		if pathDiags.HasError() {
+			return tfValue, nil
+		}
+
+		logging.FrameworkDebug(
+			ctx,
+			"Setting provider configuration value from environment variable",
+			map[string]interface{}{
+				logging.KeyAttributePath: attributePath.String(),
+			},
+		)
+
+		envValue, err := defaultEnvTerraformValue(tfValue.Type(), value)
+
+		// Verify the value is valid for the attribute type, such as a whole
+		// number for an Int64Attribute. The type error is not returned as it
+		// may contain the value.
+		if err == nil {
+			if _, typeErr := attribute.GetType().ValueFromTerraform(ctx, envValue); typeErr != nil {
+				err = fmt.Errorf("expected a value compatible with the attribute type")
+			}
+		}
+
+		if err != nil {
+			// The value is intentionally omitted as it may be sensitive.
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid Provider Environment Variable Value",
+				fmt.Sprintf("The provider cannot be configured as the %s environment variable value is not valid for %s.\n\n", name, attributePath)+
+					fmt.Sprintf("Error: %s", err),
+			)
+
+			return tfValue, nil
+		}
+
+		return envValue, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Error Setting Provider Configuration From Environment Variables",
+			"An unexpected error occurred while setting provider configuration values from environment variables. "+
+				"This is always an issue with terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	if diags.HasError() {
+		return config, diags
+	}
+
+	config.Raw = raw
+
+	return config, diags
+}
+
+// defaultEnvValue returns the name and value of the first environment
+// variable which is set and not empty. An empty name is returned if there is
+// no such environment variable.
+func defaultEnvValue(names []string) (string, string) {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return name, value
+		}
+	}
+
+	return "", ""
+}
+
+// defaultEnvTerraformValue returns the environment variable value converted
+// to the Terraform type, which must be a primitive type.
+func defaultEnvTerraformValue(typ tftypes.Type, value string) (tftypes.Value, error) {
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, value), nil
+	case typ.Is(tftypes.Bool):
+		boolValue, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("expected a boolean value, such as true or false")
+		}
+
+		return tftypes.NewValue(typ, boolValue), nil
+	case typ.Is(tftypes.Number):
+		numberValue, _, err := big.ParseFloat(value, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("expected a number value")
+		}
+
+		return tftypes.NewValue(typ, numberValue), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported attribute type %s", typ)
+	}
+}
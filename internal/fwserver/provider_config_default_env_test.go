@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+//nolint:paralleltest // t.Setenv is not compatible with t.Parallel
+func TestProviderConfigDefaultEnv(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Optional:   true,
+				DefaultEnv: []string{"TEST_PROVIDER_ENDPOINT", "TEST_PROVIDER_ENDPOINT_LEGACY"},
+			},
+			"insecure": schema.BoolAttribute{
+				Optional:   true,
+				DefaultEnv: []string{"TEST_PROVIDER_INSECURE"},
+			},
+			"retries": schema.Int64Attribute{
+				Optional:   true,
+				DefaultEnv: []string{"TEST_PROVIDER_RETRIES"},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+			},
+			"auth": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"token": schema.StringAttribute{
+						Optional:   true,
+						Sensitive:  true,
+						DefaultEnv: []string{"TEST_PROVIDER_TOKEN"},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testAuthType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"token": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"endpoint": tftypes.String,
+			"insecure": tftypes.Bool,
+			"retries":  tftypes.Number,
+			"region":   tftypes.String,
+			"auth":     testAuthType,
+		},
+	}
+
+	testConfig := func(values map[string]tftypes.Value) tfsdk.Config {
+		configValues := map[string]tftypes.Value{
+			"endpoint": tftypes.NewValue(tftypes.String, nil),
+			"insecure": tftypes.NewValue(tftypes.Bool, nil),
+			"retries":  tftypes.NewValue(tftypes.Number, nil),
+			"region":   tftypes.NewValue(tftypes.String, nil),
+			"auth":     tftypes.NewValue(testAuthType, nil),
+		}
+
+		for name, value := range values {
+			configValues[name] = value
+		}
+
+		return tfsdk.Config{
+			Raw:    tftypes.NewValue(testType, configValues),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		env           map[string]string
+		config        tfsdk.Config
+		expected      tfsdk.Config
+		expectedDiags diag.Diagnostics
+	}{
+		"null-config": {
+			env: map[string]string{
+				"TEST_PROVIDER_ENDPOINT": "https://env.example.com",
+			},
+			config: tfsdk.Config{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expected: tfsdk.Config{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+		},
+		"unset": {
+			config:   testConfig(nil),
+			expected: testConfig(nil),
+		},
+		"env": {
+			env: map[string]string{
+				"TEST_PROVIDER_ENDPOINT": "https://env.example.com",
+				"TEST_PROVIDER_INSECURE": "true",
+				"TEST_PROVIDER_RETRIES":  "3",
+			},
+			config: testConfig(nil),
+			expected: testConfig(map[string]tftypes.Value{
+				"endpoint": tftypes.NewValue(tftypes.String, "https://env.example.com"),
+				"insecure": tftypes.NewValue(tftypes.Bool, true),
+				"retries":  tftypes.NewValue(tftypes.Number, 3),
+			}),
+		},
+		"env-precedence": {
+			env: map[string]string{
+				"TEST_PROVIDER_ENDPOINT":        "",
+				"TEST_PROVIDER_ENDPOINT_LEGACY": "https://legacy.example.com",
+			},
+			config: testConfig(nil),
+			expected: testConfig(map[string]tftypes.Value{
+				"endpoint": tftypes.NewValue(tftypes.String, "https://legacy.example.com"),
+			}),
+		},
+		"config-precedence": {
+			env: map[string]string{
+				"TEST_PROVIDER_ENDPOINT": "https://env.example.com",
+			},
+			config: testConfig(map[string]tftypes.Value{
+				"endpoint": tftypes.NewValue(tftypes.String, "https://config.example.com"),
+			}),
+			expected: testConfig(map[string]tftypes.Value{
+				"endpoint": tftypes.NewValue(tftypes.String, "https://config.example.com"),
+			}),
+		},
+		"config-unknown": {
+			env: map[string]string{
+				"TEST_PROVIDER_ENDPOINT": "https://env.example.com",
+			},
+			config: testConfig(map[string]tftypes.Value{
+				"endpoint": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: testConfig(map[string]tftypes.Value{
+				"endpoint": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"nested": {
+			env: map[string]string{
+				"TEST_PROVIDER_TOKEN": "test-token",
+			},
+			config: testConfig(map[string]tftypes.Value{
+				"auth": tftypes.NewValue(testAuthType, map[string]tftypes.Value{
+					"token": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+			expected: testConfig(map[string]tftypes.Value{
+				"auth": tftypes.NewValue(testAuthType, map[string]tftypes.Value{
+					"token": tftypes.NewValue(tftypes.String, "test-token"),
+				}),
+			}),
+		},
+		"nested-parent-null": {
+			env: map[string]string{
+				"TEST_PROVIDER_TOKEN": "test-token",
+			},
+			config:   testConfig(nil),
+			expected: testConfig(nil),
+		},
+		"invalid-bool": {
+			env: map[string]string{
+				"TEST_PROVIDER_INSECURE": "maybe",
+			},
+			config:   testConfig(nil),
+			expected: testConfig(nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("insecure"),
+					"Invalid Provider Environment Variable Value",
+					"The provider cannot be configured as the TEST_PROVIDER_INSECURE environment variable value is not valid for insecure.\n\n"+
+						"Error: expected a boolean value, such as true or false",
+				),
+			},
+		},
+		"invalid-int64": {
+			env: map[string]string{
+				"TEST_PROVIDER_RETRIES": "1.5",
+			},
+			config:   testConfig(nil),
+			expected: testConfig(nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("retries"),
+					"Invalid Provider Environment Variable Value",
+					"The provider cannot be configured as the TEST_PROVIDER_RETRIES environment variable value is not valid for retries.\n\n"+
+						"Error: expected a value compatible with the attribute type",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for key, value := range testCase.env {
+				t.Setenv(key, value)
+			}
+
+			got, diags := fwserver.ProviderConfigDefaultEnv(context.Background(), testCase.config)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/retry"
+)
+
+// callResourceMethod calls the provider-defined resource method, such as
+// Create, and calls it again as configured by the resource behavior while it
+// returns only transient error diagnostics. The method is only called once
+// unless the resource behavior enables retries with a TransientErrorRetry
+// MaxAttempts greater than 1. The call function must reset the method
+// response before each call.
+func callResourceMethod(ctx context.Context, behavior resource.ResourceBehavior, method string, call func(ctx context.Context) diag.Diagnostics) {
+	var attempt int
+
+	retry.Do(ctx, behavior.TransientErrorRetry, func(ctx context.Context) diag.Diagnostics {
+		attempt++
+
+		if attempt > 1 {
+			logging.FrameworkDebug(
+				ctx,
+				"Retrying provider defined Resource "+method+" after transient error diagnostics",
+				map[string]interface{}{
+					logging.KeyRetryAttempt: attempt,
+				},
+			)
+		}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined Resource "+method)
+		diags := call(ctx)
+		logging.FrameworkTrace(ctx, "Called provider defined Resource "+method)
+
+		return diags
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// NullEmptyEquivalence returns a tftypes.Transform function which replaces
+// new values of attributes declaring null and empty string equivalence with
+// the prior value, if the values only differ by being null or an empty string.
+func NullEmptyEquivalence(ctx context.Context, prior tftypes.Value, schema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		// only attributes can declare equivalence
+		if len(path.Steps()) < 1 {
+			return val, nil
+		}
+
+		if !val.Type().Is(tftypes.String) || !isNullOrEmptyString(val) {
+			return val, nil
+		}
+
+		ctx := logging.FrameworkWithAttributePath(ctx, path.String())
+
+		attribute, err := schema.AttributeAtTerraformPath(ctx, path)
+
+		if err != nil {
+			if errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) || errors.Is(err, fwschema.ErrPathInsideDynamicAttribute) {
+				// elements inside attributes have no schema of their own
+				return val, nil
+			}
+
+			return val, fmt.Errorf("couldn't find attribute in schema: %w", err)
+		}
+
+		a, ok := attribute.(fwschema.AttributeWithNullEmptyEquivalent)
+
+		if !ok || !a.IsNullEmptyEquivalent() {
+			return val, nil
+		}
+
+		priorValIface, _, err := tftypes.WalkAttributePath(prior, path)
+
+		if err != nil {
+			// The prior value may not contain the path, such as a new
+			// list element.
+			if errors.Is(err, tftypes.ErrInvalidStep) {
+				return val, nil
+			}
+
+			return val, fmt.Errorf("error walking attribute path during null and empty string equivalence: %w", err)
+		}
+
+		priorVal, ok := priorValIface.(tftypes.Value)
+
+		if !ok {
+			return val, fmt.Errorf("unexpected type during null and empty string equivalence: %T", priorValIface)
+		}
+
+		if !priorVal.Type().Is(tftypes.String) || !isNullOrEmptyString(priorVal) || priorVal.IsNull() == val.IsNull() {
+			return val, nil
+		}
+
+		logging.FrameworkDebug(ctx, "keeping prior value of attribute with null and empty string equivalence")
+
+		return priorVal, nil
+	}
+}
+
+// isNullOrEmptyString returns true if the string value is null or a known
+// empty string.
+func isNullOrEmptyString(val tftypes.Value) bool {
+	if val.IsNull() {
+		return true
+	}
+
+	if !val.IsKnown() {
+		return false
+	}
+
+	var s string
+
+	if err := val.As(&s); err != nil {
+		return false
+	}
+
+	return s == ""
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestNullEmptyEquivalence(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_equivalent": schema.StringAttribute{
+				Optional:            true,
+				NullEmptyEquivalent: true,
+			},
+			"test_other": schema.StringAttribute{
+				Optional: true,
+			},
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_nested_equivalent": schema.StringAttribute{
+							Optional:            true,
+							NullEmptyEquivalent: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	nestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested_equivalent": tftypes.String,
+		},
+	}
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_equivalent": tftypes.String,
+			"test_other":      tftypes.String,
+			"test_list_nested": tftypes.List{
+				ElementType: nestedObjectType,
+			},
+		},
+	}
+
+	objectValue := func(equivalent, other any, nested ...any) tftypes.Value {
+		nestedValues := make([]tftypes.Value, 0, len(nested))
+
+		for _, value := range nested {
+			nestedValues = append(nestedValues, tftypes.NewValue(nestedObjectType, map[string]tftypes.Value{
+				"test_nested_equivalent": tftypes.NewValue(tftypes.String, value),
+			}))
+		}
+
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"test_equivalent":  tftypes.NewValue(tftypes.String, equivalent),
+			"test_other":       tftypes.NewValue(tftypes.String, other),
+			"test_list_nested": tftypes.NewValue(objectType.AttributeTypes["test_list_nested"], nestedValues),
+		})
+	}
+
+	testCases := map[string]struct {
+		prior    tftypes.Value
+		new      tftypes.Value
+		expected tftypes.Value
+	}{
+		"prior-null-new-empty": {
+			prior:    objectValue(nil, nil),
+			new:      objectValue("", ""),
+			expected: objectValue(nil, ""),
+		},
+		"prior-empty-new-null": {
+			prior:    objectValue("", ""),
+			new:      objectValue(nil, nil),
+			expected: objectValue("", nil),
+		},
+		"prior-empty-new-empty": {
+			prior:    objectValue("", nil),
+			new:      objectValue("", nil),
+			expected: objectValue("", nil),
+		},
+		"prior-value-new-null": {
+			prior:    objectValue("prior", nil),
+			new:      objectValue(nil, nil),
+			expected: objectValue(nil, nil),
+		},
+		"prior-null-new-value": {
+			prior:    objectValue(nil, nil),
+			new:      objectValue("new", nil),
+			expected: objectValue("new", nil),
+		},
+		"prior-unknown-new-empty": {
+			prior:    objectValue(tftypes.UnknownValue, nil),
+			new:      objectValue("", nil),
+			expected: objectValue("", nil),
+		},
+		"prior-null-object": {
+			prior:    tftypes.NewValue(objectType, nil),
+			new:      objectValue("", nil),
+			expected: objectValue("", nil),
+		},
+		"nested": {
+			prior:    objectValue(nil, nil, "", nil),
+			new:      objectValue(nil, nil, nil, ""),
+			expected: objectValue(nil, nil, "", nil),
+		},
+		"nested-new-element": {
+			prior:    objectValue(nil, nil, ""),
+			new:      objectValue(nil, nil, nil, nil),
+			expected: objectValue(nil, nil, "", nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tftypes.Transform(testCase.new, fwserver.NullEmptyEquivalence(context.Background(), testCase.prior, testSchema))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
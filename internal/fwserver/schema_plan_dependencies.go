@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// schemaPlanModificationOrder returns the names of the top level attributes
+// and blocks in the order their plan modifiers are run, and the names of
+// those with declared dependencies. Without dependencies, attributes are
+// ordered by name before blocks ordered by name. Dependencies move the
+// dependent attributes and blocks after their dependencies while otherwise
+// keeping this order.
+func schemaPlanModificationOrder(s fwschema.Schema, dependencies []resource.PlanDependency) ([]string, map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := append(sortedKeys(s.GetAttributes()), sortedKeys(s.GetBlocks())...)
+
+	if len(dependencies) == 0 {
+		return names, nil, diags
+	}
+
+	// dependsOn is a mapping of each dependent name to the names it
+	// depends on.
+	dependsOn := make(map[string]map[string]bool)
+
+	for _, dependency := range dependencies {
+		name, nameDiags := schemaPlanDependencyName(s, dependency.Path)
+
+		diags.Append(nameDiags...)
+
+		for _, dependencyPath := range dependency.DependsOn {
+			dependencyName, dependencyNameDiags := schemaPlanDependencyName(s, dependencyPath)
+
+			diags.Append(dependencyNameDiags...)
+
+			if nameDiags.HasError() || dependencyNameDiags.HasError() || name == dependencyName {
+				continue
+			}
+
+			if dependsOn[name] == nil {
+				dependsOn[name] = make(map[string]bool)
+			}
+
+			dependsOn[name][dependencyName] = true
+		}
+	}
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	ordered := make([]string, 0, len(names))
+	visited := make(map[string]bool, len(names))
+
+	for len(ordered) < len(names) {
+		next := ""
+
+		for _, name := range names {
+			if visited[name] {
+				continue
+			}
+
+			ready := true
+
+			for dependencyName := range dependsOn[name] {
+				if !visited[dependencyName] {
+					ready = false
+
+					break
+				}
+			}
+
+			if ready {
+				next = name
+
+				break
+			}
+		}
+
+		if next == "" {
+			var cycle []string
+
+			for _, name := range names {
+				if !visited[name] && len(dependsOn[name]) > 0 {
+					cycle = append(cycle, name)
+				}
+			}
+
+			diags.AddError(
+				"Invalid Resource Plan Dependencies",
+				"The resource plan dependencies contain a cycle, so the order of attribute plan modification cannot be determined. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"Attributes and blocks in or depending on the cycle: "+strings.Join(cycle, ", "),
+			)
+
+			return nil, nil, diags
+		}
+
+		ordered = append(ordered, next)
+		visited[next] = true
+	}
+
+	dependents := make(map[string]bool, len(dependsOn))
+
+	for name := range dependsOn {
+		dependents[name] = true
+	}
+
+	return ordered, dependents, diags
+}
+
+// schemaPlanDependencyName returns the top level attribute or block name of
+// the plan dependency path, or an error diagnostic if the path is not a top
+// level attribute or block of the schema.
+func schemaPlanDependencyName(s fwschema.Schema, p path.Path) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	steps := p.Steps()
+
+	if len(steps) == 1 {
+		if name, ok := steps[0].(path.PathStepAttributeName); ok {
+			_, isAttribute := s.GetAttributes()[string(name)]
+			_, isBlock := s.GetBlocks()[string(name)]
+
+			if isAttribute || isBlock {
+				return string(name), diags
+			}
+		}
+	}
+
+	diags.AddError(
+		"Invalid Resource Plan Dependency",
+		"The resource plan dependencies contain a path which is not a top level attribute or block of the resource schema. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Path: %s", p),
+	)
+
+	return "", diags
+}
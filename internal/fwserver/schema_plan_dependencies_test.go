@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaPlanModificationOrder(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"a": testschema.Attribute{Type: types.StringType, Optional: true},
+			"b": testschema.Attribute{Type: types.StringType, Optional: true},
+			"c": testschema.Attribute{Type: types.StringType, Optional: true},
+		},
+		Blocks: map[string]fwschema.Block{
+			"d": testschema.Block{
+				NestingMode: fwschema.BlockNestingModeSingle,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		dependencies        []resource.PlanDependency
+		expectedOrder       []string
+		expectedDependents  map[string]bool
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"none": {
+			expectedOrder: []string{"a", "b", "c", "d"},
+		},
+		"attribute": {
+			dependencies: []resource.PlanDependency{
+				{
+					Path:      path.Root("a"),
+					DependsOn: path.Paths{path.Root("c")},
+				},
+			},
+			expectedOrder:      []string{"b", "c", "a", "d"},
+			expectedDependents: map[string]bool{"a": true},
+		},
+		"block": {
+			dependencies: []resource.PlanDependency{
+				{
+					Path:      path.Root("b"),
+					DependsOn: path.Paths{path.Root("d")},
+				},
+			},
+			expectedOrder:      []string{"a", "c", "d", "b"},
+			expectedDependents: map[string]bool{"b": true},
+		},
+		"transitive": {
+			dependencies: []resource.PlanDependency{
+				{
+					Path:      path.Root("a"),
+					DependsOn: path.Paths{path.Root("b")},
+				},
+				{
+					Path:      path.Root("b"),
+					DependsOn: path.Paths{path.Root("c")},
+				},
+			},
+			expectedOrder:      []string{"c", "b", "a", "d"},
+			expectedDependents: map[string]bool{"a": true, "b": true},
+		},
+		"cycle": {
+			dependencies: []resource.PlanDependency{
+				{
+					Path:      path.Root("a"),
+					DependsOn: path.Paths{path.Root("b")},
+				},
+				{
+					Path:      path.Root("b"),
+					DependsOn: path.Paths{path.Root("a")},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Resource Plan Dependencies",
+					"The resource plan dependencies contain a cycle, so the order of attribute plan modification cannot be determined. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Attributes and blocks in or depending on the cycle: a, b",
+				),
+			},
+		},
+		"invalid-path": {
+			dependencies: []resource.PlanDependency{
+				{
+					Path:      path.Root("a"),
+					DependsOn: path.Paths{path.Root("d").AtName("nested")},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Resource Plan Dependency",
+					"The resource plan dependencies contain a path which is not a top level attribute or block of the resource schema. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Path: d.nested",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotOrder, gotDependents, gotDiagnostics := schemaPlanModificationOrder(testSchema, testCase.dependencies)
+
+			if diff := cmp.Diff(gotOrder, testCase.expectedOrder); diff != "" {
+				t.Errorf("unexpected order difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDependents, testCase.expectedDependents); diff != "" {
+				t.Errorf("unexpected dependents difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaModifyPlan_PlanDependencies(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"ip_address": tftypes.String,
+			"subnet_id":  tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"ip_address": testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							var subnetID types.String
+
+							resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("subnet_id"), &subnetID)...)
+
+							if subnetID.IsUnknown() {
+								return
+							}
+
+							resp.PlanValue = types.StringValue("ip-in-" + subnetID.ValueString())
+						},
+					},
+				},
+			},
+			"subnet_id": testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(_ context.Context, _ planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = types.StringValue("test-subnet")
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testValue := func(ipAddress, subnetID interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"ip_address": tftypes.NewValue(tftypes.String, ipAddress),
+			"subnet_id":  tftypes.NewValue(tftypes.String, subnetID),
+		})
+	}
+
+	testCases := map[string]struct {
+		dependencies  []resource.PlanDependency
+		expectedValue tftypes.Value
+	}{
+		"none": {
+			expectedValue: testValue(tftypes.UnknownValue, "test-subnet"),
+		},
+		"dependency": {
+			dependencies: []resource.PlanDependency{
+				{
+					Path:      path.Root("ip_address"),
+					DependsOn: path.Paths{path.Root("subnet_id")},
+				},
+			},
+			expectedValue: testValue("ip-in-test-subnet", "test-subnet"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := ModifySchemaPlanRequest{
+				Config: tfsdk.Config{
+					Raw:    testValue(nil, nil),
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testValue(tftypes.UnknownValue, tftypes.UnknownValue),
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+				PlanDependencies: testCase.dependencies,
+			}
+			resp := ModifySchemaPlanResponse{
+				Plan: req.Plan,
+			}
+
+			SchemaModifyPlan(context.Background(), testSchema, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+			}
+
+			if diff := cmp.Diff(resp.Plan.Raw, testCase.expectedValue); diff != "" {
+				t.Errorf("unexpected plan difference: %s", diff)
+			}
+		})
+	}
+}
@@ -5,12 +5,15 @@ package fwserver
 
 import (
 	"context"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -31,6 +34,15 @@ type ModifySchemaPlanRequest struct {
 
 	// Private is provider private state data.
 	Private *privatestate.ProviderData
+
+	// PlanDependencies is the order of top level attribute and block plan
+	// modification declared by the resource.
+	PlanDependencies []resource.PlanDependency
+
+	// planDump is the PlanResourceChange debug dump, if enabled, which
+	// records the plan after the plan modification of each top level
+	// attribute and block.
+	planDump *planDump
 }
 
 // ModifySchemaPlanResponse represents a response to a ModifySchemaPlanRequest.
@@ -54,7 +66,7 @@ type ModifySchemaPlanResponse struct {
 }
 
 // SchemaModifyPlan runs all AttributePlanModifiers in all schema attributes
-// and blocks.
+// and blocks, in the order determined by any resource plan dependencies.
 //
 // TODO: Clean up this abstraction back into an internal Schema type method.
 // The extra Schema parameter is a carry-over of creating the proto6server
@@ -81,8 +93,16 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 		TerraformValue: req.State.Raw,
 	}
 
-	for name, attribute := range s.GetAttributes() {
-		attrReq := ModifyAttributePlanRequest{
+	order, dependents, diags := schemaPlanModificationOrder(s, req.PlanDependencies)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	for _, name := range order {
+		modifyReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
@@ -91,7 +111,13 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 			Private:       req.Private,
 		}
 
-		attrReq.AttributeConfig, diags = configData.ValueAtPath(ctx, attrReq.AttributePath)
+		// Attributes and blocks with plan dependencies receive the plan with
+		// the modifications of their dependencies.
+		if dependents[name] {
+			modifyReq.Plan = resp.Plan
+		}
+
+		modifyReq.AttributeConfig, diags = configData.ValueAtPath(ctx, modifyReq.AttributePath)
 
 		resp.Diagnostics.Append(diags...)
 
@@ -99,7 +125,7 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 			return
 		}
 
-		attrReq.AttributePlan, diags = planData.ValueAtPath(ctx, attrReq.AttributePath)
+		modifyReq.AttributePlan, diags = planData.ValueAtPath(ctx, modifyReq.AttributePath)
 
 		resp.Diagnostics.Append(diags...)
 
@@ -107,7 +133,7 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 			return
 		}
 
-		attrReq.AttributeState, diags = stateData.ValueAtPath(ctx, attrReq.AttributePath)
+		modifyReq.AttributeState, diags = stateData.ValueAtPath(ctx, modifyReq.AttributePath)
 
 		resp.Diagnostics.Append(diags...)
 
@@ -115,83 +141,68 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 			return
 		}
 
-		attrResp := ModifyAttributePlanResponse{
-			AttributePlan: attrReq.AttributePlan,
-			Private:       attrReq.Private,
+		modifyResp := ModifyAttributePlanResponse{
+			AttributePlan: modifyReq.AttributePlan,
+			Private:       modifyReq.Private,
 		}
 
-		AttributeModifyPlan(ctx, attribute, attrReq, &attrResp)
-
-		resp.Diagnostics.Append(attrResp.Diagnostics...)
-
-		if resp.Diagnostics.HasError() {
-			return
+		if attribute, ok := s.GetAttributes()[name]; ok {
+			AttributeModifyPlan(ctx, attribute, modifyReq, &modifyResp)
+		} else {
+			BlockModifyPlan(ctx, s.GetBlocks()[name], modifyReq, &modifyResp)
 		}
 
-		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrReq.AttributePath, attrResp.AttributePlan)...)
+		resp.Diagnostics.Append(modifyResp.Diagnostics...)
 
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		resp.RequiresReplace = append(resp.RequiresReplace, attrResp.RequiresReplace...)
-		resp.Private = attrResp.Private
-	}
-
-	for name, block := range s.GetBlocks() {
-		blockReq := ModifyAttributePlanRequest{
-			AttributePath: path.Root(name),
-			Config:        req.Config,
-			State:         req.State,
-			Plan:          req.Plan,
-			ProviderMeta:  req.ProviderMeta,
-			Private:       req.Private,
-		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, modifyReq.AttributePath, modifyResp.AttributePlan)...)
 
-		blockReq.AttributeConfig, diags = configData.ValueAtPath(ctx, blockReq.AttributePath)
+		req.planDump.phase(ctx, planDumpPhaseAttributePlanModification, modifyReq.AttributePath.String(), resp.Plan.Raw)
 
-		resp.Diagnostics.Append(diags...)
-
-		if diags.HasError() {
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		blockReq.AttributePlan, diags = planData.ValueAtPath(ctx, blockReq.AttributePath)
-
-		resp.Diagnostics.Append(diags...)
-
-		if diags.HasError() {
-			return
-		}
+		resp.RequiresReplace = append(resp.RequiresReplace, modifyResp.RequiresReplace...)
+		resp.Private = modifyResp.Private
+	}
+}
 
-		blockReq.AttributeState, diags = stateData.ValueAtPath(ctx, blockReq.AttributePath)
+// orderedPlanModifiers returns the plan modifiers in execution order, which
+// is ascending by any planmodifier.Prioritizer priority, otherwise in the
+// given order.
+func orderedPlanModifiers[T any](planModifiers []T) []T {
+	prioritized := false
 
-		resp.Diagnostics.Append(diags...)
+	for _, planModifier := range planModifiers {
+		if _, ok := any(planModifier).(planmodifier.Prioritizer); ok {
+			prioritized = true
 
-		if diags.HasError() {
-			return
-		}
-
-		blockResp := ModifyAttributePlanResponse{
-			AttributePlan: blockReq.AttributePlan,
-			Private:       blockReq.Private,
+			break
 		}
+	}
 
-		BlockModifyPlan(ctx, block, blockReq, &blockResp)
+	if !prioritized {
+		return planModifiers
+	}
 
-		resp.Diagnostics.Append(blockResp.Diagnostics...)
+	result := make([]T, len(planModifiers))
+	copy(result, planModifiers)
 
-		if resp.Diagnostics.HasError() {
-			return
+	priority := func(planModifier T) int {
+		if p, ok := any(planModifier).(planmodifier.Prioritizer); ok {
+			return p.Priority()
 		}
 
-		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, blockReq.AttributePath, blockResp.AttributePlan)...)
+		return 0
+	}
 
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	sort.SliceStable(result, func(i, j int) bool {
+		return priority(result[i]) < priority(result[j])
+	})
 
-		resp.RequiresReplace = append(resp.RequiresReplace, blockResp.RequiresReplace...)
-		resp.Private = blockResp.Private
-	}
+	return result
 }
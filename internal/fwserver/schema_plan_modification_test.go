@@ -2444,3 +2444,74 @@ func TestSchemaModifyPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderedPlanModifiers(t *testing.T) {
+	t.Parallel()
+
+	testPlanModifier := func(description string) planmodifier.String {
+		return testplanmodifier.String{
+			DescriptionMethod: func(_ context.Context) string {
+				return description
+			},
+		}
+	}
+
+	testPrioritizedPlanModifier := func(description string, priority int) planmodifier.String {
+		return testplanmodifier.StringWithPriority{
+			String: testplanmodifier.String{
+				DescriptionMethod: func(_ context.Context) string {
+					return description
+				},
+			},
+			PriorityMethod: func() int {
+				return priority
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		planModifiers []planmodifier.String
+		expected      []string
+	}{
+		"nil": {
+			planModifiers: nil,
+			expected:      []string{},
+		},
+		"unprioritized": {
+			planModifiers: []planmodifier.String{
+				testPlanModifier("one"),
+				testPlanModifier("two"),
+				testPlanModifier("three"),
+			},
+			expected: []string{"one", "two", "three"},
+		},
+		"prioritized": {
+			planModifiers: []planmodifier.String{
+				testPrioritizedPlanModifier("one", 10),
+				testPlanModifier("two"),
+				testPrioritizedPlanModifier("three", -10),
+				testPrioritizedPlanModifier("four", 0),
+				testPrioritizedPlanModifier("five", 10),
+			},
+			expected: []string{"three", "two", "four", "one", "five"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := []string{}
+
+			for _, planModifier := range orderedPlanModifiers(testCase.planModifiers) {
+				got = append(got, planModifier.Description(context.Background()))
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -49,10 +51,15 @@ type SchemaSemanticEqualityResponse struct {
 // the value based recursion for collection and structural types, so additional
 // design may be necessary so that provider developer data handling intentions
 // are kept based on both the value based logic and schema based logic.
+//
+// The only schema based handling is the null and empty string equivalence of
+// attributes implementing fwschema.AttributeWithNullEmptyEquivalent, which
+// runs after the value based logic since semantic equality never applies to
+// null values.
 func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityRequest, resp *SchemaSemanticEqualityResponse) {
 	var diags diag.Diagnostics
 
-	for name := range req.ProposedNewData.Schema.GetAttributes() {
+	for _, name := range sortedKeys(req.ProposedNewData.Schema.GetAttributes()) {
 		valueReq := fwschemadata.ValueSemanticEqualityRequest{
 			Path: path.Root(name),
 		}
@@ -98,7 +105,7 @@ func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityReque
 		}
 	}
 
-	for name := range req.ProposedNewData.Schema.GetBlocks() {
+	for _, name := range sortedKeys(req.ProposedNewData.Schema.GetBlocks()) {
 		valueReq := fwschemadata.ValueSemanticEqualityRequest{
 			Path: path.Root(name),
 		}
@@ -143,4 +150,17 @@ func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityReque
 			return
 		}
 	}
+
+	newValue, err := tftypes.Transform(resp.NewData.TerraformValue, NullEmptyEquivalence(ctx, req.PriorData.TerraformValue, req.ProposedNewData.Schema))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Running Null and Empty String Equivalence",
+			"There was an unexpected error updating the data. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewData.TerraformValue = newValue
 }
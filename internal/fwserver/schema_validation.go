@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -20,6 +21,9 @@ type ValidateSchemaRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// Functions calls the pure provider-defined functions of the provider.
+	Functions function.Caller
 }
 
 // ValidateSchemaResponse represents a response to a
@@ -37,12 +41,14 @@ type ValidateSchemaResponse struct {
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
-	for name, attribute := range s.GetAttributes() {
+	for _, name := range sortedKeys(s.GetAttributes()) {
+		attribute := s.GetAttributes()[name]
 
 		attributeReq := ValidateAttributeRequest{
 			AttributePath:           path.Root(name),
 			AttributePathExpression: path.MatchRoot(name),
 			Config:                  req.Config,
+			Functions:               req.Functions,
 		}
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
@@ -53,11 +59,14 @@ func SchemaValidate(ctx context.Context, s fwschema.Schema, req ValidateSchemaRe
 		resp.Diagnostics.Append(attributeResp.Diagnostics...)
 	}
 
-	for name, block := range s.GetBlocks() {
+	for _, name := range sortedKeys(s.GetBlocks()) {
+		block := s.GetBlocks()[name]
+
 		attributeReq := ValidateAttributeRequest{
 			AttributePath:           path.Root(name),
 			AttributePathExpression: path.MatchRoot(name),
 			Config:                  req.Config,
+			Functions:               req.Functions,
 		}
 		// Instantiate a new response for each request to prevent validators
 		// from modifying or removing diagnostics.
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +22,12 @@ import (
 func TestSchemaValidate(t *testing.T) {
 	t.Parallel()
 
+	testPathErrorValidator := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Test Error", "test detail")
+		},
+	}
+
 	testCases := map[string]struct {
 		req  ValidateSchemaRequest
 		resp ValidateSchemaResponse
@@ -169,6 +176,53 @@ func TestSchemaValidate(t *testing.T) {
 				},
 			},
 		},
+		"errors-sorted-order": {
+			req: ValidateSchemaRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"attr1": tftypes.String,
+							"attr2": tftypes.String,
+							"attr3": tftypes.String,
+							"attr4": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"attr1": tftypes.NewValue(tftypes.String, "attr1value"),
+						"attr2": tftypes.NewValue(tftypes.String, "attr2value"),
+						"attr3": tftypes.NewValue(tftypes.String, "attr3value"),
+						"attr4": tftypes.NewValue(tftypes.String, "attr4value"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"attr1": testschema.AttributeWithStringValidators{
+								Required:   true,
+								Validators: []validator.String{testPathErrorValidator},
+							},
+							"attr2": testschema.AttributeWithStringValidators{
+								Required:   true,
+								Validators: []validator.String{testPathErrorValidator},
+							},
+							"attr3": testschema.AttributeWithStringValidators{
+								Required:   true,
+								Validators: []validator.String{testPathErrorValidator},
+							},
+							"attr4": testschema.AttributeWithStringValidators{
+								Required:   true,
+								Validators: []validator.String{testPathErrorValidator},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("attr1"), "Test Error", "test detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("attr2"), "Test Error", "test detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("attr3"), "Test Error", "test detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("attr4"), "Test Error", "test detail"),
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
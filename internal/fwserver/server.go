@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 // Server implements the framework provider server. Protocol specific
@@ -53,6 +55,10 @@ type Server struct {
 	// Provider.DataSources() method.
 	dataSourceFuncs map[string]func() datasource.DataSource
 
+	// dataSourceDeprecations is the cached mapping of data source type
+	// names to the datasource.MetadataResponse type Deprecation field, if set.
+	dataSourceDeprecations map[string]datasource.Deprecation
+
 	// dataSourceTypesDiags is the cached Diagnostics obtained while populating
 	// dataSourceTypes. This is to ensure any warnings or errors are also
 	// returned appropriately when fetching dataSourceTypes.
@@ -85,11 +91,48 @@ type Server struct {
 	// access from race conditions.
 	ephemeralResourceFuncsMutex sync.Mutex
 
+	// diagnosticLimits is the cached provider-defined diag.Limits, if the
+	// provider implements the ProviderWithDiagnosticLimits interface.
+	diagnosticLimits diag.Limits
+
+	// diagnosticLimitsFetched is true once diagnosticLimits has been
+	// populated, since the zero value is valid.
+	diagnosticLimitsFetched bool
+
+	// diagnosticLimitsMutex is a mutex to protect concurrent
+	// diagnosticLimits access from race conditions.
+	diagnosticLimitsMutex sync.Mutex
+
+	// diagnosticMessageCatalog is the cached provider-defined
+	// diag.MessageCatalog, if the provider implements the
+	// ProviderWithDiagnosticMessageCatalog interface.
+	diagnosticMessageCatalog diag.MessageCatalog
+
+	// diagnosticMessageCatalogFetched is true once diagnosticMessageCatalog
+	// has been populated, since a nil catalog is valid.
+	diagnosticMessageCatalogFetched bool
+
+	// diagnosticMessageCatalogMutex is a mutex to protect concurrent
+	// diagnosticMessageCatalog access from race conditions.
+	diagnosticMessageCatalogMutex sync.Mutex
+
 	// deferred indicates an automatic provider deferral. When this is set,
 	// the provider will automatically defer the PlanResourceChange, ReadResource,
 	// ImportResourceState, and ReadDataSource RPCs.
 	deferred *provider.Deferred
 
+	// configureClientCapabilities is the provider.ConfigureRequest type
+	// ClientCapabilities field value, which is passed to configuration
+	// validation requests since Terraform does not send client capabilities
+	// with those RPCs.
+	configureClientCapabilities provider.ConfigureProviderClientCapabilities
+
+	// terraformVersion is the provider.ConfigureRequest type
+	// TerraformVersion field value, which is passed to configuration
+	// validation requests since Terraform does not send its version with
+	// those RPCs.
+	terraformVersion string
+
 	// functionDefinitions is the cached Function Definitions for RPCs that need to
 	// convert data from the protocol. If not found, it will be fetched from the
 	// Function.Definition() method.
@@ -113,6 +156,36 @@ type Server struct {
 	// access from race conditions.
 	functionFuncsMutex sync.Mutex
 
+	// functionRunHooks is the cached provider-defined function.RunHook, if
+	// the provider implements the ProviderWithFunctionRunHooks interface.
+	functionRunHooks []function.RunHook
+
+	// functionRunHooksFetched is true once functionRunHooks has been
+	// populated, since nil hooks are valid.
+	functionRunHooksFetched bool
+
+	// functionRunHooksMutex is a mutex to protect concurrent
+	// functionRunHooks access from race conditions.
+	functionRunHooksMutex sync.Mutex
+
+	// functionResults is the cached Function results for functions with a
+	// Pure definition, keyed by function name and argument values. It holds
+	// at most functionResultsMaxEntries results.
+	functionResults map[string]function.ResultData
+
+	// functionResultsMutex is a mutex to protect concurrent functionResults
+	// access from race conditions.
+	functionResultsMutex sync.RWMutex
+
+	// planStore is shared across all resource ModifyPlan method calls. It is
+	// replaced when the provider is configured, so values do not outlive the
+	// Terraform operation which stored them.
+	planStore *resource.PlanStore
+
+	// planStoreMutex is a mutex to protect concurrent planStore access
+	// from race conditions.
+	planStoreMutex sync.Mutex
+
 	// providerSchema is the cached Provider Schema for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the Provider.GetSchema() method.
@@ -163,6 +236,16 @@ type Server struct {
 	// Provider.Resources() method.
 	resourceFuncs map[string]func() resource.Resource
 
+	// resourceDeprecatedTypeNames is the cached mapping of deprecated
+	// resource type names, from the resource.MetadataResponse type
+	// DeprecatedTypeNames field, to the current resource type name.
+	resourceDeprecatedTypeNames map[string]string
+
+	// resourceDeprecations is the cached mapping of resource type names,
+	// including deprecated type names, to the resource.MetadataResponse type
+	// Deprecation field, if set.
+	resourceDeprecations map[string]resource.Deprecation
+
 	// resourceTypesDiags is the cached Diagnostics obtained while populating
 	// resourceTypes. This is to ensure any warnings or errors are also
 	// returned appropriately when fetching resourceTypes.
@@ -184,6 +267,18 @@ type Server struct {
 	// resourceBehaviorsMutex is a mutex to protect concurrent resourceBehaviors
 	// access from race conditions.
 	resourceBehaviorsMutex sync.Mutex
+
+	// disabledDataSources is the [provider.ConfigureResponse.DisabledDataSources]
+	// field value, which is checked before reading data sources.
+	disabledDataSources map[string]string
+
+	// disabledResources is the [provider.ConfigureResponse.DisabledResources]
+	// field value, which is checked before planning and importing resources.
+	disabledResources map[string]string
+
+	// suppressedWarnings is the [provider.ConfigureResponse.SuppressedWarnings]
+	// field value, which is applied to diagnostics in every response.
+	suppressedWarnings diag.SuppressedWarnings
 }
 
 // DataSource returns the DataSource for a given type name.
@@ -254,19 +349,27 @@ func (s *Server) DataSourceFuncs(ctx context.Context) (map[string]func() datasou
 		}
 
 		s.dataSourceFuncs[dataSourceTypeNameResp.TypeName] = dataSourceFunc
+
+		if dataSourceTypeNameResp.Deprecation.IsDeprecated() {
+			if s.dataSourceDeprecations == nil {
+				s.dataSourceDeprecations = make(map[string]datasource.Deprecation)
+			}
+
+			s.dataSourceDeprecations[dataSourceTypeNameResp.TypeName] = dataSourceTypeNameResp.Deprecation
+		}
 	}
 
 	return s.dataSourceFuncs, s.dataSourceTypesDiags
 }
 
 // DataSourceMetadatas returns a slice of DataSourceMetadata for the GetMetadata
-// RPC.
+// RPC, ordered by type name so the response is stable across calls.
 func (s *Server) DataSourceMetadatas(ctx context.Context) ([]DataSourceMetadata, diag.Diagnostics) {
 	datasourceFuncs, diags := s.DataSourceFuncs(ctx)
 
 	datasourceMetadatas := make([]DataSourceMetadata, 0, len(datasourceFuncs))
 
-	for typeName := range datasourceFuncs {
+	for _, typeName := range sortedKeys(datasourceFuncs) {
 		datasourceMetadatas = append(datasourceMetadatas, DataSourceMetadata{
 			TypeName: typeName,
 		})
@@ -309,6 +412,8 @@ func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (fwschem
 		return schemaResp.Schema, diags
 	}
 
+	s.dataSourceDeprecationSchema(ctx, typeName, &schemaResp.Schema)
+
 	s.dataSourceSchemasMutex.Lock()
 
 	if s.dataSourceSchemas == nil {
@@ -331,7 +436,8 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 
 	dataSourceFuncs, diags := s.DataSourceFuncs(ctx)
 
-	for typeName, dataSourceFunc := range dataSourceFuncs {
+	for _, typeName := range sortedKeys(dataSourceFuncs) {
+		dataSourceFunc := dataSourceFuncs[typeName]
 		dataSource := dataSourceFunc()
 
 		schemaReq := datasource.SchemaRequest{}
@@ -355,12 +461,83 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 			continue
 		}
 
+		s.dataSourceDeprecationSchema(ctx, typeName, &schemaResp.Schema)
+
 		dataSourceSchemas[typeName] = schemaResp.Schema
 	}
 
 	return dataSourceSchemas, diags
 }
 
+// dataSourceDeprecationSchema sets the schema deprecation message for a data
+// source type with deprecation metadata, if not already set.
+func (s *Server) dataSourceDeprecationSchema(ctx context.Context, typeName string, dataSourceSchema *datasourceschema.Schema) {
+	// Ensure the deprecations are populated.
+	_, _ = s.DataSourceFuncs(ctx)
+
+	s.dataSourceTypesMutex.Lock()
+	deprecation, ok := s.dataSourceDeprecations[typeName]
+	s.dataSourceTypesMutex.Unlock()
+
+	if !ok || dataSourceSchema.DeprecationMessage != "" {
+		return
+	}
+
+	dataSourceSchema.DeprecationMessage = deprecationMessage("data source", typeName, deprecation.Message, deprecation.ReplacementTypeName, deprecation.RemovalVersion)
+}
+
+// DiagnosticLimits returns the provider-defined diag.Limits, if the provider
+// implements the ProviderWithDiagnosticLimits interface. The limits are cached
+// on first use.
+func (s *Server) DiagnosticLimits(ctx context.Context) diag.Limits {
+	s.diagnosticLimitsMutex.Lock()
+	defer s.diagnosticLimitsMutex.Unlock()
+
+	if s.diagnosticLimitsFetched {
+		return s.diagnosticLimits
+	}
+
+	s.diagnosticLimitsFetched = true
+
+	providerWithLimits, ok := s.Provider.(provider.ProviderWithDiagnosticLimits)
+
+	if !ok {
+		return diag.Limits{}
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider DiagnosticLimits")
+	s.diagnosticLimits = providerWithLimits.DiagnosticLimits(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider DiagnosticLimits")
+
+	return s.diagnosticLimits
+}
+
+// DiagnosticMessageCatalog returns the provider-defined diag.MessageCatalog,
+// if the provider implements the ProviderWithDiagnosticMessageCatalog
+// interface. The catalog is cached on first use.
+func (s *Server) DiagnosticMessageCatalog(ctx context.Context) diag.MessageCatalog {
+	s.diagnosticMessageCatalogMutex.Lock()
+	defer s.diagnosticMessageCatalogMutex.Unlock()
+
+	if s.diagnosticMessageCatalogFetched {
+		return s.diagnosticMessageCatalog
+	}
+
+	s.diagnosticMessageCatalogFetched = true
+
+	providerWithCatalog, ok := s.Provider.(provider.ProviderWithDiagnosticMessageCatalog)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider DiagnosticMessageCatalog")
+	s.diagnosticMessageCatalog = providerWithCatalog.DiagnosticMessageCatalog(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider DiagnosticMessageCatalog")
+
+	return s.diagnosticMessageCatalog
+}
+
 // ProviderTypeName returns the TypeName associated with the Provider. The TypeName is cached on first use.
 func (s *Server) ProviderTypeName(ctx context.Context) string {
 	logging.FrameworkTrace(ctx, "Checking ProviderTypeName lock")
@@ -461,6 +638,64 @@ func (s *Server) Resource(ctx context.Context, typeName string) (resource.Resour
 	return resourceFunc(), diags
 }
 
+// DataSourceEnabled returns an error diagnostic if the given data source type
+// name was disabled by the provider configuration.
+func (s *Server) DataSourceEnabled(ctx context.Context, typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	reason, ok := s.disabledDataSources[typeName]
+
+	if !ok {
+		return diags
+	}
+
+	logging.FrameworkDebug(ctx, "Data source type disabled by provider configuration")
+
+	diags.AddError(
+		"Data Source Not Enabled",
+		disabledDetail("data source", typeName, reason),
+	)
+
+	return diags
+}
+
+// SuppressedWarnings returns the warning diagnostic keys which were
+// suppressed by the provider configuration.
+func (s *Server) SuppressedWarnings() diag.SuppressedWarnings {
+	return s.suppressedWarnings
+}
+
+// ResourceEnabled returns an error diagnostic if the given resource type
+// name was disabled by the provider configuration.
+func (s *Server) ResourceEnabled(ctx context.Context, typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	reason, ok := s.disabledResources[typeName]
+
+	if !ok {
+		return diags
+	}
+
+	logging.FrameworkDebug(ctx, "Resource type disabled by provider configuration")
+
+	diags.AddError(
+		"Resource Not Enabled",
+		disabledDetail("resource", typeName, reason),
+	)
+
+	return diags
+}
+
+func disabledDetail(kind string, typeName string, reason string) string {
+	detail := fmt.Sprintf("The %q %s is not enabled by the provider configuration.", typeName, kind)
+
+	if reason != "" {
+		detail += "\n\n" + reason
+	}
+
+	return detail
+}
+
 // ResourceBehavior returns the ResourceBehavior for a given type name.
 func (s *Server) ResourceBehavior(ctx context.Context, typeName string) (resource.ResourceBehavior, diag.Diagnostics) {
 	resourceBehaviors, diags := s.ResourceBehaviors(ctx)
@@ -583,19 +818,94 @@ func (s *Server) ResourceFuncs(ctx context.Context) (map[string]func() resource.
 		}
 
 		s.resourceFuncs[resourceTypeNameResp.TypeName] = resourceFunc
+
+		if resourceTypeNameResp.Deprecation.IsDeprecated() {
+			if s.resourceDeprecations == nil {
+				s.resourceDeprecations = make(map[string]resource.Deprecation)
+			}
+
+			s.resourceDeprecations[resourceTypeNameResp.TypeName] = resourceTypeNameResp.Deprecation
+		}
+
+		for _, deprecatedTypeName := range resourceTypeNameResp.DeprecatedTypeNames {
+			if _, ok := s.resourceFuncs[deprecatedTypeName]; ok {
+				s.resourceTypesDiags.AddError(
+					"Duplicate Resource Type Defined",
+					fmt.Sprintf("The %s resource type name was returned for multiple resources. ", deprecatedTypeName)+
+						"Resource type names, including deprecated type names, must be unique. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				)
+				continue
+			}
+
+			if s.resourceDeprecatedTypeNames == nil {
+				s.resourceDeprecatedTypeNames = make(map[string]string)
+			}
+
+			s.resourceFuncs[deprecatedTypeName] = resourceFunc
+			s.resourceDeprecatedTypeNames[deprecatedTypeName] = resourceTypeNameResp.TypeName
+
+			if resourceTypeNameResp.Deprecation.IsDeprecated() {
+				s.resourceDeprecations[deprecatedTypeName] = resourceTypeNameResp.Deprecation
+			}
+		}
 	}
 
 	return s.resourceFuncs, s.resourceTypesDiags
 }
 
+// ResourceDeprecatedTypeName returns the current resource type name and true
+// if the given resource type name is deprecated.
+func (s *Server) ResourceDeprecatedTypeName(ctx context.Context, typeName string) (string, bool) {
+	// Ensure the deprecated type names are populated.
+	_, _ = s.ResourceFuncs(ctx)
+
+	s.resourceTypesMutex.Lock()
+	defer s.resourceTypesMutex.Unlock()
+
+	currentTypeName, ok := s.resourceDeprecatedTypeNames[typeName]
+
+	return currentTypeName, ok
+}
+
+// resourceDeprecationSchema sets the schema deprecation message for a resource
+// type with deprecation metadata, if not already set.
+func (s *Server) resourceDeprecationSchema(ctx context.Context, typeName string, resourceSchema *schema.Schema) {
+	// Ensure the deprecations are populated.
+	_, _ = s.ResourceFuncs(ctx)
+
+	s.resourceTypesMutex.Lock()
+	deprecation, ok := s.resourceDeprecations[typeName]
+	s.resourceTypesMutex.Unlock()
+
+	if !ok || resourceSchema.DeprecationMessage != "" {
+		return
+	}
+
+	resourceSchema.DeprecationMessage = deprecationMessage("resource", typeName, deprecation.Message, deprecation.ReplacementTypeName, deprecation.RemovalVersion)
+}
+
+// resourceDeprecatedTypeNameSchema sets the schema deprecation message for
+// a deprecated resource type name, if not already set.
+func (s *Server) resourceDeprecatedTypeNameSchema(ctx context.Context, typeName string, resourceSchema *schema.Schema) {
+	currentTypeName, ok := s.ResourceDeprecatedTypeName(ctx, typeName)
+
+	if !ok || resourceSchema.DeprecationMessage != "" {
+		return
+	}
+
+	resourceSchema.DeprecationMessage = fmt.Sprintf("The %s resource type is deprecated. Use the %s resource type instead. ", typeName, currentTypeName) +
+		"Existing resources can be migrated with a moved block in Terraform 1.8 and later."
+}
+
 // ResourceMetadatas returns a slice of ResourceMetadata for the GetMetadata
-// RPC.
+// RPC, ordered by type name so the response is stable across calls.
 func (s *Server) ResourceMetadatas(ctx context.Context) ([]ResourceMetadata, diag.Diagnostics) {
 	resourceFuncs, diags := s.ResourceFuncs(ctx)
 
 	resourceMetadatas := make([]ResourceMetadata, 0, len(resourceFuncs))
 
-	for typeName := range resourceFuncs {
+	for _, typeName := range sortedKeys(resourceFuncs) {
 		resourceMetadatas = append(resourceMetadatas, ResourceMetadata{
 			TypeName: typeName,
 		})
@@ -638,6 +948,9 @@ func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.
 		return schemaResp.Schema, diags
 	}
 
+	s.resourceDeprecationSchema(ctx, typeName, &schemaResp.Schema)
+	s.resourceDeprecatedTypeNameSchema(ctx, typeName, &schemaResp.Schema)
+
 	s.resourceSchemasMutex.Lock()
 
 	if s.resourceSchemas == nil {
@@ -660,7 +973,10 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 
 	resourceFuncs, diags := s.ResourceFuncs(ctx)
 
-	for typeName, resourceFunc := range resourceFuncs {
+	strictSchemaValidation := s.StrictSchemaValidation(ctx)
+
+	for _, typeName := range sortedKeys(resourceFuncs) {
+		resourceFunc := resourceFuncs[typeName]
 		r := resourceFunc()
 
 		schemaReq := resource.SchemaRequest{}
@@ -684,6 +1000,13 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 			continue
 		}
 
+		if strictSchemaValidation {
+			diags.Append(strictResourceSchemaDiags(ctx, typeName, schemaResp.Schema)...)
+		}
+
+		s.resourceDeprecationSchema(ctx, typeName, &schemaResp.Schema)
+		s.resourceDeprecatedTypeNameSchema(ctx, typeName, &schemaResp.Schema)
+
 		resourceSchemas[typeName] = schemaResp.Schema
 	}
 
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -17,13 +19,14 @@ import (
 // ApplyResourceChangeRequest is the framework server request for the
 // ApplyResourceChange RPC.
 type ApplyResourceChangeRequest struct {
-	Config         *tfsdk.Config
-	PlannedPrivate *privatestate.Data
-	PlannedState   *tfsdk.Plan
-	PriorState     *tfsdk.State
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	Config           *tfsdk.Config
+	PlannedPrivate   *privatestate.Data
+	PlannedState     *tfsdk.Plan
+	PriorState       *tfsdk.State
+	ProviderMeta     *tfsdk.Config
+	ResourceSchema   fwschema.Schema
+	Resource         resource.Resource
+	ResourceBehavior resource.ResourceBehavior
 }
 
 // ApplyResourceChangeResponse is the framework server response for the
@@ -40,21 +43,69 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		return
 	}
 
+	afterHooks := s.beforeResourceHooks(ctx, hookOperationApply, req.Resource)
+	auditOperation := applyAuditOperation(req)
+	finishAudit := s.startResourceAudit(ctx, auditOperation, req.Resource)
+	transform := s.resourceStateTransform(ctx, req.Resource)
+
+	defer func() {
+		afterHooks(resp.Diagnostics)
+
+		auditState := resp.NewState
+
+		if auditOperation == provider.AuditOperationDelete {
+			auditState = req.PriorState
+		}
+
+		finishAudit(auditResult{
+			Diagnostics: resp.Diagnostics,
+			State:       auditState,
+		})
+	}()
+
+	defer func() {
+		var diags diag.Diagnostics
+
+		resp.NewState, diags = transform.encodeState(ctx, resp.NewState)
+
+		resp.Diagnostics.Append(diags...)
+	}()
+
+	defer recoverPanic(ctx, "ApplyResourceChange", &resp.Diagnostics, req.Config, req.PriorState, req.PlannedState)
+
+	// The planned state is decoded last, so unchanged planned values keep
+	// their stored planned value in the new state.
+	priorState, diags := transform.decodeState(ctx, req.PriorState)
+
+	resp.Diagnostics.Append(diags...)
+
+	plannedState, diags := transform.decodePlan(ctx, req.PlannedState)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	req.PriorState = priorState
+	req.PlannedState = plannedState
+
 	// If PriorState is missing/null, its a Create request.
 	if req.PriorState == nil || req.PriorState.Raw.IsNull() {
 		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PriorState, running CreateResource")
 
 		createReq := &CreateResourceRequest{
-			Config:         req.Config,
-			PlannedPrivate: req.PlannedPrivate,
-			PlannedState:   req.PlannedState,
-			ProviderMeta:   req.ProviderMeta,
-			ResourceSchema: req.ResourceSchema,
-			Resource:       req.Resource,
+			Config:           req.Config,
+			PlannedPrivate:   req.PlannedPrivate,
+			PlannedState:     req.PlannedState,
+			ProviderMeta:     req.ProviderMeta,
+			ResourceSchema:   req.ResourceSchema,
+			Resource:         req.Resource,
+			ResourceBehavior: req.ResourceBehavior,
 		}
 		createResp := &CreateResourceResponse{}
 
-		s.CreateResource(ctx, createReq, createResp)
+		s.CreateResource(withRequestOperation(ctx, requestinfo.OperationCreate), createReq, createResp)
 
 		resp.Diagnostics = createResp.Diagnostics
 		resp.NewState = createResp.NewState
@@ -68,15 +119,16 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PlannedState, running DeleteResource")
 
 		deleteReq := &DeleteResourceRequest{
-			PlannedPrivate: req.PlannedPrivate,
-			PriorState:     req.PriorState,
-			ProviderMeta:   req.ProviderMeta,
-			ResourceSchema: req.ResourceSchema,
-			Resource:       req.Resource,
+			PlannedPrivate:   req.PlannedPrivate,
+			PriorState:       req.PriorState,
+			ProviderMeta:     req.ProviderMeta,
+			ResourceSchema:   req.ResourceSchema,
+			Resource:         req.Resource,
+			ResourceBehavior: req.ResourceBehavior,
 		}
 		deleteResp := &DeleteResourceResponse{}
 
-		s.DeleteResource(ctx, deleteReq, deleteResp)
+		s.DeleteResource(withRequestOperation(ctx, requestinfo.OperationDelete), deleteReq, deleteResp)
 
 		resp.Diagnostics = deleteResp.Diagnostics
 		resp.NewState = deleteResp.NewState
@@ -89,19 +141,30 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 	logging.FrameworkTrace(ctx, "ApplyResourceChange running UpdateResource")
 
 	updateReq := &UpdateResourceRequest{
-		Config:         req.Config,
-		PlannedPrivate: req.PlannedPrivate,
-		PlannedState:   req.PlannedState,
-		PriorState:     req.PriorState,
-		ProviderMeta:   req.ProviderMeta,
-		ResourceSchema: req.ResourceSchema,
-		Resource:       req.Resource,
+		Config:           req.Config,
+		PlannedPrivate:   req.PlannedPrivate,
+		PlannedState:     req.PlannedState,
+		PriorState:       req.PriorState,
+		ProviderMeta:     req.ProviderMeta,
+		ResourceSchema:   req.ResourceSchema,
+		Resource:         req.Resource,
+		ResourceBehavior: req.ResourceBehavior,
 	}
 	updateResp := &UpdateResourceResponse{}
 
-	s.UpdateResource(ctx, updateReq, updateResp)
+	s.UpdateResource(withRequestOperation(ctx, requestinfo.OperationUpdate), updateReq, updateResp)
 
 	resp.Diagnostics = updateResp.Diagnostics
 	resp.NewState = updateResp.NewState
 	resp.Private = updateResp.Private
 }
+
+// withRequestOperation returns a new context with the given operation added
+// to the request information.
+func withRequestOperation(ctx context.Context, operation requestinfo.Operation) context.Context {
+	info, _ := requestinfo.FromContext(ctx)
+
+	info.Operation = operation
+
+	return requestinfo.NewContext(ctx, info)
+}
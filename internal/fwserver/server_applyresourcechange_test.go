@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -800,6 +801,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_computed"), "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -857,6 +862,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_computed"), "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -914,6 +923,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_computed"), "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -972,6 +985,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_computed"), "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
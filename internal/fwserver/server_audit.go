@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// auditResult is the result of an operation, which is converted into a
+// provider.AuditRecord.
+type auditResult struct {
+	// Diagnostics is the final diagnostics of the operation.
+	Diagnostics diag.Diagnostics
+
+	// Deferred is true if the operation returned a deferred response.
+	Deferred bool
+
+	// Identity is the identity of the remote object, if known upfront, such
+	// as the import identifier. Otherwise, it is read from State.
+	Identity string
+
+	// State is the resulting state, or prior state for deletes, which the
+	// identity is read from.
+	State *tfsdk.State
+}
+
+// AuditSink returns the provider-defined audit sink, if the provider
+// implements the ProviderWithAuditSink interface.
+func (s *Server) AuditSink(ctx context.Context) provider.AuditSink {
+	providerWithAuditSink, ok := s.Provider.(provider.ProviderWithAuditSink)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider AuditSink")
+	sink := providerWithAuditSink.AuditSink(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider AuditSink")
+
+	return sink
+}
+
+// startResourceAudit returns a function which sends the audit record of the
+// resource operation to the provider-defined audit sink, if any.
+func (s *Server) startResourceAudit(ctx context.Context, operation provider.AuditOperation, r resource.Resource) func(auditResult) {
+	sink := s.AuditSink(ctx)
+
+	if sink == nil || r == nil {
+		return func(auditResult) {}
+	}
+
+	metadataResp := resource.MetadataResponse{}
+
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: s.ProviderTypeName(ctx)}, &metadataResp)
+
+	return recordAudit(ctx, sink, provider.AuditRecord{
+		Operation:        operation,
+		ResourceTypeName: metadataResp.TypeName,
+	})
+}
+
+// startDataSourceAudit returns a function which sends the audit record of
+// the data source operation to the provider-defined audit sink, if any.
+func (s *Server) startDataSourceAudit(ctx context.Context, operation provider.AuditOperation, d datasource.DataSource) func(auditResult) {
+	sink := s.AuditSink(ctx)
+
+	if sink == nil || d == nil {
+		return func(auditResult) {}
+	}
+
+	metadataResp := datasource.MetadataResponse{}
+
+	d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: s.ProviderTypeName(ctx)}, &metadataResp)
+
+	return recordAudit(ctx, sink, provider.AuditRecord{
+		Operation:          operation,
+		DataSourceTypeName: metadataResp.TypeName,
+	})
+}
+
+// recordAudit starts the operation duration and returns a function which
+// completes the record with the operation result and sends it to the sink.
+func recordAudit(ctx context.Context, sink provider.AuditSink, record provider.AuditRecord) func(auditResult) {
+	record.StartTime = time.Now()
+
+	return func(result auditResult) {
+		record.Duration = time.Since(record.StartTime)
+		record.ErrorCount = result.Diagnostics.ErrorsCount()
+		record.WarningCount = result.Diagnostics.WarningsCount()
+		record.Identity = result.Identity
+
+		if record.Identity == "" && result.State != nil {
+			record.Identity = auditIdentity(ctx, *result.State)
+		}
+
+		switch {
+		case result.Diagnostics.HasError():
+			record.Outcome = provider.AuditOutcomeFailure
+		case result.Deferred:
+			record.Outcome = provider.AuditOutcomeDeferred
+		default:
+			record.Outcome = provider.AuditOutcomeSuccess
+		}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined AuditSink Record")
+		sink.Record(ctx, record)
+		logging.FrameworkTrace(ctx, "Called provider defined AuditSink Record")
+	}
+}
+
+// auditIdentity returns the known value of the root id string attribute of
+// the state, if any. Sensitive id attributes are never returned, since audit
+// records are sent outside of Terraform.
+func auditIdentity(ctx context.Context, state tfsdk.State) string {
+	if state.Schema == nil || !state.Raw.IsKnown() || state.Raw.IsNull() || !state.Raw.Type().Is(tftypes.Object{}) {
+		return ""
+	}
+
+	attribute, err := state.Schema.AttributeAtPath(ctx, path.Root("id"))
+
+	if err != nil || attribute.IsSensitive() {
+		return ""
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := state.Raw.As(&attributes); err != nil {
+		return ""
+	}
+
+	id, ok := attributes["id"]
+
+	if !ok || !id.Type().Is(tftypes.String) || !id.IsKnown() || id.IsNull() {
+		return ""
+	}
+
+	var result string
+
+	if err := id.As(&result); err != nil {
+		return ""
+	}
+
+	return result
+}
+
+// applyAuditOperation returns the audit operation of the
+// ApplyResourceChange request, following the same logic as the
+// ApplyResourceChange method.
+func applyAuditOperation(req *ApplyResourceChangeRequest) provider.AuditOperation {
+	if req.PriorState == nil || req.PriorState.Raw.IsNull() {
+		return provider.AuditOperationCreate
+	}
+
+	if req.PlannedState == nil || req.PlannedState.Raw.IsNull() {
+		return provider.AuditOperationDelete
+	}
+
+	return provider.AuditOperationUpdate
+}
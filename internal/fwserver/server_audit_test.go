@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestServerAuditSink(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"test": tftypes.String,
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "test-id"),
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testConfigValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, nil),
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testPlannedValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testNullValue := tftypes.NewValue(testType, nil)
+
+	testResourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testSensitiveResourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testDataSourceSchema := datasourceschema.Schema{
+		Attributes: map[string]datasourceschema.Attribute{
+			"id": datasourceschema.StringAttribute{
+				Computed: true,
+			},
+			"test": datasourceschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testResource := &testprovider.ResourceWithImportState{
+		Resource: &testprovider.Resource{
+			CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+				resp.State.Raw = testValue
+			},
+			DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+				resp.Diagnostics.AddWarning("test summary", "test detail")
+			},
+			MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+				resp.TypeName = req.ProviderTypeName + "_resource"
+			},
+			ReadMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.AddError("test summary", "test detail")
+			},
+		},
+		ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+			resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		},
+	}
+
+	testDataSource := &testprovider.DataSource{
+		MetadataMethod: func(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+			resp.TypeName = req.ProviderTypeName + "_data_source"
+		},
+		ReadMethod: func(_ context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+			resp.State.Raw = testValue
+		},
+	}
+
+	testCases := map[string]struct {
+		call     func(context.Context, *fwserver.Server)
+		expected []provider.AuditRecord
+	}{
+		"plan-resource": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.PlanResourceChange(ctx, &fwserver.PlanResourceChangeRequest{
+					Config:           &tfsdk.Config{Raw: testConfigValue, Schema: testResourceSchema},
+					PriorState:       &tfsdk.State{Raw: testNullValue, Schema: testResourceSchema},
+					ProposedNewState: &tfsdk.Plan{Raw: testConfigValue, Schema: testResourceSchema},
+					ResourceSchema:   testResourceSchema,
+					Resource:         testResource,
+				}, &fwserver.PlanResourceChangeResponse{})
+			},
+			expected: []provider.AuditRecord{
+				{
+					Operation:        provider.AuditOperationPlan,
+					ResourceTypeName: "test_resource",
+					Outcome:          provider.AuditOutcomeSuccess,
+				},
+			},
+		},
+		"apply-resource-create": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+					Config:         &tfsdk.Config{Raw: testConfigValue, Schema: testResourceSchema},
+					PlannedState:   &tfsdk.Plan{Raw: testPlannedValue, Schema: testResourceSchema},
+					PriorState:     &tfsdk.State{Raw: testNullValue, Schema: testResourceSchema},
+					ResourceSchema: testResourceSchema,
+					Resource:       testResource,
+				}, &fwserver.ApplyResourceChangeResponse{})
+			},
+			expected: []provider.AuditRecord{
+				{
+					Operation:        provider.AuditOperationCreate,
+					ResourceTypeName: "test_resource",
+					Identity:         "test-id",
+					Outcome:          provider.AuditOutcomeSuccess,
+				},
+			},
+		},
+		"apply-resource-delete": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+					PlannedState:   &tfsdk.Plan{Raw: testNullValue, Schema: testResourceSchema},
+					PriorState:     &tfsdk.State{Raw: testValue, Schema: testResourceSchema},
+					ResourceSchema: testResourceSchema,
+					Resource:       testResource,
+				}, &fwserver.ApplyResourceChangeResponse{})
+			},
+			expected: []provider.AuditRecord{
+				{
+					Operation:        provider.AuditOperationDelete,
+					ResourceTypeName: "test_resource",
+					Identity:         "test-id",
+					Outcome:          provider.AuditOutcomeSuccess,
+					WarningCount:     1,
+				},
+			},
+		},
+		"read-resource-diagnostics": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ReadResource(ctx, &fwserver.ReadResourceRequest{
+					CurrentState: &tfsdk.State{Raw: testValue, Schema: testResourceSchema},
+					Resource:     testResource,
+				}, &fwserver.ReadResourceResponse{})
+			},
+			expected: []provider.AuditRecord{
+				{
+					Operation:        provider.AuditOperationRead,
+					ResourceTypeName: "test_resource",
+					Identity:         "test-id",
+					Outcome:          provider.AuditOutcomeFailure,
+					ErrorCount:       1,
+				},
+			},
+		},
+		"read-resource-sensitive-id": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ReadResource(ctx, &fwserver.ReadResourceRequest{
+					CurrentState: &tfsdk.State{Raw: testValue, Schema: testSensitiveResourceSchema},
+					Resource:     testResource,
+				}, &fwserver.ReadResourceResponse{})
+			},
+			expected: []provider.AuditRecord{
+				{
+					Operation:        provider.AuditOperationRead,
+					ResourceTypeName: "test_resource",
+					Outcome:          provider.AuditOutcomeFailure,
+					ErrorCount:       1,
+				},
+			},
+		},
+		"import-resource": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ImportResourceState(ctx, &fwserver.ImportResourceStateRequest{
+					EmptyState: tfsdk.State{Raw: testNullValue, Schema: testResourceSchema},
+					ID:         "test-import-id",
+					Resource:   testResource,
+					TypeName:   "test_resource",
+				}, &fwserver.ImportResourceStateResponse{})
+			},
+			expected: []provider.AuditRecord{
+				{
+					Operation:        provider.AuditOperationImport,
+					ResourceTypeName: "test_resource",
+					Identity:         "test-import-id",
+					Outcome:          provider.AuditOutcomeSuccess,
+				},
+			},
+		},
+		"read-data-source": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ReadDataSource(ctx, &fwserver.ReadDataSourceRequest{
+					Config:           &tfsdk.Config{Raw: testConfigValue, Schema: testDataSourceSchema},
+					DataSourceSchema: testDataSourceSchema,
+					DataSource:       testDataSource,
+				}, &fwserver.ReadDataSourceResponse{})
+			},
+			expected: []provider.AuditRecord{
+				{
+					Operation:          provider.AuditOperationRead,
+					DataSourceTypeName: "test_data_source",
+					Identity:           "test-id",
+					Outcome:            provider.AuditOutcomeSuccess,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []provider.AuditRecord
+
+			sink := &testprovider.AuditSink{
+				RecordMethod: func(_ context.Context, record provider.AuditRecord) {
+					if record.StartTime.IsZero() {
+						t.Error("expected start time")
+					}
+
+					if record.Duration < 0 {
+						t.Errorf("unexpected negative duration: %s", record.Duration)
+					}
+
+					record.StartTime = time.Time{}
+					record.Duration = 0
+					got = append(got, record)
+				},
+			}
+
+			server := &fwserver.Server{
+				Provider: &testprovider.ProviderWithAuditSink{
+					Provider: &testprovider.Provider{
+						MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+							resp.TypeName = "test"
+						},
+					},
+					AuditSinkMethod: func(_ context.Context) provider.AuditSink {
+						return sink
+					},
+				},
+			}
+
+			testCase.call(context.Background(), server)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
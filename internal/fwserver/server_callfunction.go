@@ -5,6 +5,7 @@ package fwserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -16,11 +17,15 @@ type CallFunctionRequest struct {
 	Arguments          function.ArgumentsData
 	Function           function.Function
 	FunctionDefinition function.Definition
+	FunctionName       string
 }
 
 // CallFunctionResponse is the framework server response for the
 // CallFunction RPC.
 type CallFunctionResponse struct {
+	// Cached is true if the Result was returned from the function result
+	// cache of a function with a Pure definition.
+	Cached bool
 	Error  *function.FuncError
 	Result function.ResultData
 }
@@ -31,6 +36,57 @@ func (s *Server) CallFunction(ctx context.Context, req *CallFunctionRequest, res
 		return
 	}
 
+	runHooks := s.FunctionRunHooks(ctx)
+
+	if len(runHooks) > 0 {
+		hookArguments := functionRunHookArguments(ctx, req.Arguments)
+		beforeReq := function.BeforeRunHookRequest{
+			FunctionName: req.FunctionName,
+			Arguments:    hookArguments,
+		}
+		afterReq := function.AfterRunHookRequest{
+			FunctionName: req.FunctionName,
+			Arguments:    hookArguments,
+		}
+
+		for _, runHook := range runHooks {
+			logging.FrameworkTrace(ctx, "Calling provider defined Function BeforeRun hook")
+			runHook.BeforeRun(ctx, beforeReq)
+			logging.FrameworkTrace(ctx, "Called provider defined Function BeforeRun hook")
+		}
+
+		start := time.Now()
+
+		defer func() {
+			afterReq.Cached = resp.Cached
+			afterReq.Duration = time.Since(start)
+			afterReq.Error = resp.Error
+
+			for _, runHook := range runHooks {
+				logging.FrameworkTrace(ctx, "Calling provider defined Function AfterRun hook")
+				runHook.AfterRun(ctx, afterReq)
+				logging.FrameworkTrace(ctx, "Called provider defined Function AfterRun hook")
+			}
+		}()
+	}
+
+	defer recoverFunctionPanic(ctx, &resp.Error, req.Arguments)
+
+	var cacheKey string
+
+	if req.FunctionDefinition.Pure {
+		cacheKey = functionResultCacheKey(ctx, req.FunctionName, req.Arguments)
+
+		if result, ok := s.functionResult(cacheKey); ok {
+			logging.FrameworkTrace(ctx, "Using cached Function result for pure function")
+
+			resp.Cached = true
+			resp.Result = result
+
+			return
+		}
+	}
+
 	resultData, err := req.FunctionDefinition.Return.NewResultData(ctx)
 
 	resp.Error = function.ConcatFuncErrors(resp.Error, err)
@@ -53,4 +109,8 @@ func (s *Server) CallFunction(ctx context.Context, req *CallFunctionRequest, res
 	resp.Error = function.ConcatFuncErrors(resp.Error, runResp.Error)
 
 	resp.Result = runResp.Result
+
+	if req.FunctionDefinition.Pure && cacheKey != "" && resp.Error == nil {
+		s.setFunctionResult(cacheKey, resp.Result)
+	}
 }
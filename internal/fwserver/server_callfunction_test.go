@@ -394,3 +394,264 @@ func TestServerCallFunction(t *testing.T) {
 		})
 	}
 }
+
+func TestServerCallFunction_Pure(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition    function.Definition
+		runError      *function.FuncError
+		arguments     []function.ArgumentsData
+		expectedCalls int
+	}{
+		"pure-same-arguments": {
+			definition: function.Definition{
+				Pure:   true,
+				Return: function.StringReturn{},
+			},
+			arguments: []function.ArgumentsData{
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("arg0")}),
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("arg0")}),
+			},
+			expectedCalls: 1,
+		},
+		"pure-different-arguments": {
+			definition: function.Definition{
+				Pure:   true,
+				Return: function.StringReturn{},
+			},
+			arguments: []function.ArgumentsData{
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("arg0")}),
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("arg1")}),
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringNull()}),
+			},
+			expectedCalls: 3,
+		},
+		"pure-error": {
+			definition: function.Definition{
+				Pure:   true,
+				Return: function.StringReturn{},
+			},
+			runError: function.NewFuncError("test error"),
+			arguments: []function.ArgumentsData{
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("arg0")}),
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("arg0")}),
+			},
+			expectedCalls: 2,
+		},
+		"not-pure": {
+			definition: function.Definition{
+				Return: function.StringReturn{},
+			},
+			arguments: []function.ArgumentsData{
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("arg0")}),
+				function.NewArgumentsData([]attr.Value{basetypes.NewStringValue("arg0")}),
+			},
+			expectedCalls: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+
+			server := &fwserver.Server{
+				Provider: &testprovider.ProviderWithFunctions{},
+			}
+			testFunction := &testprovider.Function{
+				RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+					calls++
+
+					var arg0 basetypes.StringValue
+
+					resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg0))
+					resp.Error = function.ConcatFuncErrors(resp.Error, testCase.runError)
+					resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, arg0))
+				},
+			}
+
+			for _, arguments := range testCase.arguments {
+				response := &fwserver.CallFunctionResponse{}
+				server.CallFunction(context.Background(), &fwserver.CallFunctionRequest{
+					Arguments:          arguments,
+					Function:           testFunction,
+					FunctionDefinition: testCase.definition,
+					FunctionName:       "testfunction",
+				}, response)
+
+				var expectedValue attr.Value
+
+				if funcErr := arguments.GetArgument(context.Background(), 0, &expectedValue); funcErr != nil {
+					t.Fatalf("unexpected error: %s", funcErr)
+				}
+
+				if diff := cmp.Diff(response.Result, function.NewResultData(expectedValue), cmp.AllowUnexported(function.ResultData{})); diff != "" {
+					t.Errorf("unexpected result difference: %s", diff)
+				}
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d Run calls, got %d", testCase.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestServerCallFunction_RunHooks(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition     function.Definition
+		arguments      function.ArgumentsData
+		runError       *function.FuncError
+		calls          int
+		expectedBefore []function.BeforeRunHookRequest
+		expectedAfter  []function.AfterRunHookRequest
+	}{
+		"arguments": {
+			definition: function.Definition{
+				Return: function.StringReturn{},
+			},
+			arguments: function.NewArgumentsData([]attr.Value{
+				basetypes.NewStringValue("secret"),
+				basetypes.NewStringNull(),
+				basetypes.NewListValueMust(basetypes.StringType{}, []attr.Value{
+					basetypes.NewStringValue("one"),
+					basetypes.NewStringValue("two"),
+				}),
+				basetypes.NewInt64Unknown(),
+			}),
+			calls: 1,
+			expectedBefore: []function.BeforeRunHookRequest{
+				{
+					FunctionName: "testfunction",
+					Arguments: []function.RunHookArgument{
+						{Type: basetypes.StringType{}, Size: 6},
+						{Type: basetypes.StringType{}, IsNull: true},
+						{Type: basetypes.ListType{ElemType: basetypes.StringType{}}, Size: 2},
+						{Type: basetypes.Int64Type{}, IsUnknown: true},
+					},
+				},
+			},
+			expectedAfter: []function.AfterRunHookRequest{
+				{
+					FunctionName: "testfunction",
+					Arguments: []function.RunHookArgument{
+						{Type: basetypes.StringType{}, Size: 6},
+						{Type: basetypes.StringType{}, IsNull: true},
+						{Type: basetypes.ListType{ElemType: basetypes.StringType{}}, Size: 2},
+						{Type: basetypes.Int64Type{}, IsUnknown: true},
+					},
+				},
+			},
+		},
+		"error": {
+			definition: function.Definition{
+				Return: function.StringReturn{},
+			},
+			runError: function.NewFuncError("test error"),
+			calls:    1,
+			expectedBefore: []function.BeforeRunHookRequest{
+				{
+					FunctionName: "testfunction",
+				},
+			},
+			expectedAfter: []function.AfterRunHookRequest{
+				{
+					FunctionName: "testfunction",
+					Error:        function.NewFuncError("test error"),
+				},
+			},
+		},
+		"pure-cached": {
+			definition: function.Definition{
+				Pure:   true,
+				Return: function.StringReturn{},
+			},
+			calls: 2,
+			expectedBefore: []function.BeforeRunHookRequest{
+				{
+					FunctionName: "testfunction",
+				},
+				{
+					FunctionName: "testfunction",
+				},
+			},
+			expectedAfter: []function.AfterRunHookRequest{
+				{
+					FunctionName: "testfunction",
+				},
+				{
+					FunctionName: "testfunction",
+					Cached:       true,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotBefore []function.BeforeRunHookRequest
+			var gotAfter []function.AfterRunHookRequest
+			var runHooksCalls int
+
+			server := &fwserver.Server{
+				Provider: &testprovider.ProviderWithFunctionRunHooks{
+					FunctionRunHooksMethod: func(_ context.Context) []function.RunHook {
+						runHooksCalls++
+
+						return []function.RunHook{
+							&testprovider.FunctionRunHook{
+								AfterRunMethod: func(_ context.Context, req function.AfterRunHookRequest) {
+									if req.Duration < 0 {
+										t.Errorf("unexpected negative duration: %s", req.Duration)
+									}
+
+									req.Duration = 0
+									gotAfter = append(gotAfter, req)
+								},
+								BeforeRunMethod: func(_ context.Context, req function.BeforeRunHookRequest) {
+									gotBefore = append(gotBefore, req)
+								},
+							},
+						}
+					},
+				},
+			}
+
+			for i := 0; i < testCase.calls; i++ {
+				server.CallFunction(context.Background(), &fwserver.CallFunctionRequest{
+					Arguments: testCase.arguments,
+					Function: &testprovider.Function{
+						RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+							resp.Error = function.ConcatFuncErrors(resp.Error, testCase.runError)
+							resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, "result"))
+						},
+					},
+					FunctionDefinition: testCase.definition,
+					FunctionName:       "testfunction",
+				}, &fwserver.CallFunctionResponse{})
+			}
+
+			if diff := cmp.Diff(gotBefore, testCase.expectedBefore); diff != "" {
+				t.Errorf("unexpected BeforeRun difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotAfter, testCase.expectedAfter); diff != "" {
+				t.Errorf("unexpected AfterRun difference: %s", diff)
+			}
+
+			if runHooksCalls != 1 {
+				t.Errorf("expected 1 FunctionRunHooks call, got %d", runHooksCalls)
+			}
+		})
+	}
+}
@@ -33,6 +33,8 @@ func (s *Server) CloseEphemeralResource(ctx context.Context, req *CloseEphemeral
 		return
 	}
 
+	defer recoverPanic(ctx, "CloseEphemeralResource", &resp.Diagnostics, panicRedactMessage{})
+
 	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
 
@@ -6,27 +6,43 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/clientcapability"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
 // ConfigureProvider implements the framework server ConfigureProvider RPC.
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	logging.FrameworkTrace(ctx, "Calling provider defined Provider Configure")
+	if req == nil {
+		req = &provider.ConfigureRequest{}
+	}
+
+	configureReq := *req
+
+	defer recoverPanic(ctx, "ConfigureProvider", &resp.Diagnostics, &configureReq.Config)
+
+	config, diags := ProviderConfigDefaultEnv(ctx, configureReq.Config)
 
-	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
-	} else {
-		s.Provider.Configure(ctx, provider.ConfigureRequest{}, resp)
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
 	}
 
+	configureReq.Config = config
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider Configure")
+
+	s.Provider.Configure(ctx, configureReq, resp)
+
 	logging.FrameworkTrace(ctx, "Called provider defined Provider Configure")
 
 	if resp.Deferred != nil {
 		if !req.ClientCapabilities.DeferralAllowed {
-			resp.Diagnostics.AddError("Invalid Deferred Provider Response",
-				"Provider configured a deferred response for all resources and data sources but the Terraform request "+
-					"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.")
+			resp.Diagnostics.Append(clientcapability.DeferredActions.UnsupportedError(
+				"Provider configured a deferred response for all resources and data sources but the Terraform request " +
+					"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers.",
+			))
 			return
 		}
 
@@ -34,8 +50,14 @@ func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureR
 			"all associated resources and data sources will automatically return a deferred response.")
 	}
 
+	s.clearPlanStore()
 	s.deferred = resp.Deferred
+	s.configureClientCapabilities = req.ClientCapabilities
+	s.terraformVersion = req.TerraformVersion
 	s.DataSourceConfigureData = resp.DataSourceData
 	s.ResourceConfigureData = resp.ResourceData
 	s.EphemeralResourceConfigureData = resp.EphemeralResourceData
+	s.disabledDataSources = resp.DisabledDataSources
+	s.disabledResources = resp.DisabledResources
+	s.suppressedWarnings = resp.SuppressedWarnings
 }
@@ -11,8 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -204,8 +206,11 @@ func TestServerConfigureProvider(t *testing.T) {
 			request: &provider.ConfigureRequest{},
 			expectedResponse: &provider.ConfigureResponse{
 				Diagnostics: diag.Diagnostics{
-					diag.NewErrorDiagnostic("Invalid Deferred Provider Response",
-						"Provider configured a deferred response for all resources and data sources but the Terraform request "+
+					diag.NewErrorDiagnostic("Unsupported Terraform Capability",
+						"The Terraform client initiating this request does not support deferred actions, which this provider operation uses. "+
+							"Support for deferred actions requires Terraform 1.9 or later. "+
+							"Deferred actions are experimental and must be enabled in Terraform.\n\n"+
+							"Provider configured a deferred response for all resources and data sources but the Terraform request "+
 							"did not indicate support for deferred actions. This is an issue with the provider and should be reported to the provider developers."),
 				},
 				Deferred: &provider.Deferred{
@@ -256,3 +261,209 @@ func TestServerConfigureProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestServerConfigureProvider_Disabled(t *testing.T) {
+	t.Parallel()
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{
+			ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+				resp.DisabledDataSources = map[string]string{
+					"test_beta_data_source": "Set enable_beta to true in the provider configuration.",
+				}
+				resp.DisabledResources = map[string]string{
+					"test_beta_resource": "",
+				}
+			},
+		},
+	}
+
+	server.ConfigureProvider(context.Background(), &provider.ConfigureRequest{}, &provider.ConfigureResponse{})
+
+	testCases := map[string]struct {
+		enabled  func(context.Context, string) diag.Diagnostics
+		typeName string
+		expected diag.Diagnostics
+	}{
+		"data-source-enabled": {
+			enabled:  server.DataSourceEnabled,
+			typeName: "test_data_source",
+		},
+		"data-source-disabled": {
+			enabled:  server.DataSourceEnabled,
+			typeName: "test_beta_data_source",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Data Source Not Enabled",
+					"The \"test_beta_data_source\" data source is not enabled by the provider configuration.\n\n"+
+						"Set enable_beta to true in the provider configuration.",
+				),
+			},
+		},
+		"resource-enabled": {
+			enabled:  server.ResourceEnabled,
+			typeName: "test_resource",
+		},
+		"resource-disabled": {
+			enabled:  server.ResourceEnabled,
+			typeName: "test_beta_resource",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Not Enabled",
+					"The \"test_beta_resource\" resource is not enabled by the provider configuration.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.enabled(context.Background(), testCase.typeName)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerConfigureProvider_DisabledRequests(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "test-id"),
+	})
+
+	testNull := tftypes.NewValue(testType, nil)
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{
+			ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+				resp.DisabledDataSources = map[string]string{
+					"test_data_source": "",
+				}
+				resp.DisabledResources = map[string]string{
+					"test_resource": "",
+				}
+			},
+		},
+	}
+
+	server.ConfigureProvider(context.Background(), &provider.ConfigureRequest{}, &provider.ConfigureResponse{})
+
+	testDataSourceDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Data Source Not Enabled",
+			"The \"test_data_source\" data source is not enabled by the provider configuration.",
+		),
+	}
+
+	testResourceDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Resource Not Enabled",
+			"The \"test_resource\" resource is not enabled by the provider configuration.",
+		),
+	}
+
+	testCases := map[string]struct {
+		call     func() diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"ImportResourceState": {
+			call: func() diag.Diagnostics {
+				resp := &fwserver.ImportResourceStateResponse{}
+
+				server.ImportResourceState(context.Background(), &fwserver.ImportResourceStateRequest{
+					EmptyState: tfsdk.State{Raw: testNull, Schema: testSchema},
+					ID:         "test-id",
+					Resource:   &testprovider.Resource{},
+					TypeName:   "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expected: testResourceDiags,
+		},
+		"PlanResourceChange-create": {
+			call: func() diag.Diagnostics {
+				resp := &fwserver.PlanResourceChangeResponse{}
+
+				server.PlanResourceChange(context.Background(), &fwserver.PlanResourceChangeRequest{
+					Config:           &tfsdk.Config{Raw: testValue, Schema: testSchema},
+					PriorState:       &tfsdk.State{Raw: testNull, Schema: testSchema},
+					ProposedNewState: &tfsdk.Plan{Raw: testValue, Schema: testSchema},
+					ResourceSchema:   testSchema,
+					Resource:         &testprovider.Resource{},
+					TypeName:         "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expected: testResourceDiags,
+		},
+		"PlanResourceChange-destroy": {
+			call: func() diag.Diagnostics {
+				resp := &fwserver.PlanResourceChangeResponse{}
+
+				server.PlanResourceChange(context.Background(), &fwserver.PlanResourceChangeRequest{
+					Config:           &tfsdk.Config{Raw: testNull, Schema: testSchema},
+					PriorState:       &tfsdk.State{Raw: testValue, Schema: testSchema},
+					ProposedNewState: &tfsdk.Plan{Raw: testNull, Schema: testSchema},
+					ResourceSchema:   testSchema,
+					Resource:         &testprovider.Resource{},
+					TypeName:         "test_resource",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+		},
+		"ReadDataSource": {
+			call: func() diag.Diagnostics {
+				resp := &fwserver.ReadDataSourceResponse{}
+
+				server.ReadDataSource(context.Background(), &fwserver.ReadDataSourceRequest{
+					Config:           &tfsdk.Config{Raw: testValue, Schema: testSchema},
+					DataSourceSchema: testSchema,
+					DataSource:       &testprovider.DataSource{},
+					TypeName:         "test_data_source",
+				}, resp)
+
+				return resp.Diagnostics
+			},
+			expected: testDataSourceDiags,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.call()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
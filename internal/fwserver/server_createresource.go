@@ -20,12 +20,13 @@ import (
 // CreateResourceRequest is the framework server request for a create request
 // with the ApplyResourceChange RPC.
 type CreateResourceRequest struct {
-	Config         *tfsdk.Config
-	PlannedPrivate *privatestate.Data
-	PlannedState   *tfsdk.Plan
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	Config           *tfsdk.Config
+	PlannedPrivate   *privatestate.Data
+	PlannedState     *tfsdk.Plan
+	ProviderMeta     *tfsdk.Config
+	ResourceSchema   fwschema.Schema
+	Resource         resource.Resource
+	ResourceBehavior resource.ResourceBehavior
 }
 
 // CreateResourceResponse is the framework server response for a create request
@@ -77,13 +78,14 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 
 	privateProviderData := privatestate.EmptyProviderData(ctx)
 
-	createResp := resource.CreateResponse{
+	initialCreateResp := resource.CreateResponse{
 		State: tfsdk.State{
 			Schema: req.ResourceSchema,
 			Raw:    nullSchemaData,
 		},
 		Private: privateProviderData,
 	}
+	createResp := initialCreateResp
 
 	if req.Config != nil {
 		createReq.Config = *req.Config
@@ -97,9 +99,13 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		createReq.ProviderMeta = *req.ProviderMeta
 	}
 
-	logging.FrameworkTrace(ctx, "Calling provider defined Resource Create")
-	req.Resource.Create(ctx, createReq, &createResp)
-	logging.FrameworkTrace(ctx, "Called provider defined Resource Create")
+	callResourceMethod(ctx, req.ResourceBehavior, "Create", func(ctx context.Context) diag.Diagnostics {
+		createResp = initialCreateResp
+		createResp.Private = initialCreateResp.Private.Copy()
+		req.Resource.Create(ctx, createReq, &createResp)
+
+		return createResp.Diagnostics
+	})
 
 	resp.Diagnostics = createResp.Diagnostics
 	resp.NewState = &createResp.State
@@ -156,11 +162,11 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		return
 	}
 
-	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
-		return
-	}
+	if !semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to semantic equality")
 
-	logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+		resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	}
 
-	resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	resp.Diagnostics.Append(PlannedStateConsistency(ctx, req.ResourceSchema, "Create", req.PlannedState.Raw, resp.NewState.Raw)...)
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -16,8 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/retry"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -352,6 +355,129 @@ func TestServerCreateResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-diagnostics-transient-error-retry": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: func() resource.Resource {
+					var attempts int
+
+					return &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							attempts++
+
+							resp.Diagnostics.AddWarning("warning summary", fmt.Sprintf("attempt %d", attempts))
+
+							if attempts < 3 {
+								resp.Diagnostics.Append(retry.NewTransientErrorDiagnostic("error summary", "error detail"))
+
+								return
+							}
+
+							resp.Diagnostics.Append(resp.State.Set(ctx, testSchemaData{
+								TestComputed: types.StringValue("test-state-value"),
+								TestRequired: types.StringValue("test-plannedstate-value"),
+							})...)
+						},
+					}
+				}(),
+				ResourceBehavior: resource.ResourceBehavior{
+					TransientErrorRetry: retry.Config{
+						MaxAttempts: 5,
+						MinDelay:    time.Millisecond,
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"attempt 3",
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-diagnostics-transient-error-retry-disabled": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				ResourceSchema: testSchema,
+				Resource: func() resource.Resource {
+					var attempts int
+
+					return &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							attempts++
+
+							resp.Diagnostics.Append(retry.NewTransientErrorDiagnostic("error summary", fmt.Sprintf("attempt %d", attempts)))
+						},
+					}
+				}(),
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					retry.NewTransientErrorDiagnostic(
+						"error summary",
+						"attempt 1",
+					),
+				},
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-diagnostics-transient-error-retry-exhausted": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				ResourceSchema: testSchema,
+				Resource: func() resource.Resource {
+					var attempts int
+
+					return &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							attempts++
+
+							resp.Diagnostics.Append(retry.NewTransientErrorDiagnostic("error summary", fmt.Sprintf("attempt %d", attempts)))
+						},
+					}
+				}(),
+				ResourceBehavior: resource.ResourceBehavior{
+					TransientErrorRetry: retry.Config{
+						MaxAttempts: 2,
+						MinDelay:    time.Millisecond,
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					retry.NewTransientErrorDiagnostic(
+						"error summary",
+						"attempt 2",
+					),
+				},
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-diagnostics-semantic-equality": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -433,6 +559,42 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-inconsistent": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.Append(resp.State.Set(ctx, testSchemaData{
+							TestComputed: types.StringValue("test-computed-value"),
+							TestRequired: types.StringValue("test-newstate-value"),
+						})...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Create", `tftypes.String<"test-plannedstate-value">`, `tftypes.String<"test-newstate-value">`),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-newstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-newstate-null": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -545,6 +707,61 @@ func TestServerCreateResource(t *testing.T) {
 				},
 			},
 		},
+		"response-private-transient-error-retry": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: func() resource.Resource {
+					var attempts int
+
+					return &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							attempts++
+
+							if attempts == 1 {
+								resp.Diagnostics.Append(resp.Private.SetKey(ctx, "failedKey", []byte(`{"failed": true}`))...)
+								resp.Diagnostics.Append(retry.NewTransientErrorDiagnostic("error summary", "error detail"))
+
+								return
+							}
+
+							var data testSchemaData
+
+							// Prevent missing resource state error diagnostic
+							resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+							resp.Diagnostics.Append(resp.Private.SetKey(ctx, "providerKeyOne", []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`))...)
+						},
+					}
+				}(),
+				ResourceBehavior: resource.ResourceBehavior{
+					TransientErrorRetry: retry.Config{
+						MaxAttempts: 2,
+						MinDelay:    time.Millisecond,
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				Private: &privatestate.Data{
+					Provider: testProviderData,
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -19,11 +19,12 @@ import (
 // DeleteResourceRequest is the framework server request for a delete request
 // with the ApplyResourceChange RPC.
 type DeleteResourceRequest struct {
-	PlannedPrivate *privatestate.Data
-	PriorState     *tfsdk.State
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	PlannedPrivate   *privatestate.Data
+	PriorState       *tfsdk.State
+	ProviderMeta     *tfsdk.Config
+	ResourceSchema   fwschema.Schema
+	Resource         resource.Resource
+	ResourceBehavior resource.ResourceBehavior
 }
 
 // DeleteResourceResponse is the framework server response for a delete request
@@ -96,9 +97,16 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		resp.Private = req.PlannedPrivate
 	}
 
-	logging.FrameworkTrace(ctx, "Calling provider defined Resource Delete")
-	req.Resource.Delete(ctx, deleteReq, &deleteResp)
-	logging.FrameworkTrace(ctx, "Called provider defined Resource Delete")
+	initialDeleteResp := deleteResp
+
+	callResourceMethod(ctx, req.ResourceBehavior, "Delete", func(ctx context.Context) diag.Diagnostics {
+		deleteResp = initialDeleteResp
+		deleteResp.Private = initialDeleteResp.Private.Copy()
+		deleteReq.Private = deleteResp.Private
+		req.Resource.Delete(ctx, deleteReq, &deleteResp)
+
+		return deleteResp.Diagnostics
+	})
 
 	if !deleteResp.Diagnostics.HasError() {
 		logging.FrameworkTrace(ctx, "No provider defined Delete errors detected, ensuring State and Private are cleared")
@@ -96,13 +96,13 @@ func (s *Server) EphemeralResourceFuncs(ctx context.Context) (map[string]func()
 }
 
 // EphemeralResourceMetadatas returns a slice of EphemeralResourceMetadata for the GetMetadata
-// RPC.
+// RPC, ordered by type name so the response is stable across calls.
 func (s *Server) EphemeralResourceMetadatas(ctx context.Context) ([]EphemeralResourceMetadata, diag.Diagnostics) {
 	ephemeralResourceFuncs, diags := s.EphemeralResourceFuncs(ctx)
 
 	ephemeralResourceMetadatas := make([]EphemeralResourceMetadata, 0, len(ephemeralResourceFuncs))
 
-	for typeName := range ephemeralResourceFuncs {
+	for _, typeName := range sortedKeys(ephemeralResourceFuncs) {
 		ephemeralResourceMetadatas = append(ephemeralResourceMetadatas, EphemeralResourceMetadata{
 			TypeName: typeName,
 		})
@@ -167,7 +167,8 @@ func (s *Server) EphemeralResourceSchemas(ctx context.Context) (map[string]fwsch
 
 	ephemeralResourceFuncs, diags := s.EphemeralResourceFuncs(ctx)
 
-	for typeName, ephemeralResourceFunc := range ephemeralResourceFuncs {
+	for _, typeName := range sortedKeys(ephemeralResourceFuncs) {
+		ephemeralResourceFunc := ephemeralResourceFuncs[typeName]
 		ephemeralResource := ephemeralResourceFunc()
 
 		schemaReq := ephemeral.SchemaRequest{}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ function.Caller = &functionCaller{}
+
+// FunctionCaller returns the function.Caller for calling the provider-defined
+// functions of the provider from schema validators and defaults.
+func (s *Server) FunctionCaller() function.Caller {
+	return &functionCaller{
+		server: s,
+	}
+}
+
+// functionCaller implements function.Caller by calling functions through the
+// framework server, so function run hooks and result caching apply.
+type functionCaller struct {
+	server *Server
+}
+
+// Call implements function.Caller.
+func (c *functionCaller) Call(ctx context.Context, name string, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	functionImpl, funcErr := c.server.Function(ctx, name)
+
+	if funcErr != nil {
+		return nil, funcErr
+	}
+
+	definition, funcErr := c.server.FunctionDefinition(ctx, name)
+
+	if funcErr != nil {
+		return nil, funcErr
+	}
+
+	if !definition.Pure {
+		return nil, function.NewFuncError(
+			fmt.Sprintf("Function Not Pure: The function %q can only be called by the provider if its definition sets Pure to true. ", name) +
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+	}
+
+	argumentsData, funcErr := functionCallerArgumentsData(ctx, name, definition, arguments)
+
+	if funcErr != nil {
+		return nil, funcErr
+	}
+
+	req := &CallFunctionRequest{
+		Arguments:          argumentsData,
+		Function:           functionImpl,
+		FunctionDefinition: definition,
+		FunctionName:       name,
+	}
+	resp := &CallFunctionResponse{}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Function from provider logic", map[string]interface{}{logging.KeyFunctionName: name})
+	c.server.CallFunction(ctx, req, resp)
+	logging.FrameworkTrace(ctx, "Called provider defined Function from provider logic", map[string]interface{}{logging.KeyFunctionName: name})
+
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	return resp.Result.Value(), nil
+}
+
+// functionCallerArgumentsData returns the function.ArgumentsData for the
+// given argument values, converting each value into its parameter type.
+// Variadic argument values are collected into a tuple, matching the arguments
+// data of the CallFunction RPC.
+func functionCallerArgumentsData(ctx context.Context, name string, definition function.Definition, arguments []attr.Value) (function.ArgumentsData, *function.FuncError) {
+	if len(arguments) < len(definition.Parameters) || (definition.VariadicParameter == nil && len(arguments) > len(definition.Parameters)) {
+		return function.NewArgumentsData(nil), function.NewFuncError(
+			fmt.Sprintf("Invalid Function Arguments: The function %q was called with an unexpected number of arguments. ", name) +
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+				fmt.Sprintf("Expected function arguments: %d\n", len(definition.Parameters)) +
+				fmt.Sprintf("Given function arguments: %d", len(arguments)),
+		)
+	}
+
+	argumentValues := make([]attr.Value, 0, len(definition.Parameters)+1)
+	variadicTypes := make([]attr.Type, 0, len(arguments)-len(definition.Parameters))
+	variadicValues := make([]attr.Value, 0, len(arguments)-len(definition.Parameters))
+
+	for position, argument := range arguments {
+		parameter := definition.VariadicParameter
+
+		if position < len(definition.Parameters) {
+			parameter = definition.Parameters[position]
+		}
+
+		parameterType := parameter.GetType()
+
+		value, err := functionCallerArgumentValue(ctx, parameterType, argument)
+
+		if err != nil {
+			return function.NewArgumentsData(nil), function.NewArgumentFuncError(
+				int64(position),
+				fmt.Sprintf("Invalid Function Argument: The function %q was called with an invalid argument. ", name)+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Argument position %d: %s", position, err),
+			)
+		}
+
+		if position >= len(definition.Parameters) {
+			variadicTypes = append(variadicTypes, parameterType)
+			variadicValues = append(variadicValues, value)
+
+			continue
+		}
+
+		argumentValues = append(argumentValues, value)
+	}
+
+	if definition.VariadicParameter != nil {
+		variadicValue, diags := basetypes.NewTupleValue(variadicTypes, variadicValues)
+
+		if funcErr := function.FuncErrorFromDiags(ctx, diags); funcErr != nil {
+			return function.NewArgumentsData(nil), funcErr
+		}
+
+		argumentValues = append(argumentValues, variadicValue)
+	}
+
+	return function.NewArgumentsData(argumentValues), nil
+}
+
+// functionCallerArgumentValue returns the argument value converted into the
+// parameter type.
+func functionCallerArgumentValue(ctx context.Context, parameterType attr.Type, argument attr.Value) (attr.Value, error) {
+	if parameterType == nil {
+		return nil, fmt.Errorf("parameter type missing")
+	}
+
+	if argument == nil {
+		return nil, fmt.Errorf("argument value missing")
+	}
+
+	tfValue, err := argument.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert argument value: %w", err)
+	}
+
+	if !tfValue.Type().UsableAs(parameterType.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got: %s", parameterType, argument.Type(ctx))
+	}
+
+	value, err := parameterType.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert argument value to parameter type: %w", err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestServerFunctionCaller(t *testing.T) {
+	t.Parallel()
+
+	testProvider := &testprovider.ProviderWithFunctions{
+		FunctionsMethod: func(_ context.Context) []func() function.Function {
+			return []func() function.Function{
+				func() function.Function {
+					return &testprovider.Function{
+						DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+							resp.Definition = function.Definition{
+								Parameters: []function.Parameter{
+									function.StringParameter{},
+								},
+								Pure:   true,
+								Return: function.StringReturn{},
+							}
+						},
+						MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+							resp.Name = "upper"
+						},
+						RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+							var arg0 string
+
+							resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg0))
+							resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.ToUpper(arg0)))
+						},
+					}
+				},
+				func() function.Function {
+					return &testprovider.Function{
+						DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+							resp.Definition = function.Definition{
+								Pure:              true,
+								Return:            function.StringReturn{},
+								VariadicParameter: function.StringParameter{},
+							}
+						},
+						MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+							resp.Name = "join"
+						},
+						RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+							var arg0 []string
+
+							resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg0))
+							resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(arg0, ",")))
+						},
+					}
+				},
+				func() function.Function {
+					return &testprovider.Function{
+						DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+							resp.Definition = function.Definition{
+								Return: function.StringReturn{},
+							}
+						},
+						MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+							resp.Name = "impure"
+						},
+					}
+				},
+				func() function.Function {
+					return &testprovider.Function{
+						DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+							resp.Definition = function.Definition{
+								Pure:   true,
+								Return: function.StringReturn{},
+							}
+						},
+						MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+							resp.Name = "error"
+						},
+						RunMethod: func(_ context.Context, _ function.RunRequest, resp *function.RunResponse) {
+							resp.Error = function.NewFuncError("test error")
+						},
+					}
+				},
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		name          string
+		arguments     []attr.Value
+		expected      attr.Value
+		expectedError *function.FuncError
+	}{
+		"result": {
+			name:      "upper",
+			arguments: []attr.Value{basetypes.NewStringValue("test")},
+			expected:  basetypes.NewStringValue("TEST"),
+		},
+		"result-variadic": {
+			name: "join",
+			arguments: []attr.Value{
+				basetypes.NewStringValue("one"),
+				basetypes.NewStringValue("two"),
+			},
+			expected: basetypes.NewStringValue("one,two"),
+		},
+		"result-variadic-empty": {
+			name:     "join",
+			expected: basetypes.NewStringValue(""),
+		},
+		"function-error": {
+			name:          "error",
+			expectedError: function.NewFuncError("test error"),
+		},
+		"function-not-found": {
+			name:          "missing",
+			expectedError: function.NewFuncError(`Function Not Found: No function named "missing" was found in the provider.`),
+		},
+		"function-not-pure": {
+			name: "impure",
+			expectedError: function.NewFuncError(
+				`Function Not Pure: The function "impure" can only be called by the provider if its definition sets Pure to true. ` +
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			),
+		},
+		"arguments-count": {
+			name: "upper",
+			expectedError: function.NewFuncError(
+				`Invalid Function Arguments: The function "upper" was called with an unexpected number of arguments. ` +
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+					"Expected function arguments: 1\n" +
+					"Given function arguments: 0",
+			),
+		},
+		"arguments-type": {
+			name:      "upper",
+			arguments: []attr.Value{basetypes.NewBoolValue(true)},
+			expectedError: function.NewArgumentFuncError(
+				0,
+				`Invalid Function Argument: The function "upper" was called with an invalid argument. `+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"Argument position 0: expected basetypes.StringType, got: basetypes.BoolType",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: testProvider,
+			}
+
+			got, err := server.FunctionCaller().Call(context.Background(), testCase.name, testCase.arguments...)
+
+			if diff := cmp.Diff(err, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+		})
+	}
+}
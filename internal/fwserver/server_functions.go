@@ -6,13 +6,23 @@ package fwserver
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
+// functionResultsMaxEntries is the maximum number of cached Function results
+// for functions with a Pure definition, which bounds the memory used by
+// long-lived provider servers, such as in debug mode.
+const functionResultsMaxEntries = 1000
+
 // Function returns the Function for a given name.
 func (s *Server) Function(ctx context.Context, name string) (function.Function, *function.FuncError) {
 	functionFuncs, diags := s.FunctionFuncs(ctx)
@@ -82,7 +92,8 @@ func (s *Server) FunctionDefinitions(ctx context.Context) (map[string]function.D
 
 	functionFuncs, diags := s.FunctionFuncs(ctx)
 
-	for name, functionFunc := range functionFuncs {
+	for _, name := range sortedKeys(functionFuncs) {
+		functionFunc := functionFuncs[name]
 		functionImpl := functionFunc()
 
 		definitionReq := function.DefinitionRequest{}
@@ -179,13 +190,13 @@ func (s *Server) FunctionFuncs(ctx context.Context) (map[string]func() function.
 }
 
 // FunctionMetadatas returns a slice of FunctionMetadata for the GetMetadata
-// RPC.
+// RPC, ordered by name so the response is stable across calls.
 func (s *Server) FunctionMetadatas(ctx context.Context) ([]FunctionMetadata, diag.Diagnostics) {
 	functionFuncs, diags := s.FunctionFuncs(ctx)
 
 	functionMetadatas := make([]FunctionMetadata, 0, len(functionFuncs))
 
-	for name := range functionFuncs {
+	for _, name := range sortedKeys(functionFuncs) {
 		functionMetadatas = append(functionMetadatas, FunctionMetadata{
 			Name: name,
 		})
@@ -193,3 +204,164 @@ func (s *Server) FunctionMetadatas(ctx context.Context) ([]FunctionMetadata, dia
 
 	return functionMetadatas, diags
 }
+
+// functionResult returns the cached result for the given cache key, if any.
+func (s *Server) functionResult(cacheKey string) (function.ResultData, bool) {
+	s.functionResultsMutex.RLock()
+	defer s.functionResultsMutex.RUnlock()
+
+	result, ok := s.functionResults[cacheKey]
+
+	return result, ok
+}
+
+// setFunctionResult caches the result for the given cache key. If the cache
+// is full, an arbitrary cached result is removed first.
+func (s *Server) setFunctionResult(cacheKey string, result function.ResultData) {
+	s.functionResultsMutex.Lock()
+	defer s.functionResultsMutex.Unlock()
+
+	if s.functionResults == nil {
+		s.functionResults = make(map[string]function.ResultData)
+	}
+
+	if _, ok := s.functionResults[cacheKey]; !ok && len(s.functionResults) >= functionResultsMaxEntries {
+		for key := range s.functionResults {
+			delete(s.functionResults, key)
+
+			break
+		}
+	}
+
+	s.functionResults[cacheKey] = result
+}
+
+// functionResultCacheKey returns a string uniquely identifying a function call
+// by its name and argument values. The argument values are encoded exactly,
+// including their types, as the MessagePack encoding of a tuple with dynamic
+// elements. An empty string is returned if any argument cannot be converted
+// or encoded, in which case the result should not be cached.
+func functionResultCacheKey(ctx context.Context, name string, arguments function.ArgumentsData) string {
+	values := functionArgumentValues(ctx, arguments)
+	tfValues := make([]tftypes.Value, 0, len(values))
+	tfTypes := make([]tftypes.Type, 0, len(values))
+
+	for _, value := range values {
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil {
+			return ""
+		}
+
+		tfValues = append(tfValues, tfValue)
+		tfTypes = append(tfTypes, tftypes.DynamicPseudoType)
+	}
+
+	tupleType := tftypes.Tuple{ElementTypes: tfTypes}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(tupleType, tftypes.NewValue(tupleType, tfValues))
+
+	if err != nil {
+		return ""
+	}
+
+	return strconv.Quote(name) + string(dynamicValue.MsgPack)
+}
+
+// FunctionRunHooks returns the provider-defined function run hooks, if the
+// provider implements the ProviderWithFunctionRunHooks interface. The hooks
+// are cached on first use.
+func (s *Server) FunctionRunHooks(ctx context.Context) []function.RunHook {
+	s.functionRunHooksMutex.Lock()
+	defer s.functionRunHooksMutex.Unlock()
+
+	if s.functionRunHooksFetched {
+		return s.functionRunHooks
+	}
+
+	s.functionRunHooksFetched = true
+
+	providerWithFunctionRunHooks, ok := s.Provider.(provider.ProviderWithFunctionRunHooks)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider FunctionRunHooks")
+	s.functionRunHooks = providerWithFunctionRunHooks.FunctionRunHooks(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider FunctionRunHooks")
+
+	return s.functionRunHooks
+}
+
+// functionArgumentValues returns all argument values, in position order.
+func functionArgumentValues(ctx context.Context, arguments function.ArgumentsData) []attr.Value {
+	var values []attr.Value
+
+	for position := 0; ; position++ {
+		var value attr.Value
+
+		if funcErr := arguments.GetArgument(ctx, position, &value); funcErr != nil {
+			return values
+		}
+
+		values = append(values, value)
+	}
+}
+
+// functionRunHookArguments returns the function run hook metadata for all
+// argument values, in position order. Argument values are never included.
+func functionRunHookArguments(ctx context.Context, arguments function.ArgumentsData) []function.RunHookArgument {
+	var hookArguments []function.RunHookArgument
+
+	for _, value := range functionArgumentValues(ctx, arguments) {
+		hookArgument := function.RunHookArgument{
+			Type:      value.Type(ctx),
+			IsNull:    value.IsNull(),
+			IsUnknown: value.IsUnknown(),
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err == nil && tfValue.IsKnown() && !tfValue.IsNull() {
+			hookArgument.Size = tftypesValueSize(tfValue)
+		}
+
+		hookArguments = append(hookArguments, hookArgument)
+	}
+
+	return hookArguments
+}
+
+// tftypesValueSize returns the number of bytes in a string value or the number
+// of elements or attributes in a collection, object, or tuple value.
+func tftypesValueSize(value tftypes.Value) int {
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			return 0
+		}
+
+		return len(s)
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return 0
+		}
+
+		return len(elements)
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return 0
+		}
+
+		return len(elements)
+	default:
+		return 0
+	}
+}
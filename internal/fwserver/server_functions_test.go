@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionResultCacheKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a             []attr.Value
+		b             []attr.Value
+		expectedEqual bool
+	}{
+		"equal": {
+			a:             []attr.Value{basetypes.NewStringValue("a"), basetypes.NewNumberValue(big.NewFloat(1.5))},
+			b:             []attr.Value{basetypes.NewStringValue("a"), basetypes.NewNumberValue(big.NewFloat(1.5))},
+			expectedEqual: true,
+		},
+		"number-precision": {
+			a: []attr.Value{basetypes.NewNumberValue(big.NewFloat(1.00000000001))},
+			b: []attr.Value{basetypes.NewNumberValue(big.NewFloat(1.00000000002))},
+		},
+		"string-separator": {
+			a: []attr.Value{basetypes.NewStringValue("a,b"), basetypes.NewStringValue("c")},
+			b: []attr.Value{basetypes.NewStringValue("a"), basetypes.NewStringValue("b,c")},
+		},
+		"string-null": {
+			a: []attr.Value{basetypes.NewStringValue("")},
+			b: []attr.Value{basetypes.NewStringNull()},
+		},
+		"type": {
+			a: []attr.Value{basetypes.NewStringValue("1")},
+			b: []attr.Value{basetypes.NewNumberValue(big.NewFloat(1))},
+		},
+		"argument-count": {
+			a: []attr.Value{basetypes.NewStringValue("a")},
+			b: []attr.Value{basetypes.NewStringValue("a"), basetypes.NewStringValue("a")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			keyA := functionResultCacheKey(context.Background(), "test", function.NewArgumentsData(testCase.a))
+			keyB := functionResultCacheKey(context.Background(), "test", function.NewArgumentsData(testCase.b))
+
+			if keyA == "" || keyB == "" {
+				t.Fatalf("unexpected empty cache key")
+			}
+
+			if got := keyA == keyB; got != testCase.expectedEqual {
+				t.Errorf("expected equal keys %t, got %t", testCase.expectedEqual, got)
+			}
+		})
+	}
+}
+
+func TestServerSetFunctionResult_MaxEntries(t *testing.T) {
+	t.Parallel()
+
+	server := &Server{}
+
+	for i := 0; i < functionResultsMaxEntries+10; i++ {
+		server.setFunctionResult(fmt.Sprintf("key-%d", i), function.NewResultData(basetypes.NewStringValue("test")))
+	}
+
+	if got := len(server.functionResults); got != functionResultsMaxEntries {
+		t.Errorf("expected %d cached results, got %d", functionResultsMaxEntries, got)
+	}
+
+	if _, ok := server.functionResult(fmt.Sprintf("key-%d", functionResultsMaxEntries+9)); !ok {
+		t.Errorf("expected latest result to be cached")
+	}
+}
@@ -14,7 +14,8 @@ import (
 type GetMetadataRequest struct{}
 
 // GetMetadataResponse is the framework server response for the
-// GetMetadata RPC.
+// GetMetadata RPC. Data sources, ephemeral resources, and resources are
+// ordered by type name and functions are ordered by name.
 type GetMetadataResponse struct {
 	DataSources        []DataSourceMetadata
 	Diagnostics        diag.Diagnostics
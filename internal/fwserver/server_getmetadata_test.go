@@ -5,7 +5,6 @@ package fwserver_test
 
 import (
 	"context"
-	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -655,23 +654,6 @@ func TestServerGetMetadata(t *testing.T) {
 			response := &fwserver.GetMetadataResponse{}
 			testCase.server.GetMetadata(context.Background(), testCase.request, response)
 
-			// Prevent false positives with random map access in testing
-			sort.Slice(response.DataSources, func(i int, j int) bool {
-				return response.DataSources[i].TypeName < response.DataSources[j].TypeName
-			})
-
-			sort.Slice(response.EphemeralResources, func(i int, j int) bool {
-				return response.EphemeralResources[i].TypeName < response.EphemeralResources[j].TypeName
-			})
-
-			sort.Slice(response.Functions, func(i int, j int) bool {
-				return response.Functions[i].Name < response.Functions[j].Name
-			})
-
-			sort.Slice(response.Resources, func(i int, j int) bool {
-				return response.Resources[i].TypeName < response.Resources[j].TypeName
-			})
-
 			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
@@ -181,6 +181,78 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"datasourceschemas-deprecation": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							func() datasource.DataSource {
+								return &testprovider.DataSource{
+									SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+										resp.Schema = datasourceschema.Schema{
+											Attributes: map[string]datasourceschema.Attribute{
+												"test": datasourceschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_data_source"
+										resp.Deprecation = datasource.Deprecation{
+											Message:             "Refer to the upgrade guide for details.",
+											ReplacementTypeName: "test_new_data_source",
+											RemovalVersion:      "2.0.0",
+										}
+									},
+								}
+							},
+							func() datasource.DataSource {
+								return &testprovider.DataSource{
+									SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+										resp.Schema = datasourceschema.Schema{
+											DeprecationMessage: "Schema message takes precedence.",
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_other_data_source"
+										resp.Deprecation = datasource.Deprecation{
+											RemovalVersion: "2.0.0",
+										}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Attributes: map[string]datasourceschema.Attribute{
+							"test": datasourceschema.StringAttribute{
+								Required: true,
+							},
+						},
+						DeprecationMessage: "The test_data_source data source type is deprecated and will be removed in version 2.0.0. " +
+							"Use the test_new_data_source data source type instead. Refer to the upgrade guide for details.",
+					},
+					"test_other_data_source": datasourceschema.Schema{
+						DeprecationMessage: "Schema message takes precedence.",
+					},
+				},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
 		"datasourceschemas-duplicate-type-name": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -1074,6 +1146,161 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"resourceschemas-deprecated-type-names": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = resourceschema.Schema{
+											Attributes: map[string]resourceschema.Attribute{
+												"test": resourceschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+										resp.DeprecatedTypeNames = []string{"test_old_resource"}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_old_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test": resourceschema.StringAttribute{
+								Required: true,
+							},
+						},
+						DeprecationMessage: "The test_old_resource resource type is deprecated. Use the test_resource resource type instead. " +
+							"Existing resources can be migrated with a moved block in Terraform 1.8 and later.",
+					},
+					"test_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test": resourceschema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"resourceschemas-deprecated-type-names-duplicate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = resourceschema.Schema{}
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+										resp.DeprecatedTypeNames = []string{"test_resource"}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Resource Type Defined",
+						"The test_resource resource type name was returned for multiple resources. "+
+							"Resource type names, including deprecated type names, must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider: providerschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"resourceschemas-deprecation": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = resourceschema.Schema{
+											Attributes: map[string]resourceschema.Attribute{
+												"test": resourceschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+										resp.DeprecatedTypeNames = []string{"test_old_resource"}
+										resp.Deprecation = resource.Deprecation{
+											ReplacementTypeName: "test_new_resource",
+										}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_old_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test": resourceschema.StringAttribute{
+								Required: true,
+							},
+						},
+						DeprecationMessage: "The test_old_resource resource type is deprecated. Use the test_new_resource resource type instead.",
+					},
+					"test_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test": resourceschema.StringAttribute{
+								Required: true,
+							},
+						},
+						DeprecationMessage: "The test_resource resource type is deprecated. Use the test_new_resource resource type instead.",
+					},
+				},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
 		"resourceschemas-duplicate-type-name": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// hookOperation is an operation which has before and after hook points.
+type hookOperation int
+
+const (
+	hookOperationValidate hookOperation = iota
+	hookOperationPlan
+	hookOperationApply
+	hookOperationRead
+)
+
+// Hooks returns the provider-defined hooks, if the provider implements the
+// ProviderWithHooks interface.
+func (s *Server) Hooks(ctx context.Context) provider.Hooks {
+	providerWithHooks, ok := s.Provider.(provider.ProviderWithHooks)
+
+	if !ok {
+		return provider.Hooks{}
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider Hooks")
+	hooks := providerWithHooks.Hooks(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider Hooks")
+
+	return hooks
+}
+
+// beforeResourceHooks calls the provider-defined before hooks of the
+// operation for the resource and returns a function which calls the after
+// hooks with the final operation diagnostics.
+func (s *Server) beforeResourceHooks(ctx context.Context, operation hookOperation, r resource.Resource) func(diag.Diagnostics) {
+	before, after, ok := s.operationHooks(ctx, operation)
+
+	if !ok || r == nil {
+		return func(diag.Diagnostics) {}
+	}
+
+	metadataResp := resource.MetadataResponse{}
+
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: s.ProviderTypeName(ctx)}, &metadataResp)
+
+	return callHooks(ctx, operation, before, after, provider.HookRequest{
+		ResourceTypeName: metadataResp.TypeName,
+	})
+}
+
+// beforeDataSourceHooks calls the provider-defined before hooks of the
+// operation for the data source and returns a function which calls the after
+// hooks with the final operation diagnostics.
+func (s *Server) beforeDataSourceHooks(ctx context.Context, operation hookOperation, d datasource.DataSource) func(diag.Diagnostics) {
+	before, after, ok := s.operationHooks(ctx, operation)
+
+	if !ok || d == nil {
+		return func(diag.Diagnostics) {}
+	}
+
+	metadataResp := datasource.MetadataResponse{}
+
+	d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: s.ProviderTypeName(ctx)}, &metadataResp)
+
+	return callHooks(ctx, operation, before, after, provider.HookRequest{
+		DataSourceTypeName: metadataResp.TypeName,
+	})
+}
+
+// operationHooks returns the provider-defined before and after hook
+// functions of the operation. The returned bool is false if there are none.
+func (s *Server) operationHooks(ctx context.Context, operation hookOperation) ([]provider.HookFunc, []provider.HookFunc, bool) {
+	hooks := s.Hooks(ctx)
+
+	var before, after []provider.HookFunc
+
+	switch operation {
+	case hookOperationValidate:
+		before, after = hooks.BeforeValidate, hooks.AfterValidate
+	case hookOperationPlan:
+		before, after = hooks.BeforePlan, hooks.AfterPlan
+	case hookOperationApply:
+		before, after = hooks.BeforeApply, hooks.AfterApply
+	case hookOperationRead:
+		before, after = hooks.BeforeRead, hooks.AfterRead
+	}
+
+	return before, after, len(before) > 0 || len(after) > 0
+}
+
+// callHooks calls the before hook functions and returns a function which
+// calls the after hook functions.
+func callHooks(ctx context.Context, operation hookOperation, before []provider.HookFunc, after []provider.HookFunc, req provider.HookRequest) func(diag.Diagnostics) {
+	beforePoint, afterPoint := operation.hookPoints()
+
+	beforeReq := req
+	beforeReq.HookPoint = beforePoint
+
+	for _, hookFunc := range before {
+		logging.FrameworkTrace(ctx, "Calling provider defined hook", map[string]interface{}{logging.KeyHookPoint: string(beforePoint)})
+		hookFunc(ctx, beforeReq)
+		logging.FrameworkTrace(ctx, "Called provider defined hook", map[string]interface{}{logging.KeyHookPoint: string(beforePoint)})
+	}
+
+	start := time.Now()
+
+	return func(diags diag.Diagnostics) {
+		afterReq := req
+		afterReq.HookPoint = afterPoint
+		afterReq.Diagnostics = diags
+		afterReq.Duration = time.Since(start)
+
+		for _, hookFunc := range after {
+			logging.FrameworkTrace(ctx, "Calling provider defined hook", map[string]interface{}{logging.KeyHookPoint: string(afterPoint)})
+			hookFunc(ctx, afterReq)
+			logging.FrameworkTrace(ctx, "Called provider defined hook", map[string]interface{}{logging.KeyHookPoint: string(afterPoint)})
+		}
+	}
+}
+
+// hookPoints returns the before and after hook points of the operation.
+func (o hookOperation) hookPoints() (provider.HookPoint, provider.HookPoint) {
+	switch o {
+	case hookOperationValidate:
+		return provider.HookPointBeforeValidate, provider.HookPointAfterValidate
+	case hookOperationPlan:
+		return provider.HookPointBeforePlan, provider.HookPointAfterPlan
+	case hookOperationApply:
+		return provider.HookPointBeforeApply, provider.HookPointAfterApply
+	default:
+		return provider.HookPointBeforeRead, provider.HookPointAfterRead
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestServerHooks(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testNullValue := tftypes.NewValue(testType, nil)
+
+	testResourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testDataSourceSchema := datasourceschema.Schema{
+		Attributes: map[string]datasourceschema.Attribute{
+			"test": datasourceschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testResource := &testprovider.Resource{
+		CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, struct {
+				Test string `tfsdk:"test"`
+			}{Test: "test-value"})...)
+		},
+		MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+			resp.TypeName = req.ProviderTypeName + "_resource"
+		},
+		ReadMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+			resp.Diagnostics.AddError("test summary", "test detail")
+		},
+	}
+
+	testDataSource := &testprovider.DataSource{
+		MetadataMethod: func(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+			resp.TypeName = req.ProviderTypeName + "_data_source"
+		},
+		ReadMethod: func(_ context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+			resp.State.Raw = req.Config.Raw
+		},
+	}
+
+	testCases := map[string]struct {
+		call     func(context.Context, *fwserver.Server)
+		expected []provider.HookRequest
+	}{
+		"validate-resource": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ValidateResourceConfig(ctx, &fwserver.ValidateResourceConfigRequest{
+					Config:   &tfsdk.Config{Raw: testValue, Schema: testResourceSchema},
+					Resource: testResource,
+				}, &fwserver.ValidateResourceConfigResponse{})
+			},
+			expected: []provider.HookRequest{
+				{
+					HookPoint:        provider.HookPointBeforeValidate,
+					ResourceTypeName: "test_resource",
+				},
+				{
+					HookPoint:        provider.HookPointAfterValidate,
+					ResourceTypeName: "test_resource",
+				},
+			},
+		},
+		"validate-data-source": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ValidateDataSourceConfig(ctx, &fwserver.ValidateDataSourceConfigRequest{
+					Config:     &tfsdk.Config{Raw: testValue, Schema: testDataSourceSchema},
+					DataSource: testDataSource,
+				}, &fwserver.ValidateDataSourceConfigResponse{})
+			},
+			expected: []provider.HookRequest{
+				{
+					HookPoint:          provider.HookPointBeforeValidate,
+					DataSourceTypeName: "test_data_source",
+				},
+				{
+					HookPoint:          provider.HookPointAfterValidate,
+					DataSourceTypeName: "test_data_source",
+				},
+			},
+		},
+		"plan-resource": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.PlanResourceChange(ctx, &fwserver.PlanResourceChangeRequest{
+					Config:           &tfsdk.Config{Raw: testValue, Schema: testResourceSchema},
+					PriorState:       &tfsdk.State{Raw: testNullValue, Schema: testResourceSchema},
+					ProposedNewState: &tfsdk.Plan{Raw: testValue, Schema: testResourceSchema},
+					ResourceSchema:   testResourceSchema,
+					Resource:         testResource,
+				}, &fwserver.PlanResourceChangeResponse{})
+			},
+			expected: []provider.HookRequest{
+				{
+					HookPoint:        provider.HookPointBeforePlan,
+					ResourceTypeName: "test_resource",
+				},
+				{
+					HookPoint:        provider.HookPointAfterPlan,
+					ResourceTypeName: "test_resource",
+				},
+			},
+		},
+		"apply-resource": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+					Config:         &tfsdk.Config{Raw: testValue, Schema: testResourceSchema},
+					PlannedState:   &tfsdk.Plan{Raw: testValue, Schema: testResourceSchema},
+					PriorState:     &tfsdk.State{Raw: testNullValue, Schema: testResourceSchema},
+					ResourceSchema: testResourceSchema,
+					Resource:       testResource,
+				}, &fwserver.ApplyResourceChangeResponse{})
+			},
+			expected: []provider.HookRequest{
+				{
+					HookPoint:        provider.HookPointBeforeApply,
+					ResourceTypeName: "test_resource",
+				},
+				{
+					HookPoint:        provider.HookPointAfterApply,
+					ResourceTypeName: "test_resource",
+				},
+			},
+		},
+		"read-resource-diagnostics": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ReadResource(ctx, &fwserver.ReadResourceRequest{
+					CurrentState: &tfsdk.State{Raw: testValue, Schema: testResourceSchema},
+					Resource:     testResource,
+				}, &fwserver.ReadResourceResponse{})
+			},
+			expected: []provider.HookRequest{
+				{
+					HookPoint:        provider.HookPointBeforeRead,
+					ResourceTypeName: "test_resource",
+				},
+				{
+					HookPoint:        provider.HookPointAfterRead,
+					ResourceTypeName: "test_resource",
+					Diagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+		},
+		"read-data-source": {
+			call: func(ctx context.Context, s *fwserver.Server) {
+				s.ReadDataSource(ctx, &fwserver.ReadDataSourceRequest{
+					Config:           &tfsdk.Config{Raw: testValue, Schema: testDataSourceSchema},
+					DataSourceSchema: testDataSourceSchema,
+					DataSource:       testDataSource,
+				}, &fwserver.ReadDataSourceResponse{})
+			},
+			expected: []provider.HookRequest{
+				{
+					HookPoint:          provider.HookPointBeforeRead,
+					DataSourceTypeName: "test_data_source",
+				},
+				{
+					HookPoint:          provider.HookPointAfterRead,
+					DataSourceTypeName: "test_data_source",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []provider.HookRequest
+
+			hookFunc := func(_ context.Context, req provider.HookRequest) {
+				if req.Duration < 0 {
+					t.Errorf("unexpected negative duration: %s", req.Duration)
+				}
+
+				req.Duration = 0
+				got = append(got, req)
+			}
+
+			server := &fwserver.Server{
+				Provider: &testprovider.ProviderWithHooks{
+					Provider: &testprovider.Provider{
+						MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+							resp.TypeName = "test"
+						},
+					},
+					HooksMethod: func(_ context.Context) provider.Hooks {
+						return provider.Hooks{
+							BeforeValidate: []provider.HookFunc{hookFunc},
+							AfterValidate:  []provider.HookFunc{hookFunc},
+							BeforePlan:     []provider.HookFunc{hookFunc},
+							AfterPlan:      []provider.HookFunc{hookFunc},
+							BeforeApply:    []provider.HookFunc{hookFunc},
+							AfterApply:     []provider.HookFunc{hookFunc},
+							BeforeRead:     []provider.HookFunc{hookFunc},
+							AfterRead:      []provider.HookFunc{hookFunc},
+						}
+					},
+				},
+			}
+
+			testCase.call(context.Background(), server)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -55,6 +56,35 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	resp.Diagnostics.Append(s.ResourceEnabled(ctx, req.TypeName)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	finishAudit := s.startResourceAudit(ctx, provider.AuditOperationImport, req.Resource)
+	transform := s.resourceStateTransform(ctx, req.Resource)
+
+	defer func() {
+		finishAudit(auditResult{
+			Diagnostics: resp.Diagnostics,
+			Deferred:    resp.Deferred != nil,
+			Identity:    req.ID,
+		})
+	}()
+
+	defer func() {
+		for i, importedResource := range resp.ImportedResources {
+			state, diags := transform.encodeState(ctx, &importedResource.State)
+
+			resp.Diagnostics.Append(diags...)
+
+			resp.ImportedResources[i].State = *state
+		}
+	}()
+
+	defer recoverPanic(ctx, "ImportResourceState", &resp.Diagnostics)
+
 	if s.deferred != nil {
 		logging.FrameworkDebug(ctx, "Provider has deferred response configured, automatically returning deferred response.",
 			map[string]interface{}{
@@ -73,8 +103,12 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 			},
 		}
 		resp.Deferred = &resource.Deferred{
-			Reason: resource.DeferredReason(s.deferred.Reason),
+			Reason:      resource.DeferredReason(s.deferred.Reason),
+			Explanation: s.deferred.Explanation,
 		}
+
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "resource", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+
 		return
 	}
 
@@ -158,6 +192,11 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 	}
 
 	resp.Deferred = importResp.Deferred
+
+	if resp.Deferred != nil {
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "resource", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+	}
+
 	resp.ImportedResources = []ImportedResource{
 		{
 			State:    importResp.State,
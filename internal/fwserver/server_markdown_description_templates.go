@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// MarkdownDescriptionTemplates returns true if the provider implements the
+// ProviderWithMarkdownDescriptionTemplates interface and enabled rendering
+// attribute MarkdownDescription templates.
+func (s *Server) MarkdownDescriptionTemplates(ctx context.Context) bool {
+	providerWithTemplates, ok := s.Provider.(provider.ProviderWithMarkdownDescriptionTemplates)
+
+	if !ok {
+		return false
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider MarkdownDescriptionTemplates")
+	enabled := providerWithTemplates.MarkdownDescriptionTemplates(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider MarkdownDescriptionTemplates")
+
+	return enabled
+}
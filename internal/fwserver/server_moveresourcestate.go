@@ -91,6 +91,41 @@ func (s *Server) MoveResourceState(ctx context.Context, req *MoveResourceStateRe
 		return
 	}
 
+	// Automatically move state from a deprecated resource type name, since
+	// the schema is the same.
+	currentTypeName, ok := s.ResourceDeprecatedTypeName(ctx, req.SourceTypeName)
+
+	if ok && currentTypeName == req.TargetTypeName && req.SourceSchemaVersion == req.TargetResourceSchema.GetVersion() {
+		logging.FrameworkDebug(ctx, "Moving resource state from deprecated resource type name")
+
+		rawStateValue, err := req.SourceRawState.UnmarshalWithOpts(req.TargetResourceSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{})
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Move Resource State",
+				"An unexpected error occurred while moving the resource state from the deprecated resource type. "+
+					"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+					"Source Resource Type: "+req.SourceTypeName+"\n"+
+					"Target Resource Type: "+req.TargetTypeName+"\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		resp.TargetState = &tfsdk.State{
+			Raw:    rawStateValue,
+			Schema: req.TargetResourceSchema,
+		}
+		resp.TargetPrivate = req.SourcePrivate
+
+		if resp.TargetPrivate == nil {
+			resp.TargetPrivate = privatestate.EmptyData(ctx)
+		}
+
+		return
+	}
+
 	resourceWithMoveState, ok := req.TargetResource.(resource.ResourceWithMoveState)
 
 	if !ok {
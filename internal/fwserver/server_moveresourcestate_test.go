@@ -111,6 +111,55 @@ func TestServerMoveResourceState(t *testing.T) {
 				},
 			},
 		},
+		"request-SourceTypeName-deprecated": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource"
+										resp.DeprecatedTypeNames = []string{"test_old_resource"}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourcePrivate: &privatestate.Data{
+					Provider: privatestate.MustProviderData(ctx, privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKey": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
+					})),
+				},
+				SourceRawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"optional_attribute": nil,
+					"required_attribute": "true",
+				}),
+				SourceTypeName:       "test_old_resource",
+				TargetResource:       &testprovider.Resource{},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_resource",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: &privatestate.Data{
+					Provider: privatestate.MustProviderData(ctx, privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKey": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
+					})),
+				},
+				TargetState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"request-SourceProviderAddress": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
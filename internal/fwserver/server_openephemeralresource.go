@@ -41,6 +41,8 @@ func (s *Server) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralRe
 		return
 	}
 
+	defer recoverPanic(ctx, "OpenEphemeralResource", &resp.Diagnostics, panicRedactMessage{})
+
 	if s.deferred != nil {
 		logging.FrameworkDebug(ctx, "Provider has deferred response configured, automatically returning deferred response.",
 			map[string]interface{}{
@@ -55,8 +57,12 @@ func (s *Server) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralRe
 			Schema: req.EphemeralResourceSchema,
 		}
 		resp.Deferred = &ephemeral.Deferred{
-			Reason: ephemeral.DeferredReason(s.deferred.Reason),
+			Reason:      ephemeral.DeferredReason(s.deferred.Reason),
+			Explanation: s.deferred.Explanation,
 		}
+
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "ephemeral resource", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+
 		return
 	}
 
@@ -106,6 +112,10 @@ func (s *Server) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralRe
 	resp.RenewAt = openResp.RenewAt
 	resp.Deferred = openResp.Deferred
 
+	if resp.Deferred != nil {
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "ephemeral resource", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+	}
+
 	resp.Private = privatestate.EmptyData(ctx)
 	if openResp.Private != nil {
 		resp.Private.Provider = openResp.Private
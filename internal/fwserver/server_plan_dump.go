@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+const (
+	// EnvPlanDumpDir is the environment variable for the directory where
+	// PlanResourceChange debug dumps are written. Dumps are only written when
+	// both this and EnvPlanDumpResource are set.
+	EnvPlanDumpDir = "TF_PLUGIN_FRAMEWORK_PLAN_DUMP_DIR"
+
+	// EnvPlanDumpResource is the environment variable for the resource type
+	// name whose PlanResourceChange debug dumps are written.
+	EnvPlanDumpResource = "TF_PLUGIN_FRAMEWORK_PLAN_DUMP_RESOURCE"
+
+	// planDumpSensitive replaces the values of Sensitive attributes.
+	planDumpSensitive = "<sensitive>"
+
+	// planDumpUnknown replaces unknown values.
+	planDumpUnknown = "<unknown>"
+)
+
+// Phases of PlanResourceChange recorded in the debug dump.
+const (
+	planDumpPhaseDefaults                  = "defaults"
+	planDumpPhaseMarkComputedUnknown       = "mark_computed_unknown"
+	planDumpPhaseAttributePlanModification = "attribute_plan_modification"
+	planDumpPhaseResourcePlanModification  = "resource_plan_modification"
+	planDumpPhasePlannedStateReferences    = "planned_state_references"
+	planDumpPhaseTaintedReplacement        = "tainted_replacement"
+)
+
+// planDump records a PlanResourceChange request, the planned state after
+// each planning phase, and the response, which are written as JSON to a
+// file for offline analysis. The methods of a nil planDump do nothing.
+type planDump struct {
+	dir    string
+	schema fwschema.Schema
+
+	ResourceType string          `json:"resource_type"`
+	Request      planDumpRequest `json:"request"`
+	Phases       []planDumpPhase `json:"phases"`
+	Response     planDumpResult  `json:"response"`
+}
+
+// planDumpRequest is the recorded PlanResourceChange request.
+type planDumpRequest struct {
+	Config           any `json:"config"`
+	PriorState       any `json:"prior_state"`
+	ProposedNewState any `json:"proposed_new_state"`
+}
+
+// planDumpPhase is the recorded planned state after a planning phase.
+type planDumpPhase struct {
+	Name          string `json:"name"`
+	AttributePath string `json:"attribute_path,omitempty"`
+	PlannedState  any    `json:"planned_state"`
+}
+
+// planDumpResult is the recorded PlanResourceChange response.
+type planDumpResult struct {
+	PlannedState    any                  `json:"planned_state"`
+	RequiresReplace []string             `json:"requires_replace"`
+	Diagnostics     []planDumpDiagnostic `json:"diagnostics"`
+}
+
+// planDumpDiagnostic is a recorded response diagnostic. The summary and
+// detail are not recorded, since they are not redacted and providers often
+// include values, such as Sensitive attribute values, in them.
+type planDumpDiagnostic struct {
+	Severity      string `json:"severity"`
+	AttributePath string `json:"attribute_path,omitempty"`
+}
+
+// startPlanDump returns the debug dump for a PlanResourceChange request of
+// the resource, or nil if debug dumps are not enabled for the resource type.
+func (s *Server) startPlanDump(ctx context.Context, req *PlanResourceChangeRequest) *planDump {
+	dir := os.Getenv(EnvPlanDumpDir)
+	resourceType := os.Getenv(EnvPlanDumpResource)
+
+	if dir == "" || resourceType == "" || req.Resource == nil || req.ResourceSchema == nil {
+		return nil
+	}
+
+	metadataResp := resource.MetadataResponse{}
+
+	req.Resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: s.ProviderTypeName(ctx)}, &metadataResp)
+
+	if metadataResp.TypeName != resourceType {
+		return nil
+	}
+
+	dump := &planDump{
+		dir:          dir,
+		schema:       req.ResourceSchema,
+		ResourceType: resourceType,
+		Phases:       []planDumpPhase{},
+	}
+
+	if req.Config != nil {
+		dump.Request.Config = dump.value(ctx, req.Config.Raw)
+	}
+
+	if req.PriorState != nil {
+		dump.Request.PriorState = dump.value(ctx, req.PriorState.Raw)
+	}
+
+	if req.ProposedNewState != nil {
+		dump.Request.ProposedNewState = dump.value(ctx, req.ProposedNewState.Raw)
+	}
+
+	return dump
+}
+
+// phase records the planned state after a planning phase. The attribute path
+// is set for phases of a single attribute.
+func (d *planDump) phase(ctx context.Context, name string, attributePath string, raw tftypes.Value) {
+	if d == nil {
+		return
+	}
+
+	d.Phases = append(d.Phases, planDumpPhase{
+		Name:          name,
+		AttributePath: attributePath,
+		PlannedState:  d.value(ctx, raw),
+	})
+}
+
+// phaseState records the planned state after a planning phase.
+func (d *planDump) phaseState(ctx context.Context, name string, state *tfsdk.State) {
+	if d == nil || state == nil {
+		return
+	}
+
+	d.phase(ctx, name, "", state.Raw)
+}
+
+// write records the response and writes the debug dump to a new file in the
+// directory. Errors are logged, rather than returned as diagnostics, so
+// debug dumps never affect the response.
+func (d *planDump) write(ctx context.Context, resp *PlanResourceChangeResponse) {
+	if d == nil {
+		return
+	}
+
+	if resp.PlannedState != nil {
+		d.Response.PlannedState = d.value(ctx, resp.PlannedState.Raw)
+	}
+
+	d.Response.RequiresReplace = []string{}
+
+	for _, p := range resp.RequiresReplace {
+		d.Response.RequiresReplace = append(d.Response.RequiresReplace, p.String())
+	}
+
+	d.Response.Diagnostics = []planDumpDiagnostic{}
+
+	for _, diagnostic := range resp.Diagnostics {
+		dumpDiagnostic := planDumpDiagnostic{
+			Severity: diagnostic.Severity().String(),
+		}
+
+		if diagnosticWithPath, ok := diagnostic.(diag.DiagnosticWithPath); ok {
+			dumpDiagnostic.AttributePath = diagnosticWithPath.Path().String()
+		}
+
+		d.Response.Diagnostics = append(d.Response.Diagnostics, dumpDiagnostic)
+	}
+
+	content, err := json.MarshalIndent(d, "", "  ")
+
+	if err != nil {
+		logging.FrameworkWarn(ctx, "Unable to encode PlanResourceChange debug dump", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return
+	}
+
+	file, err := os.CreateTemp(d.dir, d.ResourceType+"-PlanResourceChange-*.json")
+
+	if err != nil {
+		logging.FrameworkWarn(ctx, "Unable to create PlanResourceChange debug dump file", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return
+	}
+
+	defer file.Close()
+
+	if _, err := file.Write(content); err != nil {
+		logging.FrameworkWarn(ctx, "Unable to write PlanResourceChange debug dump file", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return
+	}
+
+	logging.FrameworkDebug(ctx, "Wrote PlanResourceChange debug dump file", map[string]interface{}{"path": file.Name()})
+}
+
+// value returns the JSON representation of the resource data, with unknown
+// values and the values of Sensitive attributes replaced with placeholders.
+func (d *planDump) value(ctx context.Context, raw tftypes.Value) any {
+	return planDumpValue(ctx, d.schema, tftypes.NewAttributePath(), raw)
+}
+
+// planDumpValue returns the JSON representation of the value at the path.
+func planDumpValue(ctx context.Context, schema fwschema.Schema, tfPath *tftypes.AttributePath, value tftypes.Value) any {
+	if value.Type() == nil {
+		return nil
+	}
+
+	if !value.IsKnown() {
+		return planDumpUnknown
+	}
+
+	if value.IsNull() {
+		return nil
+	}
+
+	if len(tfPath.Steps()) > 0 {
+		if _, ok := tfPath.LastStep().(tftypes.AttributeName); ok {
+			attribute, err := schema.AttributeAtTerraformPath(ctx, tfPath)
+
+			if err == nil && attribute.IsSensitive() {
+				return planDumpSensitive
+			}
+		}
+	}
+
+	switch {
+	case value.Type().Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+
+		_ = value.As(&attributes)
+
+		result := make(map[string]any, len(attributes))
+
+		for name, attributeValue := range attributes {
+			result[name] = planDumpValue(ctx, schema, tfPath.WithAttributeName(name), attributeValue)
+		}
+
+		return result
+	case value.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+
+		_ = value.As(&elements)
+
+		result := make(map[string]any, len(elements))
+
+		for key, element := range elements {
+			result[key] = planDumpValue(ctx, schema, tfPath.WithElementKeyString(key), element)
+		}
+
+		return result
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		_ = value.As(&elements)
+
+		result := make([]any, 0, len(elements))
+
+		for index, element := range elements {
+			elementPath := tfPath.WithElementKeyInt(index)
+
+			if value.Type().Is(tftypes.Set{}) {
+				elementPath = tfPath.WithElementKeyValue(element)
+			}
+
+			result = append(result, planDumpValue(ctx, schema, elementPath, element))
+		}
+
+		return result
+	case value.Type().Is(tftypes.Number):
+		var number big.Float
+
+		_ = value.As(&number)
+
+		return json.Number(number.Text('g', -1))
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+
+		_ = value.As(&b)
+
+		return b
+	case value.Type().Is(tftypes.String):
+		var s string
+
+		_ = value.As(&s)
+
+		return s
+	}
+
+	return value.String()
+}
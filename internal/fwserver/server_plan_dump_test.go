@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+//nolint:paralleltest // t.Setenv is not compatible with t.Parallel
+func TestServerPlanResourceChange_PlanDump(t *testing.T) {
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":       tftypes.String,
+			"name":     tftypes.String,
+			"password": tftypes.String,
+			"port":     tftypes.Number,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = req.StateValue
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"password": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddAttributeWarning(
+								req.Path,
+								"Weak Password",
+								"The password "+req.PlanValue.ValueString()+" is weak.",
+							)
+						},
+					},
+				},
+				Sensitive: true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(8080),
+			},
+		},
+	}
+
+	testValue := func(id, name, password, port interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, id),
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"password": tftypes.NewValue(tftypes.String, password),
+			"port":     tftypes.NewValue(tftypes.Number, port),
+		})
+	}
+
+	testDump := func(id, name, password, port interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"id":       id,
+			"name":     name,
+			"password": password,
+			"port":     port,
+		}
+	}
+
+	testCases := map[string]struct {
+		resourceType string
+		expectedDump map[string]interface{}
+	}{
+		"disabled": {
+			resourceType: "test_other",
+		},
+		"enabled": {
+			resourceType: "test_resource",
+			expectedDump: map[string]interface{}{
+				"resource_type": "test_resource",
+				"request": map[string]interface{}{
+					"config":             testDump(nil, "test-new-name", "<sensitive>", nil),
+					"prior_state":        testDump("test-id", "test-name", "<sensitive>", 8080.0),
+					"proposed_new_state": testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+				},
+				"phases": []interface{}{
+					map[string]interface{}{
+						"name":          "defaults",
+						"planned_state": testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+					},
+					map[string]interface{}{
+						"name":          "mark_computed_unknown",
+						"planned_state": testDump("<unknown>", "test-new-name", "<sensitive>", 8080.0),
+					},
+					map[string]interface{}{
+						"name":           "attribute_plan_modification",
+						"attribute_path": "id",
+						"planned_state":  testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+					},
+					map[string]interface{}{
+						"name":           "attribute_plan_modification",
+						"attribute_path": "name",
+						"planned_state":  testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+					},
+					map[string]interface{}{
+						"name":           "attribute_plan_modification",
+						"attribute_path": "password",
+						"planned_state":  testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+					},
+					map[string]interface{}{
+						"name":           "attribute_plan_modification",
+						"attribute_path": "port",
+						"planned_state":  testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+					},
+					map[string]interface{}{
+						"name":          "resource_plan_modification",
+						"planned_state": testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+					},
+					map[string]interface{}{
+						"name":          "planned_state_references",
+						"planned_state": testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+					},
+				},
+				"response": map[string]interface{}{
+					"planned_state":    testDump("test-id", "test-new-name", "<sensitive>", 8080.0),
+					"requires_replace": []interface{}{},
+					"diagnostics": []interface{}{
+						map[string]interface{}{
+							"severity":       "Warning",
+							"attribute_path": "password",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			t.Setenv(fwserver.EnvPlanDumpDir, dir)
+			t.Setenv(fwserver.EnvPlanDumpResource, testCase.resourceType)
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			resp := &fwserver.PlanResourceChangeResponse{}
+
+			server.PlanResourceChange(context.Background(), &fwserver.PlanResourceChangeRequest{
+				Config:           &tfsdk.Config{Raw: testValue(nil, "test-new-name", "test-password", nil), Schema: testSchema},
+				PriorState:       &tfsdk.State{Raw: testValue("test-id", "test-name", "test-password", 8080), Schema: testSchema},
+				ProposedNewState: &tfsdk.Plan{Raw: testValue("test-id", "test-new-name", "test-password", 8080), Schema: testSchema},
+				ResourceSchema:   testSchema,
+				Resource: &testprovider.Resource{
+					MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+						resp.TypeName = "test_resource"
+					},
+				},
+			}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+			}
+
+			files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.expectedDump == nil {
+				if len(files) != 0 {
+					t.Fatalf("unexpected dump files: %v", files)
+				}
+
+				return
+			}
+
+			if len(files) != 1 {
+				t.Fatalf("expected one dump file, got: %v", files)
+			}
+
+			content, err := os.ReadFile(files[0])
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if strings.Contains(string(content), "test-password") {
+				t.Errorf("expected sensitive value to be redacted, got: %s", content)
+			}
+
+			var got map[string]interface{}
+
+			if err := json.Unmarshal(content, &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedDump); diff != "" {
+				t.Errorf("unexpected dump difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// currentPlanStore returns the ModifyPlan PlanStore of the current Terraform
+// operation, creating it if necessary.
+func (s *Server) currentPlanStore() *resource.PlanStore {
+	s.planStoreMutex.Lock()
+	defer s.planStoreMutex.Unlock()
+
+	if s.planStore == nil {
+		s.planStore = &resource.PlanStore{}
+	}
+
+	return s.planStore
+}
+
+// clearPlanStore removes the ModifyPlan PlanStore, so later ModifyPlan calls
+// receive an empty store. Terraform configures the provider at the start of
+// each operation, such as a plan or apply, even when the provider server
+// handles many operations. Calls still using the removed store are
+// unaffected.
+func (s *Server) clearPlanStore() {
+	s.planStoreMutex.Lock()
+	defer s.planStoreMutex.Unlock()
+
+	s.planStore = nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestServerCurrentPlanStore(t *testing.T) {
+	t.Parallel()
+
+	server := &Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	planStore := server.currentPlanStore()
+	planStore.Set("test-key", "test-value")
+
+	if got := server.currentPlanStore(); got != planStore {
+		t.Errorf("expected same store within the operation")
+	}
+
+	server.ConfigureProvider(context.Background(), &provider.ConfigureRequest{}, &provider.ConfigureResponse{})
+
+	if _, ok := server.currentPlanStore().Get("test-key"); ok {
+		t.Errorf("expected no value after configuring provider")
+	}
+
+	if value, ok := planStore.Get("test-key"); !ok || value != "test-value" {
+		t.Errorf("expected cleared store to be unaffected, got: %v", value)
+	}
+}
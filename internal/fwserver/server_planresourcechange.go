@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -35,6 +36,10 @@ type PlanResourceChangeRequest struct {
 	ResourceSchema     fwschema.Schema
 	Resource           resource.Resource
 	ResourceBehavior   resource.ResourceBehavior
+
+	// TypeName is the resource type name, which is necessary for checking
+	// whether the resource is enabled by the provider configuration.
+	TypeName string
 }
 
 // PlanResourceChangeResponse is the framework server response for the
@@ -53,6 +58,57 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		return
 	}
 
+	// Destroying disabled resources is permitted, so practitioners can remove
+	// them after they are disabled.
+	if req.ProposedNewState != nil && !req.ProposedNewState.Raw.IsNull() {
+		resp.Diagnostics.Append(s.ResourceEnabled(ctx, req.TypeName)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	afterHooks := s.beforeResourceHooks(ctx, hookOperationPlan, req.Resource)
+	finishAudit := s.startResourceAudit(ctx, provider.AuditOperationPlan, req.Resource)
+	transform := s.resourceStateTransform(ctx, req.Resource)
+	dump := s.startPlanDump(ctx, req)
+
+	defer func() {
+		afterHooks(resp.Diagnostics)
+		finishAudit(auditResult{
+			Diagnostics: resp.Diagnostics,
+			Deferred:    resp.Deferred != nil,
+			State:       resp.PlannedState,
+		})
+	}()
+
+	defer dump.write(ctx, resp)
+
+	defer func() {
+		var diags diag.Diagnostics
+
+		resp.PlannedState, diags = transform.encodeState(ctx, resp.PlannedState)
+
+		resp.Diagnostics.Append(diags...)
+	}()
+
+	defer recoverPanic(ctx, "PlanResourceChange", &resp.Diagnostics, req.Config, req.PriorState, req.ProposedNewState)
+
+	priorState, diags := transform.decodeState(ctx, req.PriorState)
+
+	resp.Diagnostics.Append(diags...)
+
+	proposedNewState, diags := transform.decodePlan(ctx, req.ProposedNewState)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	req.PriorState = priorState
+	req.ProposedNewState = proposedNewState
+
 	// Skip ModifyPlan for automatic deferrals with proposed new state as a best effort for PlannedState
 	// unless ProviderDeferredBehavior.EnablePlanModification is true.
 	if s.deferred != nil && !req.ResourceBehavior.ProviderDeferred.EnablePlanModification {
@@ -65,9 +121,12 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		resp.PlannedState = planToState(*req.ProposedNewState)
 		resp.PlannedPrivate = req.PriorPrivate
 		resp.Deferred = &resource.Deferred{
-			Reason: resource.DeferredReason(s.deferred.Reason),
+			Reason:      resource.DeferredReason(s.deferred.Reason),
+			Explanation: s.deferred.Explanation,
 		}
 
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "resource", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+
 		return
 	}
 
@@ -144,7 +203,7 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 			TerraformValue: resp.PlannedState.Raw,
 		}
 
-		diags := data.TransformDefaults(ctx, req.Config.Raw)
+		diags := data.TransformDefaults(ctx, req.Config.Raw, s.FunctionCaller())
 
 		resp.Diagnostics.Append(diags...)
 
@@ -153,6 +212,8 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 
 		resp.PlannedState.Raw = data.TerraformValue
+
+		dump.phaseState(ctx, planDumpPhaseDefaults, resp.PlannedState)
 	}
 
 	// After ensuring there are proposed changes, mark any computed attributes
@@ -164,51 +225,24 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	//
 	// We only do this if there's a plan to modify; otherwise, it
 	// represents a resource being deleted and there's no point.
-	if !resp.PlannedState.Raw.IsNull() && !resp.PlannedState.Raw.Equal(req.PriorState.Raw) {
+	if !resp.PlannedState.Raw.IsNull() && !schemaTerraformValueEqual(ctx, req.ResourceSchema, resp.PlannedState.Raw, req.PriorState.Raw) {
 		// Loop through top level attributes/blocks to individually emit logs
 		// for value changes. This is helpful for troubleshooting unexpected
 		// plan outputs and only needs to be done for resource update plans.
 		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/627
-		if !req.PriorState.Raw.IsNull() {
-			var allPaths, changedPaths path.Paths
-
-			for attrName := range resp.PlannedState.Schema.GetAttributes() {
-				allPaths.Append(path.Root(attrName))
-			}
-
-			for blockName := range resp.PlannedState.Schema.GetBlocks() {
-				allPaths.Append(path.Root(blockName))
-			}
-
-			for _, p := range allPaths {
-				var plannedState, priorState attr.Value
-
-				// This logging is best effort and any errors should not be
-				// returned to practitioners.
-				_ = resp.PlannedState.GetAttribute(ctx, p, &plannedState)
-				_ = req.PriorState.GetAttribute(ctx, p, &priorState)
-
-				// Due to ignoring diagnostics, the value may not be populated.
-				// Prevent the panic and show the path as changed.
-				if plannedState == nil {
-					changedPaths.Append(p)
-
-					continue
-				}
-
-				if plannedState.Equal(priorState) {
+		//
+		// Values are compared without creating attr.Value, so types with
+		// extremely large values can short-circuit the comparison.
+		if plannedAttributes, priorAttributes, ok := terraformObjectAttributes(resp.PlannedState.Raw, req.PriorState.Raw); ok {
+			for _, name := range sortedKeys(plannedAttributes) {
+				if schemaAttributeTerraformValueEqual(ctx, req.ResourceSchema, name, plannedAttributes[name], priorAttributes[name]) {
 					continue
 				}
 
-				changedPaths.Append(p)
-			}
-
-			// Colocate these log entries to not intermix with GetAttribute logging
-			for _, p := range changedPaths {
 				logging.FrameworkDebug(ctx,
 					"Detected value change between proposed new state and prior state",
 					map[string]any{
-						logging.KeyAttributePath: p.String(),
+						logging.KeyAttributePath: path.Root(name).String(),
 					},
 				)
 			}
@@ -232,96 +266,62 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 
 		resp.PlannedState.Raw = modifiedPlan
+
+		dump.phaseState(ctx, planDumpPhaseMarkComputedUnknown, resp.PlannedState)
 	}
 
-	// Execute any schema-based plan modifiers. This allows overwriting
-	// any unknown values.
-	//
-	// We only do this if there's a plan to modify; otherwise, it
-	// represents a resource being deleted and there's no point.
-	if !resp.PlannedState.Raw.IsNull() {
-		modifySchemaPlanReq := ModifySchemaPlanRequest{
-			Config:  *req.Config,
-			Plan:    stateToPlan(*resp.PlannedState),
-			State:   *req.PriorState,
-			Private: resp.PlannedPrivate.Provider,
-		}
+	// Execute any schema-based plan modifiers and resource-level ModifyPlan
+	// method, in the order declared by the resource behavior.
+	var deferred bool
 
-		if req.ProviderMeta != nil {
-			modifySchemaPlanReq.ProviderMeta = *req.ProviderMeta
-		}
+	if req.ResourceBehavior.ModifyPlanBeforeSchema {
+		deferred = s.resourceModifyPlan(ctx, req, resp)
 
-		modifySchemaPlanResp := ModifySchemaPlanResponse{
-			Diagnostics: resp.Diagnostics,
-			Plan:        modifySchemaPlanReq.Plan,
-			Private:     modifySchemaPlanReq.Private,
-		}
+		dump.phaseState(ctx, planDumpPhaseResourcePlanModification, resp.PlannedState)
 
-		SchemaModifyPlan(ctx, req.ResourceSchema, modifySchemaPlanReq, &modifySchemaPlanResp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-		resp.Diagnostics = modifySchemaPlanResp.Diagnostics
-		resp.PlannedState = planToState(modifySchemaPlanResp.Plan)
-		resp.RequiresReplace = append(resp.RequiresReplace, modifySchemaPlanResp.RequiresReplace...)
-		resp.PlannedPrivate.Provider = modifySchemaPlanResp.Private
+		schemaModifyPlan(ctx, req, resp, dump)
+	} else {
+		schemaModifyPlan(ctx, req, resp, dump)
 
 		if resp.Diagnostics.HasError() {
 			return
 		}
+
+		deferred = s.resourceModifyPlan(ctx, req, resp)
+
+		dump.phaseState(ctx, planDumpPhaseResourcePlanModification, resp.PlannedState)
 	}
 
-	// Execute any resource-level ModifyPlan method. This allows
-	// overwriting any unknown values.
-	//
-	// We do this regardless of whether the plan is null or not, because we
-	// want resources to be able to return diagnostics when planning to
-	// delete resources, e.g. to inform practitioners that the resource
-	// _can't_ be deleted in the API and will just be removed from
-	// Terraform's state
-	if resourceWithModifyPlan, ok := req.Resource.(resource.ResourceWithModifyPlan); ok {
-		logging.FrameworkTrace(ctx, "Resource implements ResourceWithModifyPlan")
-
-		modifyPlanReq := resource.ModifyPlanRequest{
-			ClientCapabilities: req.ClientCapabilities,
-			Config:             *req.Config,
-			Plan:               stateToPlan(*resp.PlannedState),
-			State:              *req.PriorState,
-			Private:            resp.PlannedPrivate.Provider,
-		}
+	if resp.Deferred != nil {
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "resource", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+	}
 
-		if req.ProviderMeta != nil {
-			modifyPlanReq.ProviderMeta = *req.ProviderMeta
-		}
+	if deferred {
+		return
+	}
 
-		modifyPlanResp := resource.ModifyPlanResponse{
-			Diagnostics:     resp.Diagnostics,
-			Plan:            modifyPlanReq.Plan,
-			RequiresReplace: path.Paths{},
-			Private:         modifyPlanReq.Private,
-		}
+	// Ensure planned values match the planned values of any attributes
+	// referenced with the References schema field.
+	resp.Diagnostics.Append(PlannedStateReferences(ctx, req.ResourceSchema, *resp.PlannedState)...)
 
-		logging.FrameworkTrace(ctx, "Calling provider defined Resource ModifyPlan")
-		resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
-		logging.FrameworkTrace(ctx, "Called provider defined Resource ModifyPlan")
-
-		resp.Diagnostics = modifyPlanResp.Diagnostics
-		resp.PlannedState = planToState(modifyPlanResp.Plan)
-		resp.RequiresReplace = append(resp.RequiresReplace, modifyPlanResp.RequiresReplace...)
-		resp.PlannedPrivate.Provider = modifyPlanResp.Private
-		resp.Deferred = modifyPlanResp.Deferred
-
-		// Provider deferred response is present, add the deferred response alongside the provider-modified plan
-		if s.deferred != nil {
-			logging.FrameworkDebug(ctx, "Provider has deferred response configured, returning deferred response with modified plan.")
-			// Only set the response to the provider configured deferred reason if there is no resource configured deferred reason
-			if resp.Deferred == nil {
-				resp.Deferred = &resource.Deferred{
-					Reason: resource.DeferredReason(s.deferred.Reason),
-				}
-			} else {
-				logging.FrameworkDebug(ctx, fmt.Sprintf("Resource has deferred reason configured, "+
-					"replacing provider deferred reason: %s with resource deferred reason: %s",
-					s.deferred.Reason.String(), modifyPlanResp.Deferred.Reason.String()))
-			}
+	dump.phaseState(ctx, planDumpPhasePlannedStateReferences, resp.PlannedState)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the resource was marked as tainted during a prior read or update,
+	// plan its replacement.
+	if req.PriorPrivate.IsTainted() && !req.PriorState.Raw.IsNull() && !resp.PlannedState.Raw.IsNull() {
+		PlanTaintedReplacement(ctx, req, resp)
+
+		dump.phaseState(ctx, planDumpPhaseTaintedReplacement, resp.PlannedState)
+
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -340,6 +340,117 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	}
 }
 
+// schemaModifyPlan executes any schema-based plan modifiers. This allows
+// overwriting any unknown values.
+func schemaModifyPlan(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse, dump *planDump) {
+	// We only do this if there's a plan to modify; otherwise, it
+	// represents a resource being deleted and there's no point.
+	if resp.PlannedState.Raw.IsNull() {
+		return
+	}
+
+	modifySchemaPlanReq := ModifySchemaPlanRequest{
+		Config:  *req.Config,
+		Plan:    stateToPlan(*resp.PlannedState),
+		State:   *req.PriorState,
+		Private: resp.PlannedPrivate.Provider,
+
+		planDump: dump,
+	}
+
+	if req.ProviderMeta != nil {
+		modifySchemaPlanReq.ProviderMeta = *req.ProviderMeta
+	}
+
+	if resourceWithPlanDependencies, ok := req.Resource.(resource.ResourceWithPlanDependencies); ok {
+		logging.FrameworkTrace(ctx, "Calling provider defined Resource PlanDependencies")
+		modifySchemaPlanReq.PlanDependencies = resourceWithPlanDependencies.PlanDependencies(ctx)
+		logging.FrameworkTrace(ctx, "Called provider defined Resource PlanDependencies")
+	}
+
+	modifySchemaPlanResp := ModifySchemaPlanResponse{
+		Diagnostics: resp.Diagnostics,
+		Plan:        modifySchemaPlanReq.Plan,
+		Private:     modifySchemaPlanReq.Private,
+	}
+
+	SchemaModifyPlan(ctx, req.ResourceSchema, modifySchemaPlanReq, &modifySchemaPlanResp)
+
+	resp.Diagnostics = modifySchemaPlanResp.Diagnostics
+	resp.PlannedState = planToState(modifySchemaPlanResp.Plan)
+	resp.RequiresReplace = append(resp.RequiresReplace, modifySchemaPlanResp.RequiresReplace...)
+	resp.PlannedPrivate.Provider = modifySchemaPlanResp.Private
+}
+
+// resourceModifyPlan executes any resource-level ModifyPlan method. This
+// allows overwriting any unknown values. It returns true if the response was
+// deferred due to the provider deferred response.
+//
+// We do this regardless of whether the plan is null or not, because we
+// want resources to be able to return diagnostics when planning to
+// delete resources, e.g. to inform practitioners that the resource
+// _can't_ be deleted in the API and will just be removed from
+// Terraform's state
+func (s *Server) resourceModifyPlan(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) bool {
+	resourceWithModifyPlan, ok := req.Resource.(resource.ResourceWithModifyPlan)
+
+	if !ok {
+		return false
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithModifyPlan")
+
+	modifyPlanReq := resource.ModifyPlanRequest{
+		ClientCapabilities: req.ClientCapabilities,
+		Config:             *req.Config,
+		Plan:               stateToPlan(*resp.PlannedState),
+		State:              *req.PriorState,
+		Private:            resp.PlannedPrivate.Provider,
+		PlanStore:          s.currentPlanStore(),
+	}
+
+	if req.ProviderMeta != nil {
+		modifyPlanReq.ProviderMeta = *req.ProviderMeta
+	}
+
+	modifyPlanResp := resource.ModifyPlanResponse{
+		Diagnostics:     resp.Diagnostics,
+		Plan:            modifyPlanReq.Plan,
+		RequiresReplace: path.Paths{},
+		Private:         modifyPlanReq.Private,
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource ModifyPlan")
+	resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource ModifyPlan")
+
+	resp.Diagnostics = modifyPlanResp.Diagnostics
+	resp.PlannedState = planToState(modifyPlanResp.Plan)
+	resp.RequiresReplace = append(resp.RequiresReplace, modifyPlanResp.RequiresReplace...)
+	resp.PlannedPrivate.Provider = modifyPlanResp.Private
+	resp.Deferred = modifyPlanResp.Deferred
+
+	// Provider deferred response is present, add the deferred response alongside the provider-modified plan
+	if s.deferred == nil {
+		return false
+	}
+
+	logging.FrameworkDebug(ctx, "Provider has deferred response configured, returning deferred response with modified plan.")
+	// Only set the response to the provider configured deferred reason if there is no resource configured deferred reason
+	if resp.Deferred == nil {
+		resp.Deferred = &resource.Deferred{
+			Reason:      resource.DeferredReason(s.deferred.Reason),
+			Explanation: s.deferred.Explanation,
+		}
+	} else {
+		logging.FrameworkDebug(ctx, fmt.Sprintf("Resource has deferred reason configured, "+
+			"replacing provider deferred reason: %s with resource deferred reason: %s",
+			s.deferred.Reason.String(), modifyPlanResp.Deferred.Reason.String()))
+	}
+
+	return true
+}
+
 func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
 	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
 		ctx = logging.FrameworkWithAttributePath(ctx, path.String())
@@ -403,6 +514,14 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			return val, nil
 		}
 
+		// Known objects planned for attributes which enable it only have their
+		// nested computed attributes marked as unknown.
+		if a, ok := attribute.(fwschema.NestedAttributeWithPlanKnownObject); ok && a.IsPlanKnownObject() && !val.IsNull() && val.IsKnown() {
+			logging.FrameworkTrace(ctx, "attribute plans a known object, not marking unknown")
+
+			return val, nil
+		}
+
 		switch a := attribute.(type) {
 		case fwschema.AttributeWithBoolDefaultValue:
 			if a.BoolDefaultValue() != nil {
@@ -470,6 +589,77 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 	}
 }
 
+// PlanTaintedReplacement plans the replacement of a resource which was marked
+// as tainted. Terraform only replaces resources when a value requiring
+// replacement changes, so all top level Computed attributes without a
+// configuration value are set to unknown and require replacement.
+func PlanTaintedReplacement(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) {
+	var replacePaths path.Paths
+
+	for _, name := range sortedKeys(req.ResourceSchema.GetAttributes()) {
+		attribute := req.ResourceSchema.GetAttributes()[name]
+
+		if !attribute.IsComputed() {
+			continue
+		}
+
+		attributePath := path.Root(name)
+
+		var configValue attr.Value
+
+		diags := req.Config.GetAttribute(ctx, attributePath, &configValue)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		if !configValue.IsNull() {
+			continue
+		}
+
+		replacePaths.Append(attributePath)
+	}
+
+	if len(replacePaths) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Plan Tainted Resource Replacement",
+			"The Terraform Provider marked the resource as requiring replacement, however the resource schema "+
+				"has no Computed attributes without a configuration value to plan the replacement. "+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"The resource can be manually replaced with the terraform apply -replace option.",
+		)
+
+		return
+	}
+
+	plannedData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionPlan,
+		Schema:         resp.PlannedState.Schema,
+		TerraformValue: resp.PlannedState.Raw,
+	}
+
+	for _, replacePath := range replacePaths {
+		resp.Diagnostics.Append(plannedData.SetUnknownAtPath(ctx, replacePath)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.PlannedState.Raw = plannedData.TerraformValue
+
+	logging.FrameworkDebug(ctx, "Planning replacement of tainted resource")
+
+	resp.RequiresReplace = append(resp.RequiresReplace, replacePaths...)
+
+	resp.Diagnostics.AddWarning(
+		"Resource Tainted",
+		"The Terraform Provider detected the remote resource is irrecoverably degraded, so it is planned for replacement.",
+	)
+}
+
 // NormaliseRequiresReplace sorts and deduplicates the slice of AttributePaths
 // used in the RequiresReplace response field.
 // Sorting is lexical based on the string representation of each AttributePath.
@@ -38,6 +38,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -6340,6 +6341,126 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testPrivateProvider,
 			},
 		},
+		"update-tainted": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorPrivate: &privatestate.Data{
+					Framework: map[string][]byte{
+						".tainted": []byte("true"),
+					},
+					Provider: testEmptyProviderData,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Resource Tainted",
+						"The Terraform Provider detected the remote resource is irrecoverably degraded, so it is planned for replacement.",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: &privatestate.Data{
+					Framework: map[string][]byte{
+						".tainted": []byte("true"),
+					},
+					Provider: testEmptyProviderData,
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test_computed"),
+				},
+			},
+		},
+		"update-tainted-no-computed-attributes": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorPrivate: &privatestate.Data{
+					Framework: map[string][]byte{
+						".tainted": []byte("true"),
+					},
+					Provider: testEmptyProviderData,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Plan Tainted Resource Replacement",
+						"The Terraform Provider marked the resource as requiring replacement, however the resource schema "+
+							"has no Computed attributes without a configuration value to plan the replacement. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"The resource can be manually replaced with the terraform apply -replace option.",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: &privatestate.Data{
+					Framework: map[string][]byte{
+						".tainted": []byte("true"),
+					},
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -15973,3 +16094,619 @@ func TestServerPlanResourceChange_AttributeRoundtrip(t *testing.T) {
 		})
 	}
 }
+
+func TestServerPlanResourceChange_PlanKnownObject(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed":  tftypes.String,
+			"test_default":   tftypes.String,
+			"test_optional":  tftypes.String,
+			"test_preserved": tftypes.String,
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_object":   testObjectType,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := func(planKnownObject bool) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"test_object": schema.SingleNestedAttribute{
+					Attributes: map[string]schema.Attribute{
+						"test_computed": schema.StringAttribute{
+							Computed: true,
+						},
+						"test_default": schema.StringAttribute{
+							Computed: true,
+							Default:  stringdefault.StaticString("test-default-value"),
+						},
+						"test_optional": schema.StringAttribute{
+							Optional: true,
+						},
+						"test_preserved": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Computed:        true,
+					Optional:        true,
+					PlanKnownObject: planKnownObject,
+				},
+				"test_required": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		}
+	}
+
+	testConfigValue := func(required string) tftypes.Value {
+		return tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_object":   tftypes.NewValue(testObjectType, nil),
+			"test_required": tftypes.NewValue(tftypes.String, required),
+		})
+	}
+
+	testPriorStateValue := tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+		"test_object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+			"test_computed":  tftypes.NewValue(tftypes.String, "test-computed-state-value"),
+			"test_default":   tftypes.NewValue(tftypes.String, "test-default-value"),
+			"test_optional":  tftypes.NewValue(tftypes.String, nil),
+			"test_preserved": tftypes.NewValue(tftypes.String, "test-preserved-state-value"),
+		}),
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testCases := map[string]struct {
+		planKnownObject  bool
+		config           tftypes.Value
+		proposedNewState tftypes.Value
+		priorState       tftypes.Value
+		expected         tftypes.Value
+	}{
+		"create": {
+			planKnownObject:  true,
+			config:           testConfigValue("test-config-value"),
+			proposedNewState: testConfigValue("test-config-value"),
+			priorState:       tftypes.NewValue(testSchemaType, nil),
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+					"test_computed":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"test_default":   tftypes.NewValue(tftypes.String, "test-default-value"),
+					"test_optional":  tftypes.NewValue(tftypes.String, nil),
+					"test_preserved": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+			}),
+		},
+		"create-disabled": {
+			config:           testConfigValue("test-config-value"),
+			proposedNewState: testConfigValue("test-config-value"),
+			priorState:       tftypes.NewValue(testSchemaType, nil),
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_object":   tftypes.NewValue(testObjectType, tftypes.UnknownValue),
+				"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+			}),
+		},
+		"update": {
+			planKnownObject: true,
+			config:          testConfigValue("test-new-config-value"),
+			proposedNewState: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+					"test_computed":  tftypes.NewValue(tftypes.String, "test-computed-state-value"),
+					"test_default":   tftypes.NewValue(tftypes.String, "test-default-value"),
+					"test_optional":  tftypes.NewValue(tftypes.String, nil),
+					"test_preserved": tftypes.NewValue(tftypes.String, "test-preserved-state-value"),
+				}),
+				"test_required": tftypes.NewValue(tftypes.String, "test-new-config-value"),
+			}),
+			priorState: testPriorStateValue,
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+					"test_computed":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"test_default":   tftypes.NewValue(tftypes.String, "test-default-value"),
+					"test_optional":  tftypes.NewValue(tftypes.String, nil),
+					"test_preserved": tftypes.NewValue(tftypes.String, "test-preserved-state-value"),
+				}),
+				"test_required": tftypes.NewValue(tftypes.String, "test-new-config-value"),
+			}),
+		},
+		"update-disabled": {
+			config: testConfigValue("test-new-config-value"),
+			proposedNewState: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_object": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+					"test_computed":  tftypes.NewValue(tftypes.String, "test-computed-state-value"),
+					"test_default":   tftypes.NewValue(tftypes.String, "test-default-value"),
+					"test_optional":  tftypes.NewValue(tftypes.String, nil),
+					"test_preserved": tftypes.NewValue(tftypes.String, "test-preserved-state-value"),
+				}),
+				"test_required": tftypes.NewValue(tftypes.String, "test-new-config-value"),
+			}),
+			priorState: testPriorStateValue,
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_object":   tftypes.NewValue(testObjectType, tftypes.UnknownValue),
+				"test_required": tftypes.NewValue(tftypes.String, "test-new-config-value"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			testSchema := testSchema(testCase.planKnownObject)
+			request := &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testCase.config,
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testCase.proposedNewState,
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    testCase.priorState,
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+			}
+			expected := &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testCase.expected,
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			}
+
+			got := &fwserver.PlanResourceChangeResponse{}
+
+			server.PlanResourceChange(context.Background(), request, got)
+
+			if diff := cmp.Diff(got, expected, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest // t.Setenv is not compatible with t.Parallel
+func TestServerPlanResourceChange_FirstNonNullChain(t *testing.T) {
+	testSettingsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":     tftypes.String,
+			"settings": testSettingsType,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				Default: stringdefault.FirstNonNull(
+					stringdefault.FromPath(path.MatchRoot("settings").AtName("name")),
+					stringdefault.EnvVar("TF_TEST_FWSERVER_FIRSTNONNULL"),
+					stringdefault.StaticString("test-static-value"),
+				),
+			},
+			"settings": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testConfigValue := func(name tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, nil),
+			"settings": tftypes.NewValue(testSettingsType, map[string]tftypes.Value{
+				"name": name,
+			}),
+		})
+	}
+
+	testPlannedValue := func(name tftypes.Value, plannedName string) tftypes.Value {
+		return tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, plannedName),
+			"settings": tftypes.NewValue(testSettingsType, map[string]tftypes.Value{
+				"name": name,
+			}),
+		})
+	}
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testCases := map[string]struct {
+		name     tftypes.Value
+		envValue string
+		expected tftypes.Value
+	}{
+		"config-path": {
+			name:     tftypes.NewValue(tftypes.String, "test-config-value"),
+			envValue: "test-env-value",
+			expected: testPlannedValue(tftypes.NewValue(tftypes.String, "test-config-value"), "test-config-value"),
+		},
+		"env-var": {
+			name:     tftypes.NewValue(tftypes.String, nil),
+			envValue: "test-env-value",
+			expected: testPlannedValue(tftypes.NewValue(tftypes.String, nil), "test-env-value"),
+		},
+		"static": {
+			name:     tftypes.NewValue(tftypes.String, nil),
+			expected: testPlannedValue(tftypes.NewValue(tftypes.String, nil), "test-static-value"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if testCase.envValue != "" {
+				t.Setenv("TF_TEST_FWSERVER_FIRSTNONNULL", testCase.envValue)
+			}
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			request := &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testConfigValue(testCase.name),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testConfigValue(testCase.name),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+			}
+			expected := &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testCase.expected,
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			}
+
+			got := &fwserver.PlanResourceChangeResponse{}
+
+			server.PlanResourceChange(context.Background(), request, got)
+
+			if diff := cmp.Diff(got, expected, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerPlanResourceChange_FirstNonNullReference(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"display_name": tftypes.String,
+			"name":         tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				Default: stringdefault.FirstNonNull(
+					stringdefault.FromSibling("name"),
+					stringdefault.StaticString("test-static-value"),
+				),
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testConfigValue := func(name tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"display_name": tftypes.NewValue(tftypes.String, nil),
+			"name":         name,
+		})
+	}
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testCases := map[string]struct {
+		name     tftypes.Value
+		expected tftypes.Value
+	}{
+		"sibling-set": {
+			name: tftypes.NewValue(tftypes.String, "test-name"),
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"display_name": tftypes.NewValue(tftypes.String, "test-name"),
+				"name":         tftypes.NewValue(tftypes.String, "test-name"),
+			}),
+		},
+		"sibling-null": {
+			name: tftypes.NewValue(tftypes.String, nil),
+			expected: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"display_name": tftypes.NewValue(tftypes.String, "test-static-value"),
+				"name":         tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			request := &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    testConfigValue(testCase.name),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw:    testConfigValue(testCase.name),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+			}
+			expected := &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw:    testCase.expected,
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			}
+
+			got := &fwserver.PlanResourceChangeResponse{}
+
+			server.PlanResourceChange(context.Background(), request, got)
+
+			if diff := cmp.Diff(got, expected, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerPlanResourceChange_PlanStore(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	var computeCalls int
+
+	testResource := &testprovider.ResourceWithModifyPlan{
+		ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+			value, diags := req.PlanStore.GetOrCompute(ctx, "test-key", func(context.Context) (any, diag.Diagnostics) {
+				computeCalls++
+
+				return "test-stored-value", nil
+			})
+
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_computed"), value.(string))...)
+		},
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	expected := &fwserver.PlanResourceChangeResponse{
+		PlannedState: &tfsdk.State{
+			Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_computed": tftypes.NewValue(tftypes.String, "test-stored-value"),
+			}),
+			Schema: testSchema,
+		},
+		PlannedPrivate: &privatestate.Data{
+			Provider: privatestate.EmptyProviderData(context.Background()),
+		},
+	}
+
+	// Different resource types share the store within the operation.
+	for _, typeName := range []string{"test_resource_one", "test_resource_two"} {
+		request := &fwserver.PlanResourceChangeRequest{
+			Config: &tfsdk.Config{
+				Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+				}),
+				Schema: testSchema,
+			},
+			ProposedNewState: &tfsdk.Plan{
+				Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+				}),
+				Schema: testSchema,
+			},
+			PriorState: &tfsdk.State{
+				Raw:    tftypes.NewValue(testSchemaType, nil),
+				Schema: testSchema,
+			},
+			ResourceSchema: testSchema,
+			Resource:       testResource,
+			TypeName:       typeName,
+		}
+
+		got := &fwserver.PlanResourceChangeResponse{}
+
+		server.PlanResourceChange(context.Background(), request, got)
+
+		if diff := cmp.Diff(got, expected, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	}
+
+	if computeCalls != 1 {
+		t.Errorf("expected 1 compute call, got %d", computeCalls)
+	}
+}
+
+func TestServerPlanResourceChange_ModifyPlanBeforeSchema(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							if req.PlanValue.IsUnknown() {
+								resp.PlanValue = types.StringValue("schema")
+
+								return
+							}
+
+							resp.PlanValue = types.StringValue(req.PlanValue.ValueString() + "-schema")
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testResource := &testprovider.ResourceWithModifyPlan{
+		ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+			var value types.String
+
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_computed"), &value)...)
+
+			if value.IsUnknown() {
+				value = types.StringValue("resource")
+			} else {
+				value = types.StringValue(value.ValueString() + "-resource")
+			}
+
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_computed"), value)...)
+		},
+	}
+
+	testCases := map[string]struct {
+		resourceBehavior resource.ResourceBehavior
+		expected         string
+	}{
+		"default": {
+			expected: "schema-resource",
+		},
+		"ModifyPlanBeforeSchema": {
+			resourceBehavior: resource.ResourceBehavior{
+				ModifyPlanBeforeSchema: true,
+			},
+			expected: "resource-schema",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			request := &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				ResourceSchema:   testSchema,
+				Resource:         testResource,
+				ResourceBehavior: testCase.resourceBehavior,
+			}
+			expected := &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, testCase.expected),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: &privatestate.Data{
+					Provider: privatestate.EmptyProviderData(context.Background()),
+				},
+			}
+
+			got := &fwserver.PlanResourceChangeResponse{}
+
+			server.PlanResourceChange(context.Background(), request, got)
+
+			if diff := cmp.Diff(got, expected, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -24,6 +25,10 @@ type ReadDataSourceRequest struct {
 	DataSourceSchema   fwschema.Schema
 	DataSource         datasource.DataSource
 	ProviderMeta       *tfsdk.Config
+
+	// TypeName is the data source type name, which is necessary for checking
+	// whether the data source is enabled by the provider configuration.
+	TypeName string
 }
 
 // ReadDataSourceResponse is the framework server response for the
@@ -40,6 +45,26 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(s.DataSourceEnabled(ctx, req.TypeName)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	afterHooks := s.beforeDataSourceHooks(ctx, hookOperationRead, req.DataSource)
+	finishAudit := s.startDataSourceAudit(ctx, provider.AuditOperationRead, req.DataSource)
+
+	defer func() {
+		afterHooks(resp.Diagnostics)
+		finishAudit(auditResult{
+			Diagnostics: resp.Diagnostics,
+			Deferred:    resp.Deferred != nil,
+			State:       resp.State,
+		})
+	}()
+
+	defer recoverPanic(ctx, "ReadDataSource", &resp.Diagnostics, req.Config)
+
 	if s.deferred != nil {
 		logging.FrameworkDebug(ctx, "Provider has deferred response configured, automatically returning deferred response.",
 			map[string]interface{}{
@@ -54,8 +79,12 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 			Schema: req.DataSourceSchema,
 		}
 		resp.Deferred = &datasource.Deferred{
-			Reason: datasource.DeferredReason(s.deferred.Reason),
+			Reason:      datasource.DeferredReason(s.deferred.Reason),
+			Explanation: s.deferred.Explanation,
 		}
+
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "data source", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+
 		return
 	}
 
@@ -107,6 +136,10 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 	resp.State = &readResp.State
 	resp.Deferred = readResp.Deferred
 
+	if resp.Deferred != nil {
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "data source", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -20,6 +21,7 @@ type ReadResourceRequest struct {
 	ClientCapabilities resource.ReadClientCapabilities
 	CurrentState       *tfsdk.State
 	Resource           resource.Resource
+	ResourceBehavior   resource.ResourceBehavior
 	Private            *privatestate.Data
 	ProviderMeta       *tfsdk.Config
 }
@@ -39,6 +41,29 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	afterHooks := s.beforeResourceHooks(ctx, hookOperationRead, req.Resource)
+	finishAudit := s.startResourceAudit(ctx, provider.AuditOperationRead, req.Resource)
+	transform := s.resourceStateTransform(ctx, req.Resource)
+
+	defer func() {
+		afterHooks(resp.Diagnostics)
+		finishAudit(auditResult{
+			Diagnostics: resp.Diagnostics,
+			Deferred:    resp.Deferred != nil,
+			State:       resp.NewState,
+		})
+	}()
+
+	defer func() {
+		var diags diag.Diagnostics
+
+		resp.NewState, diags = transform.encodeState(ctx, resp.NewState)
+
+		resp.Diagnostics.Append(diags...)
+	}()
+
+	defer recoverPanic(ctx, "ReadResource", &resp.Diagnostics, req.CurrentState)
+
 	if req.CurrentState == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Read Request",
@@ -49,6 +74,16 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	currentState, diags := transform.decodeState(ctx, req.CurrentState)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	req.CurrentState = currentState
+
 	if s.deferred != nil {
 		logging.FrameworkDebug(ctx, "Provider has deferred response configured, automatically returning deferred response.",
 			map[string]interface{}{
@@ -57,8 +92,12 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		)
 		resp.NewState = req.CurrentState
 		resp.Deferred = &resource.Deferred{
-			Reason: resource.DeferredReason(s.deferred.Reason),
+			Reason:      resource.DeferredReason(s.deferred.Reason),
+			Explanation: s.deferred.Explanation,
 		}
+
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "resource", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+
 		return
 	}
 
@@ -113,14 +152,25 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private = req.Private
 	}
 
-	logging.FrameworkTrace(ctx, "Calling provider defined Resource Read")
-	req.Resource.Read(ctx, readReq, &readResp)
-	logging.FrameworkTrace(ctx, "Called provider defined Resource Read")
+	initialReadResp := readResp
+
+	callResourceMethod(ctx, req.ResourceBehavior, "Read", func(ctx context.Context) diag.Diagnostics {
+		readResp = initialReadResp
+		readResp.Private = initialReadResp.Private.Copy()
+		readReq.Private = readResp.Private
+		req.Resource.Read(ctx, readReq, &readResp)
+
+		return readResp.Diagnostics
+	})
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.NewState = &readResp.State
 	resp.Deferred = readResp.Deferred
 
+	if resp.Deferred != nil {
+		resp.Diagnostics.Append(DeferredExplanationDiagnostics(ctx, "resource", resp.Deferred.Reason, resp.Deferred.Explanation)...)
+	}
+
 	if readResp.Private != nil {
 		if resp.Private == nil {
 			resp.Private = &privatestate.Data{}
@@ -129,6 +179,16 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private.Provider = readResp.Private
 	}
 
+	if readResp.Tainted {
+		logging.FrameworkDebug(ctx, "Resource marked as tainted, which will plan replacement")
+
+		if resp.Private == nil {
+			resp.Private = &privatestate.Data{}
+		}
+
+		resp.Private.SetTainted()
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -399,6 +399,78 @@ func TestServerReadResource(t *testing.T) {
 				Deferred: &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq},
 			},
 		},
+		"response-deferral-automatic-explanation": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						resp.Deferred = &provider.Deferred{
+							Reason:      provider.DeferredReasonProviderConfigUnknown,
+							Explanation: "The region is not yet known.",
+						}
+					},
+				},
+			},
+			configureProviderReq: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState:       testCurrentState,
+				Resource:           &testprovider.Resource{},
+				ClientCapabilities: testDeferralAllowed,
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Deferred Change Explanation",
+						"The provider deferred changes to this resource.\n\n"+
+							"Reason: Provider Config Unknown\n"+
+							"Explanation: The region is not yet known.",
+					),
+				},
+				NewState: testCurrentState,
+				Deferred: &resource.Deferred{
+					Reason:      resource.DeferredReasonProviderConfigUnknown,
+					Explanation: "The region is not yet known.",
+				},
+			},
+		},
+		"response-deferral-manual-explanation": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.State = req.State
+						resp.Deferred = &resource.Deferred{
+							Reason:      resource.DeferredReasonAbsentPrereq,
+							Explanation: "The network is still being created.",
+						}
+					},
+				},
+				ClientCapabilities: testDeferralAllowed,
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Deferred Change Explanation",
+						"The provider deferred changes to this resource.\n\n"+
+							"Reason: Absent Prerequisite\n"+
+							"Explanation: The network is still being created.",
+					),
+				},
+				NewState: testCurrentState,
+				Private:  testEmptyPrivate,
+				Deferred: &resource.Deferred{
+					Reason:      resource.DeferredReasonAbsentPrereq,
+					Explanation: "The network is still being created.",
+				},
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -601,6 +673,28 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testPrivate,
 			},
 		},
+		"response-tainted": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Tainted = true
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private: &privatestate.Data{
+					Framework: map[string][]byte{
+						".tainted": []byte("true"),
+					},
+					Provider: privatestate.EmptyProviderData(context.Background()),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -36,6 +36,8 @@ func (s *Server) RenewEphemeralResource(ctx context.Context, req *RenewEphemeral
 		return
 	}
 
+	defer recoverPanic(ctx, "RenewEphemeralResource", &resp.Diagnostics, panicRedactMessage{})
+
 	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
 
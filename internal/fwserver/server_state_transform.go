@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// StateTransformer returns the provider-defined state transformer, if the
+// provider implements the ProviderWithStateTransformer interface.
+func (s *Server) StateTransformer(ctx context.Context) provider.StateTransformer {
+	providerWithStateTransformer, ok := s.Provider.(provider.ProviderWithStateTransformer)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider StateTransformer")
+	transformer := providerWithStateTransformer.StateTransformer(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider StateTransformer")
+
+	return transformer
+}
+
+// stateTransform transforms the state values of attributes implementing
+// fwschema.AttributeWithStateTransform during a resource operation. The
+// methods of a nil stateTransform return values unchanged.
+type stateTransform struct {
+	transformer      provider.StateTransformer
+	resourceTypeName string
+
+	// stored is the stored value of each decoded value, which is used to
+	// keep the stored value when encoding an unchanged value.
+	stored map[stateTransformKey]string
+}
+
+// stateTransformKey identifies a decoded value by its attribute path,
+// without element steps, and the decoded value itself. Element steps are
+// omitted as set element keys differ between stored and decoded values.
+type stateTransformKey struct {
+	attributePath string
+	value         string
+}
+
+// resourceStateTransform returns the state transform for an operation of
+// the resource, or nil if the provider does not define a state transformer.
+func (s *Server) resourceStateTransform(ctx context.Context, r resource.Resource) *stateTransform {
+	transformer := s.StateTransformer(ctx)
+
+	if transformer == nil || r == nil {
+		return nil
+	}
+
+	metadataResp := resource.MetadataResponse{}
+
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: s.ProviderTypeName(ctx)}, &metadataResp)
+
+	return &stateTransform{
+		transformer:      transformer,
+		resourceTypeName: metadataResp.TypeName,
+		stored:           make(map[stateTransformKey]string),
+	}
+}
+
+// decodeState returns a copy of the state with transformed values decoded.
+func (t *stateTransform) decodeState(ctx context.Context, state *tfsdk.State) (*tfsdk.State, diag.Diagnostics) {
+	if t == nil || state == nil {
+		return state, nil
+	}
+
+	raw, diags := t.transform(ctx, state.Schema, state.Raw, t.decode)
+
+	return &tfsdk.State{
+		Schema: state.Schema,
+		Raw:    raw,
+	}, diags
+}
+
+// decodePlan returns a copy of the plan with transformed values decoded.
+func (t *stateTransform) decodePlan(ctx context.Context, plan *tfsdk.Plan) (*tfsdk.Plan, diag.Diagnostics) {
+	if t == nil || plan == nil {
+		return plan, nil
+	}
+
+	raw, diags := t.transform(ctx, plan.Schema, plan.Raw, t.decode)
+
+	return &tfsdk.Plan{
+		Schema: plan.Schema,
+		Raw:    raw,
+	}, diags
+}
+
+// encodeState returns a copy of the state with transformed values encoded.
+// Values which were previously decoded keep their stored value. Values which
+// cannot be encoded are set to null, so decoded values are never returned to
+// Terraform.
+func (t *stateTransform) encodeState(ctx context.Context, state *tfsdk.State) (*tfsdk.State, diag.Diagnostics) {
+	if t == nil || state == nil {
+		return state, nil
+	}
+
+	raw, diags := t.transform(ctx, state.Schema, state.Raw, t.encode)
+
+	return &tfsdk.State{
+		Schema: state.Schema,
+		Raw:    raw,
+	}, diags
+}
+
+// stateTransformFunc transforms a single known, non-null string value.
+type stateTransformFunc func(context.Context, *tftypes.AttributePath, path.Path, tftypes.Value) (tftypes.Value, diag.Diagnostics)
+
+// transform returns the value with the transform function applied to each
+// known, non-null value of an attribute with state transform enabled.
+func (t *stateTransform) transform(ctx context.Context, schema fwschema.Schema, raw tftypes.Value, fn stateTransformFunc) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if schema == nil || raw.Type() == nil || !raw.IsKnown() || raw.IsNull() {
+		return raw, diags
+	}
+
+	result, err := tftypes.Transform(raw, func(tfPath *tftypes.AttributePath, tfValue tftypes.Value) (tftypes.Value, error) {
+		if !tfValue.IsKnown() || tfValue.IsNull() || !tfValue.Type().Is(tftypes.String) {
+			return tfValue, nil
+		}
+
+		attribute, err := schema.AttributeAtTerraformPath(ctx, tfPath)
+
+		// Paths which are not attributes, such as elements, are not
+		// transformed.
+		if err != nil {
+			return tfValue, nil
+		}
+
+		attributeWithStateTransform, ok := attribute.(fwschema.AttributeWithStateTransform)
+
+		if !ok || !attributeWithStateTransform.HasStateTransform() {
+			return tfValue, nil
+		}
+
+		attributePath, pathDiags := fromtftypes.AttributePath(ctx, tfPath, schema)
+
+		diags.Append(pathDiags...)
+
+		if pathDiags.HasError() {
+			return tfValue, nil
+		}
+
+		transformedValue, fnDiags := fn(ctx, tfPath, attributePath, tfValue)
+
+		diags.Append(fnDiags...)
+
+		return transformedValue, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Error Transforming State",
+			"An unexpected error was encountered when transforming state values. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return raw, diags
+	}
+
+	return result, diags
+}
+
+// decode calls the provider-defined Decode method and records the stored
+// value of the decoded value. If decoding fails, the stored value is kept.
+func (t *stateTransform) decode(ctx context.Context, tfPath *tftypes.AttributePath, attributePath path.Path, tfValue tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var value string
+
+	if err := tfValue.As(&value); err != nil {
+		return tfValue, stateTransformValueErrorDiags(attributePath, err)
+	}
+
+	req := provider.StateTransformRequest{
+		ResourceTypeName: t.resourceTypeName,
+		Path:             attributePath,
+		Value:            value,
+	}
+	resp := provider.StateTransformResponse{
+		Value: value,
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined StateTransformer Decode", map[string]interface{}{logging.KeyAttributePath: attributePath.String()})
+	t.transformer.Decode(ctx, req, &resp)
+	logging.FrameworkTrace(ctx, "Called provider defined StateTransformer Decode", map[string]interface{}{logging.KeyAttributePath: attributePath.String()})
+
+	if resp.Diagnostics.HasError() {
+		return tfValue, resp.Diagnostics
+	}
+
+	t.stored[stateTransformKey{attributePath: stateTransformAttributePath(tfPath), value: resp.Value}] = value
+
+	return tftypes.NewValue(tfValue.Type(), resp.Value), resp.Diagnostics
+}
+
+// encode returns the stored value of a previously decoded value or calls
+// the provider-defined Encode method. If encoding fails, the value is set
+// to null so the decoded value is never returned to Terraform.
+func (t *stateTransform) encode(ctx context.Context, tfPath *tftypes.AttributePath, attributePath path.Path, tfValue tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var value string
+
+	if err := tfValue.As(&value); err != nil {
+		return tftypes.NewValue(tfValue.Type(), nil), stateTransformValueErrorDiags(attributePath, err)
+	}
+
+	if stored, ok := t.stored[stateTransformKey{attributePath: stateTransformAttributePath(tfPath), value: value}]; ok {
+		return tftypes.NewValue(tfValue.Type(), stored), nil
+	}
+
+	req := provider.StateTransformRequest{
+		ResourceTypeName: t.resourceTypeName,
+		Path:             attributePath,
+		Value:            value,
+	}
+	resp := provider.StateTransformResponse{
+		Value: value,
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined StateTransformer Encode", map[string]interface{}{logging.KeyAttributePath: attributePath.String()})
+	t.transformer.Encode(ctx, req, &resp)
+	logging.FrameworkTrace(ctx, "Called provider defined StateTransformer Encode", map[string]interface{}{logging.KeyAttributePath: attributePath.String()})
+
+	if resp.Diagnostics.HasError() {
+		return tftypes.NewValue(tfValue.Type(), nil), resp.Diagnostics
+	}
+
+	return tftypes.NewValue(tfValue.Type(), resp.Value), resp.Diagnostics
+}
+
+// stateTransformValueErrorDiags returns diagnostics for a value which could
+// not be converted into a string.
+func stateTransformValueErrorDiags(attributePath path.Path, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddAttributeError(
+		attributePath,
+		"Error Transforming State",
+		"An unexpected error was encountered when transforming the state value. "+
+			"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+			"Error: "+err.Error(),
+	)
+
+	return diags
+}
+
+// stateTransformAttributePath returns the string representation of the
+// attribute path without element steps.
+func stateTransformAttributePath(tfPath *tftypes.AttributePath) string {
+	var steps []tftypes.AttributePathStep
+
+	for _, step := range tfPath.Steps() {
+		if attributeName, ok := step.(tftypes.AttributeName); ok {
+			steps = append(steps, attributeName)
+		}
+	}
+
+	return tftypes.NewAttributePathWithSteps(steps).String()
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerStateTransform(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":     tftypes.String,
+			"secret": tftypes.String,
+		},
+	}
+
+	testValue := func(id interface{}, secret interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id":     tftypes.NewValue(tftypes.String, id),
+			"secret": tftypes.NewValue(tftypes.String, secret),
+		})
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret": schema.StringAttribute{
+				Computed:       true,
+				Sensitive:      true,
+				StateTransform: true,
+			},
+		},
+	}
+
+	// The test transformer prefixes encoded values with "encoded:" and
+	// accepts any prefix when decoding, simulating non-deterministic
+	// encryption of values stored by a prior operation.
+	testTransformer := &testprovider.StateTransformer{
+		DecodeMethod: func(_ context.Context, req provider.StateTransformRequest, resp *provider.StateTransformResponse) {
+			_, value, ok := strings.Cut(req.Value, ":")
+
+			if !ok {
+				resp.Diagnostics.AddAttributeError(req.Path, "test summary", "test detail")
+
+				return
+			}
+
+			resp.Value = value
+		},
+		EncodeMethod: func(_ context.Context, req provider.StateTransformRequest, resp *provider.StateTransformResponse) {
+			if req.Value == "invalid" {
+				resp.Diagnostics.AddAttributeError(req.Path, "test summary", "test detail")
+
+				return
+			}
+
+			resp.Value = "encoded:" + req.Value
+		},
+	}
+
+	testCases := map[string]struct {
+		call                func(context.Context, *fwserver.Server, *testing.T) (tftypes.Value, diag.Diagnostics)
+		expectedValue       tftypes.Value
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"read-unchanged": {
+			call: func(ctx context.Context, s *fwserver.Server, t *testing.T) (tftypes.Value, diag.Diagnostics) {
+				resp := &fwserver.ReadResourceResponse{}
+
+				s.ReadResource(ctx, &fwserver.ReadResourceRequest{
+					CurrentState: &tfsdk.State{Raw: testValue("test-id", "stored:test-secret"), Schema: testSchema},
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							var secret types.String
+
+							resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("secret"), &secret)...)
+
+							if secret.ValueString() != "test-secret" {
+								t.Errorf("expected decoded value, got: %s", secret)
+							}
+						},
+					},
+				}, resp)
+
+				return resp.NewState.Raw, resp.Diagnostics
+			},
+			expectedValue: testValue("test-id", "stored:test-secret"),
+		},
+		"read-changed": {
+			call: func(ctx context.Context, s *fwserver.Server, _ *testing.T) (tftypes.Value, diag.Diagnostics) {
+				resp := &fwserver.ReadResourceResponse{}
+
+				s.ReadResource(ctx, &fwserver.ReadResourceRequest{
+					CurrentState: &tfsdk.State{Raw: testValue("test-id", "stored:test-secret"), Schema: testSchema},
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret"), "test-new-secret")...)
+						},
+					},
+				}, resp)
+
+				return resp.NewState.Raw, resp.Diagnostics
+			},
+			expectedValue: testValue("test-id", "encoded:test-new-secret"),
+		},
+		"read-decode-error": {
+			call: func(ctx context.Context, s *fwserver.Server, t *testing.T) (tftypes.Value, diag.Diagnostics) {
+				resp := &fwserver.ReadResourceResponse{}
+
+				s.ReadResource(ctx, &fwserver.ReadResourceRequest{
+					CurrentState: &tfsdk.State{Raw: testValue("test-id", "test-secret"), Schema: testSchema},
+					Resource: &testprovider.Resource{
+						ReadMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+							t.Error("unexpected Read call")
+						},
+					},
+				}, resp)
+
+				if resp.NewState != nil {
+					t.Errorf("unexpected new state: %s", resp.NewState.Raw)
+				}
+
+				return tftypes.Value{}, resp.Diagnostics
+			},
+			expectedValue: tftypes.Value{},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("secret"), "test summary", "test detail"),
+			},
+		},
+		"read-encode-error": {
+			call: func(ctx context.Context, s *fwserver.Server, _ *testing.T) (tftypes.Value, diag.Diagnostics) {
+				resp := &fwserver.ReadResourceResponse{}
+
+				s.ReadResource(ctx, &fwserver.ReadResourceRequest{
+					CurrentState: &tfsdk.State{Raw: testValue("test-id", "stored:test-secret"), Schema: testSchema},
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret"), "invalid")...)
+						},
+					},
+				}, resp)
+
+				return resp.NewState.Raw, resp.Diagnostics
+			},
+			expectedValue: testValue("test-id", nil),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("secret"), "test summary", "test detail"),
+			},
+		},
+		"plan-unchanged": {
+			call: func(ctx context.Context, s *fwserver.Server, t *testing.T) (tftypes.Value, diag.Diagnostics) {
+				resp := &fwserver.PlanResourceChangeResponse{}
+
+				s.PlanResourceChange(ctx, &fwserver.PlanResourceChangeRequest{
+					Config:           &tfsdk.Config{Raw: testValue(nil, nil), Schema: testSchema},
+					PriorState:       &tfsdk.State{Raw: testValue("test-id", "stored:test-secret"), Schema: testSchema},
+					ProposedNewState: &tfsdk.Plan{Raw: testValue("test-id", "stored:test-secret"), Schema: testSchema},
+					ResourceSchema:   testSchema,
+					Resource: &testprovider.ResourceWithModifyPlan{
+						Resource: &testprovider.Resource{},
+						ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+							var secret types.String
+
+							resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("secret"), &secret)...)
+
+							if secret.ValueString() != "test-secret" {
+								t.Errorf("expected decoded value, got: %s", secret)
+							}
+						},
+					},
+				}, resp)
+
+				return resp.PlannedState.Raw, resp.Diagnostics
+			},
+			expectedValue: testValue("test-id", "stored:test-secret"),
+		},
+		"apply-create": {
+			call: func(ctx context.Context, s *fwserver.Server, _ *testing.T) (tftypes.Value, diag.Diagnostics) {
+				resp := &fwserver.ApplyResourceChangeResponse{}
+
+				s.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+					Config:         &tfsdk.Config{Raw: testValue(nil, nil), Schema: testSchema},
+					PlannedState:   &tfsdk.Plan{Raw: testValue(tftypes.UnknownValue, tftypes.UnknownValue), Schema: testSchema},
+					PriorState:     &tfsdk.State{Raw: tftypes.NewValue(testType, nil), Schema: testSchema},
+					ResourceSchema: testSchema,
+					Resource: &testprovider.Resource{
+						CreateMethod: func(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+							resp.State.Raw = testValue("test-id", "test-secret")
+						},
+					},
+				}, resp)
+
+				return resp.NewState.Raw, resp.Diagnostics
+			},
+			expectedValue: testValue("test-id", "encoded:test-secret"),
+		},
+		"apply-update-unchanged": {
+			call: func(ctx context.Context, s *fwserver.Server, _ *testing.T) (tftypes.Value, diag.Diagnostics) {
+				resp := &fwserver.ApplyResourceChangeResponse{}
+
+				s.ApplyResourceChange(ctx, &fwserver.ApplyResourceChangeRequest{
+					Config:         &tfsdk.Config{Raw: testValue(nil, nil), Schema: testSchema},
+					PlannedState:   &tfsdk.Plan{Raw: testValue("test-id", "planned:test-secret"), Schema: testSchema},
+					PriorState:     &tfsdk.State{Raw: testValue("test-id", "stored:test-secret"), Schema: testSchema},
+					ResourceSchema: testSchema,
+					Resource: &testprovider.Resource{
+						UpdateMethod: func(_ context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+							resp.State.Raw = req.Plan.Raw
+						},
+					},
+				}, resp)
+
+				return resp.NewState.Raw, resp.Diagnostics
+			},
+			expectedValue: testValue("test-id", "planned:test-secret"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.ProviderWithStateTransformer{
+					Provider: &testprovider.Provider{},
+					StateTransformerMethod: func(_ context.Context) provider.StateTransformer {
+						return testTransformer
+					},
+				},
+			}
+
+			gotValue, gotDiagnostics := testCase.call(context.Background(), server, t)
+
+			if diff := cmp.Diff(gotValue, testCase.expectedValue); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
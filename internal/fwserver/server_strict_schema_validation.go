@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// StrictSchemaValidation returns true if the provider implements the
+// ProviderWithStrictSchemaValidation interface and enables the additional
+// resource schema checks.
+func (s *Server) StrictSchemaValidation(ctx context.Context) bool {
+	providerWithStrictSchemaValidation, ok := s.Provider.(provider.ProviderWithStrictSchemaValidation)
+
+	if !ok {
+		return false
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider StrictSchemaValidation")
+	enabled := providerWithStrictSchemaValidation.StrictSchemaValidation(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider StrictSchemaValidation")
+
+	return enabled
+}
+
+// strictResourceSchemaDiags returns warning diagnostics for Optional and
+// Computed attributes of the resource schema which are planned as unknown
+// on every resource update when not configured, because the attribute and
+// its parent attributes or blocks have no Default, plan modifier which uses
+// the prior state value, or type with semantic equality.
+func strictResourceSchemaDiags(ctx context.Context, typeName string, resourceSchema fwschema.Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(strictAttributesDiags(ctx, typeName, path.Empty(), resourceSchema.GetAttributes())...)
+	diags.Append(strictBlocksDiags(ctx, typeName, path.Empty(), resourceSchema.GetBlocks())...)
+
+	return diags
+}
+
+// strictAttributesDiags returns the strict schema validation diagnostics for
+// the attributes and their nested attributes.
+func strictAttributesDiags(ctx context.Context, typeName string, parentPath path.Path, attributes fwschema.UnderlyingAttributes) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		attributePath := parentPath.AtName(name)
+
+		if attributeHasStablePlan(ctx, attribute) {
+			continue
+		}
+
+		if attribute.IsOptional() && attribute.IsComputed() {
+			diags.AddWarning(
+				"Optional and Computed Attribute Without Stable Plan",
+				fmt.Sprintf("The %q resource schema attribute %q is Optional and Computed, but has no Default, ", typeName, attributePath)+
+					"UseStateForUnknown plan modifier, or custom type with semantic equality. "+
+					"When the attribute is not configured, Terraform will show its value as (known after apply) on every resource update, "+
+					"and a differing value after apply can cause a \"Provider produced inconsistent result after apply\" error.\n\n"+
+					"If the value does not change after creation, add the UseStateForUnknown plan modifier. "+
+					"If the value has a known default, add a Default. "+
+					"If the value can change on every update, this warning can be ignored.\n\n"+
+					"This warning is only returned because the provider enables strict schema validation.",
+			)
+		}
+
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if !ok {
+			continue
+		}
+
+		nestedObject := nestedAttribute.GetNestedObject()
+
+		if nestedObjectWithPlanModifiers, ok := nestedObject.(fwxschema.NestedAttributeObjectWithPlanModifiers); ok {
+			if hasStateForUnknownModifier(nestedObjectWithPlanModifiers.ObjectPlanModifiers()) {
+				continue
+			}
+		}
+
+		diags.Append(strictAttributesDiags(ctx, typeName, attributePath, nestedObject.GetAttributes())...)
+	}
+
+	return diags
+}
+
+// strictBlocksDiags returns the strict schema validation diagnostics for
+// the attributes and blocks nested within the blocks.
+func strictBlocksDiags(ctx context.Context, typeName string, parentPath path.Path, blocks map[string]fwschema.Block) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range sortedKeys(blocks) {
+		block := blocks[name]
+		blockPath := parentPath.AtName(name)
+
+		if blockHasStablePlan(block) {
+			continue
+		}
+
+		nestedObject := block.GetNestedObject()
+
+		if nestedObjectWithPlanModifiers, ok := nestedObject.(fwxschema.NestedBlockObjectWithPlanModifiers); ok {
+			if hasStateForUnknownModifier(nestedObjectWithPlanModifiers.ObjectPlanModifiers()) {
+				continue
+			}
+		}
+
+		diags.Append(strictAttributesDiags(ctx, typeName, blockPath, nestedObject.GetAttributes())...)
+		diags.Append(strictBlocksDiags(ctx, typeName, blockPath, nestedObject.GetBlocks())...)
+	}
+
+	return diags
+}
+
+// attributeHasStablePlan returns true if the attribute has a Default, a plan
+// modifier which uses the prior state value, or a type with semantic
+// equality.
+func attributeHasStablePlan(ctx context.Context, attribute fwschema.Attribute) bool {
+	switch a := attribute.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		if a.BoolDefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithDynamicDefaultValue:
+		if a.DynamicDefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithFloat32DefaultValue:
+		if a.Float32DefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithFloat64DefaultValue:
+		if a.Float64DefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithInt32DefaultValue:
+		if a.Int32DefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithInt64DefaultValue:
+		if a.Int64DefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithListDefaultValue:
+		if a.ListDefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithMapDefaultValue:
+		if a.MapDefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithNumberDefaultValue:
+		if a.NumberDefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithObjectDefaultValue:
+		if a.ObjectDefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithSetDefaultValue:
+		if a.SetDefaultValue() != nil {
+			return true
+		}
+	case fwschema.AttributeWithStringDefaultValue:
+		if a.StringDefaultValue() != nil {
+			return true
+		}
+	}
+
+	switch a := attribute.(type) {
+	case fwxschema.AttributeWithBoolPlanModifiers:
+		if hasStateForUnknownModifier(a.BoolPlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithDynamicPlanModifiers:
+		if hasStateForUnknownModifier(a.DynamicPlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithFloat32PlanModifiers:
+		if hasStateForUnknownModifier(a.Float32PlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithFloat64PlanModifiers:
+		if hasStateForUnknownModifier(a.Float64PlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithInt32PlanModifiers:
+		if hasStateForUnknownModifier(a.Int32PlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithInt64PlanModifiers:
+		if hasStateForUnknownModifier(a.Int64PlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithListPlanModifiers:
+		if hasStateForUnknownModifier(a.ListPlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithMapPlanModifiers:
+		if hasStateForUnknownModifier(a.MapPlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithNumberPlanModifiers:
+		if hasStateForUnknownModifier(a.NumberPlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithObjectPlanModifiers:
+		if hasStateForUnknownModifier(a.ObjectPlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithSetPlanModifiers:
+		if hasStateForUnknownModifier(a.SetPlanModifiers()) {
+			return true
+		}
+	case fwxschema.AttributeWithStringPlanModifiers:
+		if hasStateForUnknownModifier(a.StringPlanModifiers()) {
+			return true
+		}
+	}
+
+	switch attribute.GetType().ValueType(ctx).(type) {
+	case basetypes.BoolValuableWithSemanticEquals,
+		basetypes.DynamicValuableWithSemanticEquals,
+		basetypes.Float32ValuableWithSemanticEquals,
+		basetypes.Float64ValuableWithSemanticEquals,
+		basetypes.Int32ValuableWithSemanticEquals,
+		basetypes.Int64ValuableWithSemanticEquals,
+		basetypes.ListValuableWithSemanticEquals,
+		basetypes.MapValuableWithSemanticEquals,
+		basetypes.NumberValuableWithSemanticEquals,
+		basetypes.ObjectValuableWithSemanticEquals,
+		basetypes.SetValuableWithSemanticEquals,
+		basetypes.StringValuableWithSemanticEquals:
+		return true
+	}
+
+	return false
+}
+
+// blockHasStablePlan returns true if the block has a plan modifier which uses
+// the prior state value.
+func blockHasStablePlan(block fwschema.Block) bool {
+	switch b := block.(type) {
+	case fwxschema.BlockWithListPlanModifiers:
+		return hasStateForUnknownModifier(b.ListPlanModifiers())
+	case fwxschema.BlockWithObjectPlanModifiers:
+		return hasStateForUnknownModifier(b.ObjectPlanModifiers())
+	case fwxschema.BlockWithSetPlanModifiers:
+		return hasStateForUnknownModifier(b.SetPlanModifiers())
+	}
+
+	return false
+}
+
+// hasStateForUnknownModifier returns true if any of the plan modifiers
+// implement fwplanmodifier.StateForUnknownModifier and use the prior state
+// value.
+func hasStateForUnknownModifier[T any](planModifiers []T) bool {
+	for _, planModifier := range planModifiers {
+		stateForUnknownModifier, ok := any(planModifier).(fwplanmodifier.StateForUnknownModifier)
+
+		if ok && stateForUnknownModifier.UsesStateForUnknown() {
+			return true
+		}
+	}
+
+	return false
+}
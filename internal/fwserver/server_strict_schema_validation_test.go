@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func TestServerGetProviderSchema_StrictSchemaValidation(t *testing.T) {
+	t.Parallel()
+
+	expectedWarning := func(attributePath string) diag.Diagnostic {
+		return diag.NewWarningDiagnostic(
+			"Optional and Computed Attribute Without Stable Plan",
+			"The \"test_resource\" resource schema attribute \""+attributePath+"\" is Optional and Computed, but has no Default, "+
+				"UseStateForUnknown plan modifier, or custom type with semantic equality. "+
+				"When the attribute is not configured, Terraform will show its value as (known after apply) on every resource update, "+
+				"and a differing value after apply can cause a \"Provider produced inconsistent result after apply\" error.\n\n"+
+				"If the value does not change after creation, add the UseStateForUnknown plan modifier. "+
+				"If the value has a known default, add a Default. "+
+				"If the value can change on every update, this warning can be ignored.\n\n"+
+				"This warning is only returned because the provider enables strict schema validation.",
+		)
+	}
+
+	testCases := map[string]struct {
+		strictSchemaValidation bool
+		schema                 schema.Schema
+		expectedDiagnostics    diag.Diagnostics
+	}{
+		"disabled": {
+			strictSchemaValidation: false,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"optional-computed": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				expectedWarning("test"),
+			},
+		},
+		"optional-computed-default": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("test"),
+					},
+				},
+			},
+		},
+		"optional-computed-planmodifiers": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				expectedWarning("test"),
+			},
+		},
+		"optional-computed-planmodifiers-usestateforunknown": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+		"optional-computed-semantic-equality": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						CustomType: testtypes.StringTypeWithSemanticEquals{},
+						Optional:   true,
+						Computed:   true,
+					},
+				},
+			},
+		},
+		"computed": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+		"nested-attribute": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_test": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+						},
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				expectedWarning("test"),
+				expectedWarning("test.nested_test"),
+			},
+		},
+		"nested-attribute-parent-usestateforunknown": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_test": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+						},
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknownElements(),
+						},
+					},
+				},
+			},
+		},
+		"block": {
+			strictSchemaValidation: true,
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"nested_test": schema.StringAttribute{
+								Optional: true,
+								Computed: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"nested_block": schema.ListNestedBlock{
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"nested_block_test": schema.StringAttribute{
+											Optional: true,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				expectedWarning("test.nested_test"),
+				expectedWarning("test.nested_block.nested_block_test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.ProviderWithStrictSchemaValidation{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testCase.schema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+					StrictSchemaValidationMethod: func(_ context.Context) bool {
+						return testCase.strictSchemaValidation
+					},
+				},
+			}
+
+			response := &fwserver.GetProviderSchemaResponse{}
+			server.GetProviderSchema(context.Background(), &fwserver.GetProviderSchemaRequest{}, response)
+
+			if diff := cmp.Diff(response.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
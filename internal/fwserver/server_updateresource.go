@@ -20,13 +20,14 @@ import (
 // UpdateResourceRequest is the framework server request for an update request
 // with the ApplyResourceChange RPC.
 type UpdateResourceRequest struct {
-	Config         *tfsdk.Config
-	PlannedPrivate *privatestate.Data
-	PlannedState   *tfsdk.Plan
-	PriorState     *tfsdk.State
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	Config           *tfsdk.Config
+	PlannedPrivate   *privatestate.Data
+	PlannedState     *tfsdk.Plan
+	PriorState       *tfsdk.State
+	ProviderMeta     *tfsdk.Config
+	ResourceSchema   fwschema.Schema
+	Resource         resource.Resource
+	ResourceBehavior resource.ResourceBehavior
 }
 
 // UpdateResourceResponse is the framework server response for an update request
@@ -118,9 +119,16 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		resp.Private = req.PlannedPrivate
 	}
 
-	logging.FrameworkTrace(ctx, "Calling provider defined Resource Update")
-	req.Resource.Update(ctx, updateReq, &updateResp)
-	logging.FrameworkTrace(ctx, "Called provider defined Resource Update")
+	initialUpdateResp := updateResp
+
+	callResourceMethod(ctx, req.ResourceBehavior, "Update", func(ctx context.Context) diag.Diagnostics {
+		updateResp = initialUpdateResp
+		updateResp.Private = initialUpdateResp.Private.Copy()
+		updateReq.Private = updateResp.Private
+		req.Resource.Update(ctx, updateReq, &updateResp)
+
+		return updateResp.Diagnostics
+	})
 
 	resp.Diagnostics = updateResp.Diagnostics
 	resp.NewState = &updateResp.State
@@ -141,6 +149,16 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		resp.Private.Provider = updateResp.Private
 	}
 
+	if updateResp.Tainted {
+		logging.FrameworkDebug(ctx, "Resource marked as tainted, which will plan replacement")
+
+		if resp.Private == nil {
+			resp.Private = &privatestate.Data{}
+		}
+
+		resp.Private.SetTainted()
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -169,11 +187,11 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
-	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
-		return
-	}
+	if !semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to semantic equality")
 
-	logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+		resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	}
 
-	resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	resp.Diagnostics.Append(PlannedStateConsistency(ctx, req.ResourceSchema, "Update", req.PlannedState.Raw, resp.NewState.Raw)...)
 }
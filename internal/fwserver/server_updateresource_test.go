@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -188,6 +189,10 @@ func TestServerUpdateResource(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_computed"), "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -239,6 +244,10 @@ func TestServerUpdateResource(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_computed"), "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -290,6 +299,10 @@ func TestServerUpdateResource(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_computed"), "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -342,6 +355,10 @@ func TestServerUpdateResource(t *testing.T) {
 				},
 			},
 			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					testInconsistentResultDiagnostic(path.Root("test_computed"), "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic(path.Root("test_required"), "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
@@ -856,6 +873,48 @@ func TestServerUpdateResource(t *testing.T) {
 				Private: testPrivate,
 			},
 		},
+		"response-tainted": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpdateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.Tainted = true
+					},
+				},
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				Private: &privatestate.Data{
+					Framework: map[string][]byte{
+						".tainted": []byte("true"),
+					},
+					Provider: privatestate.EmptyProviderData(context.Background()),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
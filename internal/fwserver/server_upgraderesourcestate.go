@@ -31,9 +31,10 @@ type UpgradeResourceStateRequest struct {
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/340
 	RawState *tfprotov6.RawState
 
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
-	Version        int64
+	ResourceBehavior resource.ResourceBehavior
+	ResourceSchema   fwschema.Schema
+	Resource         resource.Resource
+	Version          int64
 }
 
 // UpgradeResourceStateResponse is the framework server response for the
@@ -101,6 +102,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 			return
 		}
 
+		resp.Diagnostics.Append(extraneousStateAttributesDiags(ctx, req.ResourceBehavior.ExtraneousStateAttributes, req.RawState, resourceSchemaType)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		resp.UpgradedState = &tfsdk.State{
 			Schema: req.ResourceSchema,
 			Raw:    rawStateValue,
@@ -733,6 +733,101 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"Version-current-json-mismatch-warn": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                    "test-id-value",
+					"required_attribute":    "true",
+					"nonexistent_attribute": "value",
+					"other_attribute":       "value",
+				}),
+				ResourceBehavior: resource.ResourceBehavior{
+					ExtraneousStateAttributes: resource.ExtraneousStateAttributesWarn,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Extraneous Resource State Attributes Removed",
+						"The prior resource state contains attributes which are not defined in the resource schema, "+
+							"such as after downgrading the provider to a version without the attributes. "+
+							"The attributes were removed from the resource state:\n\n"+
+							"- nonexistent_attribute\n"+
+							"- other_attribute",
+					),
+				},
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"Version-current-json-mismatch-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                    "test-id-value",
+					"required_attribute":    "true",
+					"nonexistent_attribute": "value",
+				}),
+				ResourceBehavior: resource.ResourceBehavior{
+					ExtraneousStateAttributes: resource.ExtraneousStateAttributesError,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Extraneous Resource State Attributes",
+						"The prior resource state contains attributes which are not defined in the resource schema, "+
+							"such as after downgrading the provider to a version without the attributes. "+
+							"To prevent losing data, upgrade the provider to a version which defines the attributes:\n\n"+
+							"- nonexistent_attribute",
+					),
+				},
+			},
+		},
+		"Version-current-json-match-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"required_attribute": "true",
+				}),
+				ResourceBehavior: resource.ResourceBehavior{
+					ExtraneousStateAttributes: resource.ExtraneousStateAttributesError,
+				},
+				ResourceSchema: testSchema,
+				Resource:       &testprovider.Resource{},
+				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"Version-not-implemented": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fixit"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -22,7 +23,8 @@ type ValidateDataSourceConfigRequest struct {
 // ValidateDataSourceConfigResponse is the framework server response for the
 // ValidateDataSourceConfig RPC.
 type ValidateDataSourceConfigResponse struct {
-	Diagnostics diag.Diagnostics
+	Diagnostics      diag.Diagnostics
+	SuggestedChanges fixit.SuggestedChanges
 }
 
 // ValidateDataSourceConfig implements the framework server ValidateDataSourceConfig RPC.
@@ -31,6 +33,14 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		return
 	}
 
+	afterHooks := s.beforeDataSourceHooks(ctx, hookOperationValidate, req.DataSource)
+
+	defer func() {
+		afterHooks(resp.Diagnostics)
+	}()
+
+	defer recoverPanic(ctx, "ValidateDataSourceConfig", &resp.Diagnostics, req.Config)
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
@@ -51,7 +61,11 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 	}
 
 	vdscReq := datasource.ValidateConfigRequest{
-		Config: *req.Config,
+		ClientCapabilities: datasource.ValidateConfigClientCapabilities{
+			DeferralAllowed: s.configureClientCapabilities.DeferralAllowed,
+		},
+		Config:           *req.Config,
+		TerraformVersion: s.terraformVersion,
 	}
 
 	if dataSource, ok := req.DataSource.(datasource.DataSourceWithConfigValidators); ok {
@@ -79,6 +93,7 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 			)
 
 			resp.Diagnostics.Append(vdscResp.Diagnostics...)
+			resp.SuggestedChanges.Append(vdscResp.SuggestedChanges...)
 		}
 	}
 
@@ -94,10 +109,12 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 		logging.FrameworkTrace(ctx, "Called provider defined DataSource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
+		resp.SuggestedChanges.Append(vdscResp.SuggestedChanges...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:    *req.Config,
+		Functions: s.FunctionCaller(),
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
@@ -106,4 +123,6 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateData
 	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
 
 	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+
+	resp.Diagnostics = expandConfigDiagnosticPathExpressions(ctx, *req.Config, resp.Diagnostics)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/fixit"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -22,7 +23,8 @@ type ValidateEphemeralResourceConfigRequest struct {
 // ValidateEphemeralResourceConfigResponse is the framework server response for the
 // ValidateEphemeralResourceConfig RPC.
 type ValidateEphemeralResourceConfigResponse struct {
-	Diagnostics diag.Diagnostics
+	Diagnostics      diag.Diagnostics
+	SuggestedChanges fixit.SuggestedChanges
 }
 
 // ValidateEphemeralResourceConfig implements the framework server ValidateEphemeralResourceConfig RPC.
@@ -31,6 +33,8 @@ func (s *Server) ValidateEphemeralResourceConfig(ctx context.Context, req *Valid
 		return
 	}
 
+	defer recoverPanic(ctx, "ValidateEphemeralResourceConfig", &resp.Diagnostics, panicRedactMessage{})
+
 	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
 
@@ -51,7 +55,11 @@ func (s *Server) ValidateEphemeralResourceConfig(ctx context.Context, req *Valid
 	}
 
 	vdscReq := ephemeral.ValidateConfigRequest{
-		Config: *req.Config,
+		ClientCapabilities: ephemeral.ValidateConfigClientCapabilities{
+			DeferralAllowed: s.configureClientCapabilities.DeferralAllowed,
+		},
+		Config:           *req.Config,
+		TerraformVersion: s.terraformVersion,
 	}
 
 	if ephemeralResourceWithConfigValidators, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigValidators); ok {
@@ -79,6 +87,7 @@ func (s *Server) ValidateEphemeralResourceConfig(ctx context.Context, req *Valid
 			)
 
 			resp.Diagnostics.Append(vdscResp.Diagnostics...)
+			resp.SuggestedChanges.Append(vdscResp.SuggestedChanges...)
 		}
 	}
 
@@ -94,10 +103,12 @@ func (s *Server) ValidateEphemeralResourceConfig(ctx context.Context, req *Valid
 		logging.FrameworkTrace(ctx, "Called provider defined EphemeralResource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
+		resp.SuggestedChanges.Append(vdscResp.SuggestedChanges...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:    *req.Config,
+		Functions: s.FunctionCaller(),
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
@@ -106,4 +117,6 @@ func (s *Server) ValidateEphemeralResourceConfig(ctx context.Context, req *Valid
 	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
 
 	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+
+	resp.Diagnostics = expandConfigDiagnosticPathExpressions(ctx, *req.Config, resp.Diagnostics)
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fixit"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -21,8 +22,9 @@ type ValidateProviderConfigRequest struct {
 // ValidateProviderConfigResponse is the framework server response for the
 // ValidateProviderConfig RPC.
 type ValidateProviderConfigResponse struct {
-	PreparedConfig *tfsdk.Config
-	Diagnostics    diag.Diagnostics
+	PreparedConfig   *tfsdk.Config
+	Diagnostics      diag.Diagnostics
+	SuggestedChanges fixit.SuggestedChanges
 }
 
 // ValidateProviderConfig implements the framework server ValidateProviderConfig RPC.
@@ -31,6 +33,8 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		return
 	}
 
+	defer recoverPanic(ctx, "ValidateProviderConfig", &resp.Diagnostics, req.Config)
+
 	vpcReq := provider.ValidateConfigRequest{
 		Config: *req.Config,
 	}
@@ -60,6 +64,7 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 			)
 
 			resp.Diagnostics.Append(vpcRes.Diagnostics...)
+			resp.SuggestedChanges.Append(vpcRes.SuggestedChanges...)
 		}
 	}
 
@@ -75,10 +80,12 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		logging.FrameworkTrace(ctx, "Called provider defined Provider ValidateConfig")
 
 		resp.Diagnostics.Append(vpcRes.Diagnostics...)
+		resp.SuggestedChanges.Append(vpcRes.SuggestedChanges...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:    *req.Config,
+		Functions: s.FunctionCaller(),
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
@@ -88,6 +95,8 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 
 	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
 
+	resp.Diagnostics = expandConfigDiagnosticPathExpressions(ctx, *req.Config, resp.Diagnostics)
+
 	// This RPC allows a modified configuration to be returned. This was
 	// previously used to allow a "required" provider attribute (as defined
 	// by a schema) to still be "optional" with a default value, typically
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fixit"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -22,7 +23,8 @@ type ValidateResourceConfigRequest struct {
 // ValidateResourceConfigResponse is the framework server response for the
 // ValidateResourceConfig RPC.
 type ValidateResourceConfigResponse struct {
-	Diagnostics diag.Diagnostics
+	Diagnostics      diag.Diagnostics
+	SuggestedChanges fixit.SuggestedChanges
 }
 
 // ValidateResourceConfig implements the framework server ValidateResourceConfig RPC.
@@ -31,6 +33,14 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		return
 	}
 
+	afterHooks := s.beforeResourceHooks(ctx, hookOperationValidate, req.Resource)
+
+	defer func() {
+		afterHooks(resp.Diagnostics)
+	}()
+
+	defer recoverPanic(ctx, "ValidateResourceConfig", &resp.Diagnostics, req.Config)
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -51,7 +61,11 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 	}
 
 	vdscReq := resource.ValidateConfigRequest{
-		Config: *req.Config,
+		ClientCapabilities: resource.ValidateConfigClientCapabilities{
+			DeferralAllowed: s.configureClientCapabilities.DeferralAllowed,
+		},
+		Config:           *req.Config,
+		TerraformVersion: s.terraformVersion,
 	}
 
 	if resourceWithConfigValidators, ok := req.Resource.(resource.ResourceWithConfigValidators); ok {
@@ -79,6 +93,7 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 			)
 
 			resp.Diagnostics.Append(vdscResp.Diagnostics...)
+			resp.SuggestedChanges.Append(vdscResp.SuggestedChanges...)
 		}
 	}
 
@@ -94,10 +109,12 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		logging.FrameworkTrace(ctx, "Called provider defined Resource ValidateConfig")
 
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
+		resp.SuggestedChanges.Append(vdscResp.SuggestedChanges...)
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:    *req.Config,
+		Functions: s.FunctionCaller(),
 	}
 	// Instantiate a new response for each request to prevent validators
 	// from modifying or removing diagnostics.
@@ -106,4 +123,6 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
 
 	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+
+	resp.Diagnostics = expandConfigDiagnosticPathExpressions(ctx, *req.Config, resp.Diagnostics)
 }
@@ -5,14 +5,18 @@ package fwserver_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fixit"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -89,6 +93,63 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaAttributeValidatorFunctions := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							result, funcErr := req.Functions.Call(ctx, "upper", req.ConfigValue)
+
+							if funcErr != nil {
+								resp.Diagnostics.AddAttributeError(req.Path, "function error", funcErr.Error())
+
+								return
+							}
+
+							resp.Diagnostics.AddAttributeError(req.Path, "function result", result.String())
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testConfigAttributeValidatorFunctions := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaAttributeValidatorFunctions,
+	}
+
+	testProviderWithFunctions := &testprovider.ProviderWithFunctions{
+		FunctionsMethod: func(_ context.Context) []func() function.Function {
+			return []func() function.Function{
+				func() function.Function {
+					return &testprovider.Function{
+						DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+							resp.Definition = function.Definition{
+								Parameters: []function.Parameter{
+									function.StringParameter{},
+								},
+								Pure:   true,
+								Return: function.StringReturn{},
+							}
+						},
+						MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+							resp.Name = "upper"
+						},
+						RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+							var arg0 string
+
+							resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arg0))
+							resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.ToUpper(arg0)))
+						},
+					}
+				},
+			}
+		},
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateResourceConfigRequest
@@ -150,6 +211,28 @@ func TestServerValidateResourceConfig(t *testing.T) {
 				},
 			},
 		},
+		"request-config-AttributeValidator-Functions": {
+			server: &fwserver.Server{
+				Provider: testProviderWithFunctions,
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigAttributeValidatorFunctions,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaAttributeValidatorFunctions
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"function result",
+						`"TEST-VALUE"`,
+					),
+				},
+			},
+		},
 		"request-config-ResourceWithConfigValidators": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -230,6 +313,69 @@ func TestServerValidateResourceConfig(t *testing.T) {
 					),
 				}},
 		},
+		"request-config-ResourceWithConfigValidators-suggested-changes": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigValidators{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []resource.ConfigValidator {
+						return []resource.ConfigValidator{
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddAttributeError(path.Root("test"), "error summary 1", "error detail 1")
+									resp.SuggestedChanges.Append(fixit.SuggestedChange{
+										Path:  path.Root("test"),
+										Value: types.StringValue("suggested-value-1"),
+									})
+								},
+							},
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddAttributeError(path.Root("test"), "error summary 2", "error detail 2")
+									resp.SuggestedChanges = fixit.SuggestedChanges{
+										{
+											Path:  path.Root("test"),
+											Value: types.StringValue("suggested-value-2"),
+										},
+									}
+								},
+							},
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary 1",
+						"error detail 1",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary 2",
+						"error detail 2",
+					),
+				},
+				SuggestedChanges: fixit.SuggestedChanges{
+					{
+						Path:  path.Root("test"),
+						Value: types.StringValue("suggested-value-1"),
+					},
+					{
+						Path:  path.Root("test"),
+						Value: types.StringValue("suggested-value-2"),
+					},
+				},
+			},
+		},
 		"request-config-ResourceWithValidateConfig": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -289,6 +435,32 @@ func TestServerValidateResourceConfig(t *testing.T) {
 					),
 				}},
 		},
+		"request-config-ResourceWithValidateConfig-diagnostic-path-expression": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithValidateConfig{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ValidateConfigMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+						resp.Diagnostics.AddAttributeErrorForExpression(path.MatchRoot("test"), "error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"error summary",
+						"error detail",
+					),
+				}},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -306,3 +478,96 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestServerValidateResourceConfig_ConfigureProvider(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testCases := map[string]struct {
+		configureRequest *provider.ConfigureRequest
+		expected         resource.ValidateConfigRequest
+	}{
+		"unconfigured": {
+			expected: resource.ValidateConfigRequest{
+				Config: testConfig,
+			},
+		},
+		"configured": {
+			configureRequest: &provider.ConfigureRequest{
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: true,
+				},
+				TerraformVersion: "1.9.0",
+			},
+			expected: resource.ValidateConfigRequest{
+				ClientCapabilities: resource.ValidateConfigClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:           testConfig,
+				TerraformVersion: "1.9.0",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			if testCase.configureRequest != nil {
+				configureResponse := &provider.ConfigureResponse{}
+				server.ConfigureProvider(context.Background(), testCase.configureRequest, configureResponse)
+
+				if configureResponse.Diagnostics.HasError() {
+					t.Fatalf("unexpected configure diagnostics: %+v", configureResponse.Diagnostics)
+				}
+			}
+
+			var got resource.ValidateConfigRequest
+
+			request := &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithValidateConfig{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ValidateConfigMethod: func(_ context.Context, req resource.ValidateConfigRequest, _ *resource.ValidateConfigResponse) {
+						got = req
+					},
+				},
+			}
+
+			response := &fwserver.ValidateResourceConfigResponse{}
+			server.ValidateResourceConfig(context.Background(), request, response)
+
+			if diff := cmp.Diff(response, &fwserver.ValidateResourceConfigResponse{}); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected request difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"sort"
+)
+
+// sortedKeys returns the keys of the map in sorted order. Schema attributes,
+// blocks, and map elements are processed in this order so plan modification,
+// validation, and their diagnostics are deterministic.
+func sortedKeys[M ~map[string]V, V any](m M) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
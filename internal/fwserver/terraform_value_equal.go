@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// schemaTerraformValueEqual returns true if the given resource values of the
+// schema are equal. Top level attributes with types implementing
+// xattr.TypeWithTerraformValueEqual are compared with that method, while all
+// other values are compared with tftypes.Value Equal.
+func schemaTerraformValueEqual(ctx context.Context, schema fwschema.Schema, a tftypes.Value, b tftypes.Value) bool {
+	aAttributes, bAttributes, ok := terraformObjectAttributes(a, b)
+
+	if !ok {
+		return a.Equal(b)
+	}
+
+	for name := range aAttributes {
+		if !schemaAttributeTerraformValueEqual(ctx, schema, name, aAttributes[name], bAttributes[name]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// schemaAttributeTerraformValueEqual returns true if the given values of the
+// top level attribute or block are equal.
+func schemaAttributeTerraformValueEqual(ctx context.Context, schema fwschema.Schema, name string, a tftypes.Value, b tftypes.Value) bool {
+	attribute, ok := schema.GetAttributes()[name]
+
+	if !ok {
+		return a.Equal(b)
+	}
+
+	typeWithTerraformValueEqual, ok := attribute.GetType().(xattr.TypeWithTerraformValueEqual)
+
+	if !ok || a.IsNull() || b.IsNull() || !a.IsKnown() || !b.IsKnown() || !a.Type().Equal(b.Type()) {
+		return a.Equal(b)
+	}
+
+	logging.FrameworkTrace(ctx, "Attribute type implements TypeWithTerraformValueEqual, calling TerraformValueEqual", map[string]interface{}{logging.KeyAttributePath: name})
+
+	return typeWithTerraformValueEqual.TerraformValueEqual(ctx, a, b)
+}
+
+// terraformObjectAttributes returns the attribute values of both object
+// values, if they are known, non-null objects with the same type.
+func terraformObjectAttributes(a tftypes.Value, b tftypes.Value) (map[string]tftypes.Value, map[string]tftypes.Value, bool) {
+	if a.IsNull() || b.IsNull() || !a.IsKnown() || !b.IsKnown() || !a.Type().Equal(b.Type()) || !a.Type().Is(tftypes.Object{}) {
+		return nil, nil, false
+	}
+
+	var aAttributes, bAttributes map[string]tftypes.Value
+
+	if err := a.As(&aAttributes); err != nil {
+		return nil, nil, false
+	}
+
+	if err := b.As(&bAttributes); err != nil {
+		return nil, nil, false
+	}
+
+	return aAttributes, bAttributes, true
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ xattr.TypeWithTerraformValueEqual = testPrefixEqualType{}
+
+// testPrefixEqualType is a string type which only compares the first
+// character of values, to verify TerraformValueEqual is called.
+type testPrefixEqualType struct {
+	basetypes.StringType
+}
+
+func (t testPrefixEqualType) Equal(o attr.Type) bool {
+	_, ok := o.(testPrefixEqualType)
+
+	return ok
+}
+
+func (t testPrefixEqualType) TerraformValueEqual(_ context.Context, a tftypes.Value, b tftypes.Value) bool {
+	var aString, bString string
+
+	_ = a.As(&aString)
+	_ = b.As(&bString)
+
+	return aString[:1] == bString[:1]
+}
+
+func TestSchemaTerraformValueEqual(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_custom": testschema.Attribute{
+				Optional: true,
+				Type:     testPrefixEqualType{},
+			},
+			"test_string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_custom": tftypes.String,
+			"test_string": tftypes.String,
+		},
+	}
+
+	testValue := func(custom interface{}, str interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_custom": tftypes.NewValue(tftypes.String, custom),
+			"test_string": tftypes.NewValue(tftypes.String, str),
+		})
+	}
+
+	testCases := map[string]struct {
+		a        tftypes.Value
+		b        tftypes.Value
+		expected bool
+	}{
+		"equal": {
+			a:        testValue("test-value", "test-value"),
+			b:        testValue("test-value", "test-value"),
+			expected: true,
+		},
+		"custom-terraformvalueequal-true": {
+			a:        testValue("test-value", "test-value"),
+			b:        testValue("test-other-value", "test-value"),
+			expected: true,
+		},
+		"custom-terraformvalueequal-false": {
+			a:        testValue("test-value", "test-value"),
+			b:        testValue("other-value", "test-value"),
+			expected: false,
+		},
+		"custom-null": {
+			a:        testValue("test-value", "test-value"),
+			b:        testValue(nil, "test-value"),
+			expected: false,
+		},
+		"custom-unknown": {
+			a:        testValue("test-value", "test-value"),
+			b:        testValue(tftypes.UnknownValue, "test-value"),
+			expected: false,
+		},
+		"string-not-equal": {
+			a:        testValue("test-value", "test-value"),
+			b:        testValue("test-value", "test-other-value"),
+			expected: false,
+		},
+		"object-null": {
+			a:        testValue("test-value", "test-value"),
+			b:        tftypes.NewValue(testType, nil),
+			expected: false,
+		},
+		"object-both-null": {
+			a:        tftypes.NewValue(testType, nil),
+			b:        tftypes.NewValue(testType, nil),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemaTerraformValueEqual(context.Background(), testSchema, testCase.a, testCase.b)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -24,6 +24,9 @@ const (
 	// The Deferred reason for an RPC response
 	KeyDeferredReason = "tf_deferred_reason"
 
+	// The Deferred explanation for an RPC response
+	KeyDeferredExplanation = "tf_deferred_explanation"
+
 	// Human readable string when calling a provider defined type that must
 	// implement the Description() method, such as validators.
 	KeyDescription = "description"
@@ -34,6 +37,13 @@ const (
 	// The name of function being operated on, such as "parse_xyz"
 	KeyFunctionName = "tf_function_name"
 
+	// The attempt number when calling provider-defined logic again after
+	// transient errors, starting at 2 for the first retry.
+	KeyRetryAttempt = "tf_retry_attempt"
+
+	// The provider-defined hook point being called, such as "BeforeApply"
+	KeyHookPoint = "tf_hook_point"
+
 	// The type of resource being operated on, such as "random_pet"
 	KeyResourceType = "tf_resource_type"
 
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"unicode/utf8"
 
@@ -245,6 +246,28 @@ type ProviderData struct {
 	data map[string][]byte
 }
 
+// Copy returns a copy of the ProviderData, so changes to either do not
+// affect the other. A nil ProviderData returns nil.
+func (d *ProviderData) Copy() *ProviderData {
+	if d == nil {
+		return nil
+	}
+
+	if d.data == nil {
+		return &ProviderData{}
+	}
+
+	data := make(map[string][]byte, len(d.data))
+
+	for key, value := range d.data {
+		data[key] = slices.Clone(value)
+	}
+
+	return &ProviderData{
+		data: data,
+	}
+}
+
 // Equal returns true if the given ProviderData is exactly equivalent. The
 // internal data is compared byte-for-byte, not accounting for semantic
 // equivalency such as JSON whitespace or property reordering.
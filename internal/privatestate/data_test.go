@@ -556,6 +556,62 @@ func TestNewProviderData(t *testing.T) {
 	}
 }
 
+func TestProviderDataCopy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData *ProviderData
+	}{
+		"nil": {
+			providerData: nil,
+		},
+		"zero": {
+			providerData: &ProviderData{},
+		},
+		"empty": {
+			providerData: EmptyProviderData(context.Background()),
+		},
+		"data": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{"key": "value"}`)}),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.providerData.Copy()
+
+			if !got.Equal(testCase.providerData) {
+				t.Fatalf("expected copy to equal original")
+			}
+
+			if got == nil {
+				return
+			}
+
+			if got == testCase.providerData {
+				t.Fatalf("expected copy to be a different pointer")
+			}
+
+			diags := got.SetKey(context.Background(), "other", []byte(`{}`))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if value, _ := testCase.providerData.GetKey(context.Background(), "other"); value != nil {
+				t.Errorf("expected original to be unaffected, got: %s", value)
+			}
+		})
+	}
+}
+
 func TestProviderDataEqual(t *testing.T) {
 	t.Parallel()
 
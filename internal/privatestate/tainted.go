@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate
+
+// FrameworkKeyTainted is the framework private state key which marks the
+// resource as requiring replacement during the next plan.
+const FrameworkKeyTainted = ".tainted"
+
+// IsTainted returns true if the resource was marked as requiring replacement.
+func (d *Data) IsTainted() bool {
+	if d == nil {
+		return false
+	}
+
+	return len(d.Framework[FrameworkKeyTainted]) > 0
+}
+
+// SetTainted marks the resource as requiring replacement.
+func (d *Data) SetTainted() {
+	if d.Framework == nil {
+		d.Framework = make(map[string][]byte, 1)
+	}
+
+	d.Framework[FrameworkKeyTainted] = []byte("true")
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatestate
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDataIsTainted(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data     *Data
+		expected bool
+	}{
+		"nil": {
+			data:     nil,
+			expected: false,
+		},
+		"empty": {
+			data:     EmptyData(context.Background()),
+			expected: false,
+		},
+		"tainted": {
+			data: &Data{
+				Framework: map[string][]byte{
+					".tainted": []byte("true"),
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.data.IsTainted()
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDataSetTainted(t *testing.T) {
+	t.Parallel()
+
+	data := EmptyData(context.Background())
+
+	data.SetTainted()
+
+	if !data.IsTainted() {
+		t.Fatal("expected data to be tainted")
+	}
+
+	got, diags := data.Bytes(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if string(got) != `{".tainted":"dHJ1ZQ=="}` {
+		t.Errorf("unexpected bytes: %s", got)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -17,6 +18,10 @@ import (
 func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:              "ApplyResourceChange",
+		ResourceTypeName: proto5Req.TypeName,
+	})
 
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
@@ -44,7 +49,15 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto5Req *tfprotov5.A
 		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
 	}
 
-	fwReq, diags := fromproto5.ApplyResourceChangeRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema)
+	resourceBehavior, diags := s.FrameworkServer.ResourceBehavior(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ApplyResourceChangeResponse(ctx, fwResp), nil
+	}
+
+	fwReq, diags := fromproto5.ApplyResourceChangeRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema, resourceBehavior)
 
 	fwResp.Diagnostics.Append(diags...)
 
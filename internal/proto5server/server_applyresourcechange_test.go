@@ -915,6 +915,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -975,6 +979,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1034,6 +1042,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-config-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1099,6 +1111,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName:     "test_resource",
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1168,6 +1184,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				}),
 			},
 			expectedResponse: &tfprotov5.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1433,3 +1453,20 @@ func TestServerApplyResourceChange(t *testing.T) {
 		})
 	}
 }
+
+// testInconsistentResultDiagnostic returns the diagnostic for a new state
+// attribute value which does not match the planned value.
+func testInconsistentResultDiagnostic(attributeName string, operation string, plannedValue string, newStateValue string) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Provider Produced Inconsistent Result",
+		Detail: "After the resource " + operation + " logic, the new state value does not match the planned value. " +
+			"Terraform will return an error about the provider producing an inconsistent result. " +
+			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+			"Known planned values must be saved into the new state unchanged. " +
+			"Provider developers should either set the new state value to the planned value, " +
+			"or mark the value as unknown during planning, such as by not using a plan modifier or default value which sets it.\n\n" +
+			"Planned Value: " + plannedValue + "\nNew State Value: " + newStateValue,
+		Attribute: tftypes.NewAttributePath().WithAttributeName(attributeName),
+	}
+}
@@ -13,12 +13,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 )
 
 // CallFunction satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) CallFunction(ctx context.Context, protoReq *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:          "CallFunction",
+		FunctionName: protoReq.Name,
+	})
 
 	fwResp := &fwserver.CallFunctionResponse{}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -17,6 +18,10 @@ import (
 func (s *Server) CloseEphemeralResource(ctx context.Context, proto5Req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:                       "CloseEphemeralResource",
+		EphemeralResourceTypeName: proto5Req.TypeName,
+	})
 
 	fwResp := &fwserver.CloseEphemeralResourceResponse{}
 
@@ -9,15 +9,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 )
 
 // ConfigureProvider satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) ConfigureProvider(ctx context.Context, proto5Req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC: "ConfigureProvider",
+	})
 
 	fwResp := &provider.ConfigureResponse{}
 
@@ -39,5 +44,8 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto5Req *tfprotov5.Con
 
 	s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
 
+	// Apply the newly configured warning suppressions to this response.
+	ctx = fwcontext.WithSuppressedWarnings(ctx, s.FrameworkServer.SuppressedWarnings())
+
 	return toproto5.ConfigureProviderResponse(ctx, fwResp), nil
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -150,6 +151,30 @@ func TestServerConfigureProvider(t *testing.T) {
 				},
 			},
 		},
+		"response-diagnostics-suppressed-warnings": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.Diagnostics.AddWarningWithKey("test_key", "suppressed summary", "suppressed detail")
+							resp.Diagnostics.AddWarningWithKey("other_key", "warning summary", "warning detail")
+							resp.SuppressedWarnings = diag.SuppressedWarnings{"test_key"}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.ConfigureProviderRequest{},
+			expectedResponse: &tfprotov5.ConfigureProviderResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "warning summary",
+						Detail:   "warning detail",
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
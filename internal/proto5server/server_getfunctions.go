@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -17,6 +18,9 @@ import (
 func (s *Server) GetFunctions(ctx context.Context, protoReq *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC: "GetFunctions",
+	})
 
 	fwReq := fromproto5.GetFunctionsRequest(ctx, protoReq)
 	fwResp := &fwserver.GetFunctionsResponse{}
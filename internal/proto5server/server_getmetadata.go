@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -17,6 +18,9 @@ import (
 func (s *Server) GetMetadata(ctx context.Context, proto6Req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC: "GetMetadata",
+	})
 
 	fwReq := fromproto5.GetMetadataRequest(ctx, proto6Req)
 	fwResp := &fwserver.GetMetadataResponse{}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -24,6 +25,11 @@ func (s *Server) MoveResourceState(ctx context.Context, proto5Req *tfprotov5.Mov
 		return toproto5.MoveResourceStateResponse(ctx, fwResp), nil
 	}
 
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:              "MoveResourceState",
+		ResourceTypeName: proto5Req.TargetTypeName,
+	})
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TargetTypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -12,12 +12,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 )
 
 // PlanResourceChange satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:              "PlanResourceChange",
+		Operation:        requestinfo.OperationPlan,
+		ResourceTypeName: proto5Req.TypeName,
+	})
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
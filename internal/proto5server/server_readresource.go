@@ -12,12 +12,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 )
 
 // ReadResource satisfies the tfprotov5.ProviderServer interface.
 func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:              "ReadResource",
+		Operation:        requestinfo.OperationRead,
+		ResourceTypeName: proto5Req.TypeName,
+	})
 
 	fwResp := &fwserver.ReadResourceResponse{}
 
@@ -45,7 +51,15 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 		return toproto5.ReadResourceResponse(ctx, fwResp), nil
 	}
 
-	fwReq, diags := fromproto5.ReadResourceRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema)
+	resourceBehavior, diags := s.FrameworkServer.ResourceBehavior(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+	}
+
+	fwReq, diags := fromproto5.ReadResourceRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema, resourceBehavior)
 
 	fwResp.Diagnostics.Append(diags...)
 
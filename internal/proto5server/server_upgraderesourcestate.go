@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -24,6 +25,11 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:              "UpgradeResourceState",
+		ResourceTypeName: proto5Req.TypeName,
+	})
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -40,7 +46,15 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
-	fwReq, diags := fromproto5.UpgradeResourceStateRequest(ctx, proto5Req, resource, resourceSchema)
+	resourceBehavior, diags := s.FrameworkServer.ResourceBehavior(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+	}
+
+	fwReq, diags := fromproto5.UpgradeResourceStateRequest(ctx, proto5Req, resource, resourceSchema, resourceBehavior)
 
 	fwResp.Diagnostics.Append(diags...)
 
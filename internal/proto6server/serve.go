@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -23,6 +24,10 @@ type Server struct {
 
 func (s *Server) registerContext(in context.Context) context.Context {
 	ctx, cancel := context.WithCancel(in)
+	ctx = fwcontext.WithDiagnosticMessageCatalog(ctx, s.FrameworkServer.DiagnosticMessageCatalog(ctx))
+	ctx = fwcontext.WithDiagnosticLimits(ctx, s.FrameworkServer.DiagnosticLimits(ctx))
+	ctx = fwcontext.WithSuppressedWarnings(ctx, s.FrameworkServer.SuppressedWarnings())
+
 	s.contextCancelsMu.Lock()
 	defer s.contextCancelsMu.Unlock()
 	s.contextCancels = append(s.contextCancels, cancel)
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -320,6 +321,67 @@ func TestServerApplyResourceChange(t *testing.T) {
 				NewState: &testEmptyDynamicValue,
 			},
 		},
+		"create-request-info": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+											info, ok := requestinfo.FromContext(ctx)
+
+											resp.Diagnostics.AddWarning("request info", fmt.Sprintf("%t %s %s %s", ok, info.RPC, info.Operation, info.ResourceTypeName))
+											resp.Diagnostics.AddError("error summary", "error detail")
+										},
+										DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+											resp.Diagnostics.AddError("Unexpected Method Call", "Expected: Create, Got: Delete")
+										},
+										UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+											resp.Diagnostics.AddError("Unexpected Method Call", "Expected: Create, Got: Update")
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ApplyResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PriorState: &testEmptyDynamicValue,
+				TypeName:   "test_resource",
+			},
+			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "request info",
+						Detail:   "true ApplyResourceChange Create test_resource",
+					},
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "error summary",
+						Detail:   "error detail",
+					},
+				},
+				NewState: &testEmptyDynamicValue,
+			},
+		},
 		"create-response-newstate": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -915,6 +977,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -975,6 +1041,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1034,6 +1104,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName: "test_resource",
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-config-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1099,6 +1173,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				TypeName:     "test_resource",
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1168,6 +1246,10 @@ func TestServerApplyResourceChange(t *testing.T) {
 				}),
 			},
 			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					testInconsistentResultDiagnostic("test_computed", "Update", `tftypes.String<"test-plannedstate-value">`, "tftypes.String<null>"),
+					testInconsistentResultDiagnostic("test_required", "Update", `tftypes.String<"test-new-value">`, `tftypes.String<"test-old-value">`),
+				},
 				// Intentionally old, Update implementation does not call resp.State.Set()
 				NewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -1433,3 +1515,20 @@ func TestServerApplyResourceChange(t *testing.T) {
 		})
 	}
 }
+
+// testInconsistentResultDiagnostic returns the diagnostic for a new state
+// attribute value which does not match the planned value.
+func testInconsistentResultDiagnostic(attributeName string, operation string, plannedValue string, newStateValue string) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Provider Produced Inconsistent Result",
+		Detail: "After the resource " + operation + " logic, the new state value does not match the planned value. " +
+			"Terraform will return an error about the provider producing an inconsistent result. " +
+			"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n" +
+			"Known planned values must be saved into the new state unchanged. " +
+			"Provider developers should either set the new state value to the planned value, " +
+			"or mark the value as unknown during planning, such as by not using a plan modifier or default value which sets it.\n\n" +
+			"Planned Value: " + plannedValue + "\nNew State Value: " + newStateValue,
+		Attribute: tftypes.NewAttributePath().WithAttributeName(attributeName),
+	}
+}
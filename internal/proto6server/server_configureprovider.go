@@ -7,9 +7,11 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -17,6 +19,9 @@ import (
 func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC: "ConfigureProvider",
+	})
 
 	fwResp := &provider.ConfigureResponse{}
 
@@ -38,5 +43,8 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.Con
 
 	s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
 
+	// Apply the newly configured warning suppressions to this response.
+	ctx = fwcontext.WithSuppressedWarnings(ctx, s.FrameworkServer.SuppressedWarnings())
+
 	return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
 }
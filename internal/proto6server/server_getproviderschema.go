@@ -7,9 +7,11 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -17,11 +19,16 @@ import (
 func (s *Server) GetProviderSchema(ctx context.Context, proto6Req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC: "GetProviderSchema",
+	})
 
 	fwReq := fromproto6.GetProviderSchemaRequest(ctx, proto6Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
+	ctx = fwcontext.WithMarkdownDescriptionTemplates(ctx, s.FrameworkServer.MarkdownDescriptionTemplates(ctx))
+
 	return toproto6.GetProviderSchemaResponse(ctx, fwResp), nil
 }
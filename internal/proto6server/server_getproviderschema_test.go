@@ -1026,3 +1026,77 @@ func TestServerGetProviderSchema_logging(t *testing.T) {
 		t.Errorf("unexpected difference: %s", diff)
 	}
 }
+
+func TestServerGetProviderSchema_MarkdownDescriptionTemplates(t *testing.T) {
+	t.Parallel()
+
+	testProvider := &testprovider.Provider{
+		SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+			resp.Schema = providerschema.Schema{
+				Attributes: map[string]providerschema.Attribute{
+					"test": providerschema.StringAttribute{
+						MarkdownDescription: "Test description.{{ if .Sensitive }} Sensitive.{{ end }}",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		provider provider.Provider
+		expected string
+	}{
+		"not-implemented": {
+			provider: testProvider,
+			expected: "Test description.{{ if .Sensitive }} Sensitive.{{ end }}",
+		},
+		"disabled": {
+			provider: &testprovider.ProviderWithMarkdownDescriptionTemplates{
+				Provider: testProvider,
+				MarkdownDescriptionTemplatesMethod: func(_ context.Context) bool {
+					return false
+				},
+			},
+			expected: "Test description.{{ if .Sensitive }} Sensitive.{{ end }}",
+		},
+		"enabled": {
+			provider: &testprovider.ProviderWithMarkdownDescriptionTemplates{
+				Provider: testProvider,
+				MarkdownDescriptionTemplatesMethod: func(_ context.Context) bool {
+					return true
+				},
+			},
+			expected: "Test description. Sensitive.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testCase.provider,
+				},
+			}
+
+			got, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", got.Diagnostics)
+			}
+
+			if diff := cmp.Diff(got.Provider.Block.Attributes[0].Description, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -24,6 +25,11 @@ func (s *Server) MoveResourceState(ctx context.Context, proto6Req *tfprotov6.Mov
 		return toproto6.MoveResourceStateResponse(ctx, fwResp), nil
 	}
 
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:              "MoveResourceState",
+		ResourceTypeName: proto6Req.TargetTypeName,
+	})
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TargetTypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -12,12 +12,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 )
 
 // PlanResourceChange satisfies the tfprotov6.ProviderServer interface.
 func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:              "PlanResourceChange",
+		Operation:        requestinfo.OperationPlan,
+		ResourceTypeName: proto6Req.TypeName,
+	})
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -24,6 +25,11 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
+	ctx = requestinfo.NewContext(ctx, requestinfo.Info{
+		RPC:              "UpgradeResourceState",
+		ResourceTypeName: proto6Req.TypeName,
+	})
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
@@ -40,7 +46,15 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
 	}
 
-	fwReq, diags := fromproto6.UpgradeResourceStateRequest(ctx, proto6Req, resource, resourceSchema)
+	resourceBehavior, diags := s.FrameworkServer.ResourceBehavior(ctx, proto6Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+	}
+
+	fwReq, diags := fromproto6.UpgradeResourceStateRequest(ctx, proto6Req, resource, resourceSchema, resourceBehavior)
 
 	fwResp.Diagnostics.Append(diags...)
 
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// convertAttributeValue attempts to convert the Terraform value into the
+// target attr.Value type when the target type shares the same Terraform type
+// as the schema type, such as a custom string value into a types.String or
+// vice versa. This enables models to use base types when the schema uses
+// custom types, and vice versa.
+//
+// The conversion is performed by the ValueFromTerraform method of the target
+// value attr.Type rather than the basetypes Valuable and Typable interfaces,
+// since this package cannot import the basetypes package without an import
+// cycle. Element and attribute types of the target attr.Type are copied from
+// the schema type, since they cannot be determined from the zero value.
+//
+// The returned bool is false if the conversion is not supported.
+func convertAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, targetType reflect.Type, path path.Path) (reflect.Value, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Only struct value types are supported, since the target value type
+	// must be instantiated to determine its attr.Type.
+	if targetType.Kind() != reflect.Struct {
+		return reflect.Value{}, false, diags
+	}
+
+	targetZero, ok := reflect.Zero(targetType).Interface().(attr.Value)
+
+	if !ok {
+		return reflect.Value{}, false, diags
+	}
+
+	targetAttrType := targetZero.Type(ctx)
+
+	if targetAttrType == nil {
+		return reflect.Value{}, false, diags
+	}
+
+	switch t := targetAttrType.(type) {
+	case attr.TypeWithElementType:
+		if s, ok := typ.(attr.TypeWithElementType); ok {
+			targetAttrType = t.WithElementType(s.ElementType())
+		}
+	case attr.TypeWithElementTypes:
+		if s, ok := typ.(attr.TypeWithElementTypes); ok {
+			targetAttrType = t.WithElementTypes(s.ElementTypes())
+		}
+	case attr.TypeWithAttributeTypes:
+		if s, ok := typ.(attr.TypeWithAttributeTypes); ok {
+			targetAttrType = t.WithAttributeTypes(s.AttributeTypes())
+		}
+	}
+
+	if !targetAttrType.TerraformType(ctx).Equal(typ.TerraformType(ctx)) {
+		return reflect.Value{}, false, diags
+	}
+
+	targetAttrValue, err := targetAttrType.ValueFromTerraform(ctx, val)
+
+	if err != nil {
+		return reflect.Value{}, true, append(diags, valueFromTerraformErrorDiag(err, path))
+	}
+
+	targetValue := reflect.ValueOf(targetAttrValue)
+
+	if !targetValue.IsValid() || targetValue.Type() != targetType {
+		return reflect.Value{}, false, diags
+	}
+
+	if validateable, ok := targetAttrValue.(xattr.ValidateableAttribute); ok {
+		resp := xattr.ValidateAttributeResponse{}
+
+		validateable.ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: path}, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if diags.HasError() {
+			return reflect.Value{}, true, diags
+		}
+	}
+
+	return targetValue, true, diags
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// SnakeCaseFieldNames can be embedded in a struct to enable automatic mapping
+// of struct field names to snake case attribute names, such as the APIToken
+// field to the api_token attribute. Fields with a "tfsdk" tag use the tag
+// instead. The mapping also applies to fields of embedded structs.
+type SnakeCaseFieldNames struct{}
+
+var snakeCaseFieldNamesType = reflect.TypeOf(SnakeCaseFieldNames{})
+
+// embedsSnakeCaseFieldNames returns true if the struct type directly embeds
+// SnakeCaseFieldNames.
+func embedsSnakeCaseFieldNames(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.Anonymous && field.Type == snakeCaseFieldNamesType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// snakeCase returns the snake case of a Go identifier, treating consecutive
+// uppercase letters as a single word, such as APIToken to api_token and
+// HTTPSPort to https_port.
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteRune('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
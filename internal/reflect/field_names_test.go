@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"testing"
+)
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"":             "",
+		"A":            "a",
+		"ID":           "id",
+		"Name":         "name",
+		"UserID":       "user_id",
+		"APIToken":     "api_token",
+		"HTTPSPort":    "https_port",
+		"Sha256Digest": "sha256_digest",
+		"Ipv4Address":  "ipv4_address",
+		"Field2":       "field2",
+		"lowerCamel":   "lower_camel",
+	}
+
+	for name, expected := range testCases {
+		name, expected := name, expected
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := snakeCase(name); got != expected {
+				t.Errorf("expected %q, got %q", expected, got)
+			}
+		})
+	}
+}
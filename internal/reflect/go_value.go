@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Unknown is the Go value representation of an unknown value returned by
+// GoValue.
+type Unknown struct{}
+
+// String returns a human-readable representation of the unknown value.
+func (u Unknown) String() string {
+	return "<unknown>"
+}
+
+// AttributeGoValue returns the plain Go value representation of the
+// attr.Value. Refer to GoValue for the conversion rules.
+func AttributeGoValue(ctx context.Context, val attr.Value, path path.Path) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if val == nil {
+		return nil, diags
+	}
+
+	tfVal, err := val.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.Append(toTerraformValueErrorDiag(err, path))
+
+		return nil, diags
+	}
+
+	return GoValue(ctx, tfVal, path)
+}
+
+// GoValue returns the plain Go value representation of the tftypes.Value,
+// which is intended for passing data to JSON-based logic without reflecting
+// into a defined Go type. The conversion rules are:
+//
+//   - Null values are nil.
+//   - Unknown values are Unknown.
+//   - Bool values are bool.
+//   - Number values are int64 if they are an integer within the int64 range,
+//     float64 if they can be exactly represented as a float64, otherwise
+//     *big.Float.
+//   - String values are string.
+//   - List, Set, and Tuple values are []any.
+//   - Map and Object values are map[string]any.
+func GoValue(ctx context.Context, val tftypes.Value, path path.Path) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !val.IsKnown() {
+		return Unknown{}, diags
+	}
+
+	if val.IsNull() {
+		return nil, diags
+	}
+
+	typ := val.Type()
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		if err := val.As(&b); err != nil {
+			diags.Append(goValueErrorDiag(err, path))
+
+			return nil, diags
+		}
+
+		return b, diags
+	case typ.Is(tftypes.Number):
+		var bf big.Float
+
+		if err := val.As(&bf); err != nil {
+			diags.Append(goValueErrorDiag(err, path))
+
+			return nil, diags
+		}
+
+		return goNumber(&bf), diags
+	case typ.Is(tftypes.String):
+		var s string
+
+		if err := val.As(&s); err != nil {
+			diags.Append(goValueErrorDiag(err, path))
+
+			return nil, diags
+		}
+
+		return s, diags
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			diags.Append(goValueErrorDiag(err, path))
+
+			return nil, diags
+		}
+
+		result := make([]any, 0, len(elems))
+
+		for index, elem := range elems {
+			// Set element paths require the framework value, so errors
+			// under sets are reported at the set path.
+			elemPath := path
+
+			if !typ.Is(tftypes.Set{}) {
+				elemPath = path.AtListIndex(index)
+			}
+
+			goElem, elemDiags := GoValue(ctx, elem, elemPath)
+
+			diags.Append(elemDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			result = append(result, goElem)
+		}
+
+		return result, diags
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			diags.Append(goValueErrorDiag(err, path))
+
+			return nil, diags
+		}
+
+		result := make(map[string]any, len(elems))
+
+		for key, elem := range elems {
+			elemPath := path.AtMapKey(key)
+
+			if typ.Is(tftypes.Object{}) {
+				elemPath = path.AtName(key)
+			}
+
+			goElem, elemDiags := GoValue(ctx, elem, elemPath)
+
+			diags.Append(elemDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			result[key] = goElem
+		}
+
+		return result, diags
+	default:
+		diags.Append(goValueErrorDiag(fmt.Errorf("unsupported type %s", typ), path))
+
+		return nil, diags
+	}
+}
+
+// goNumber returns the int64, float64, or *big.Float representation of the
+// number, preferring the simplest type which does not lose precision.
+func goNumber(bf *big.Float) any {
+	if bf.IsInt() {
+		if i, accuracy := bf.Int64(); accuracy == big.Exact {
+			return i
+		}
+	}
+
+	if f, accuracy := bf.Float64(); accuracy == big.Exact && !math.IsInf(f, 0) {
+		return f
+	}
+
+	return bf
+}
+
+func goValueErrorDiag(err error, path path.Path) diag.DiagnosticWithPath {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Value Conversion Error",
+		"An unexpected error was encountered trying to convert the Terraform value into a Go value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+	)
+}
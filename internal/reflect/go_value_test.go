@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestGoValue(t *testing.T) {
+	t.Parallel()
+
+	bigNumber, _, _ := big.ParseFloat("1e400", 10, 512, big.ToNearestEven)
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: nil,
+		},
+		"unknown": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: refl.Unknown{},
+		},
+		"bool": {
+			val:      tftypes.NewValue(tftypes.Bool, true),
+			expected: true,
+		},
+		"number-integer": {
+			val:      tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
+			expected: int64(123),
+		},
+		"number-float": {
+			val:      tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			expected: float64(1.5),
+		},
+		"number-big": {
+			val:      tftypes.NewValue(tftypes.Number, bigNumber),
+			expected: bigNumber,
+		},
+		"string": {
+			val:      tftypes.NewValue(tftypes.String, "test"),
+			expected: "test",
+		},
+		"list": {
+			val: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.String},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, nil),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			),
+			expected: []any{"one", nil, refl.Unknown{}},
+		},
+		"set": {
+			val: tftypes.NewValue(
+				tftypes.Set{ElementType: tftypes.Bool},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Bool, true),
+				},
+			),
+			expected: []any{true},
+		},
+		"tuple": {
+			val: tftypes.NewValue(
+				tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.Number, big.NewFloat(2)),
+				},
+			),
+			expected: []any{"one", int64(2)},
+		},
+		"map": {
+			val: tftypes.NewValue(
+				tftypes.Map{ElementType: tftypes.String},
+				map[string]tftypes.Value{
+					"key": tftypes.NewValue(tftypes.String, "value"),
+				},
+			),
+			expected: map[string]any{"key": "value"},
+		},
+		"object": {
+			val: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"nested": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"string": tftypes.String,
+							},
+						},
+						"number": tftypes.Number,
+					},
+				},
+				map[string]tftypes.Value{
+					"nested": tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"string": tftypes.String,
+							},
+						},
+						map[string]tftypes.Value{
+							"string": tftypes.NewValue(tftypes.String, "test"),
+						},
+					),
+					"number": tftypes.NewValue(tftypes.Number, nil),
+				},
+			),
+			expected: map[string]any{
+				"nested": map[string]any{
+					"string": "test",
+				},
+				"number": nil,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.GoValue(context.Background(), testCase.val, path.Empty())
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.Comparer(func(x, y *big.Float) bool { return x.Cmp(y) == 0 })); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -58,7 +58,14 @@ func commaSeparatedString(in []string) string {
 //   - Duplicate "tfsdk" tags
 //   - Exported fields without a "tfsdk" tag
 //   - Exported fields with an invalid "tfsdk" tag (must be a valid Terraform identifier)
-func getStructTags(ctx context.Context, typ reflect.Type, path path.Path) (map[string][]int, error) { //nolint:unparam // False positive, ctx is used below.
+//
+// If the struct, or a struct it is embedded in, embeds SnakeCaseFieldNames,
+// fields without a "tfsdk" tag use the snake case of the field name instead.
+func getStructTags(ctx context.Context, typ reflect.Type, path path.Path) (map[string][]int, error) {
+	return getStructTagsWithFieldNames(ctx, typ, path, false)
+}
+
+func getStructTagsWithFieldNames(ctx context.Context, typ reflect.Type, path path.Path, snakeCaseFieldNames bool) (map[string][]int, error) { //nolint:unparam // False positive, ctx is used below.
 	tags := make(map[string][]int, 0)
 
 	if typ.Kind() == reflect.Pointer {
@@ -68,8 +75,16 @@ func getStructTags(ctx context.Context, typ reflect.Type, path path.Path) (map[s
 		return nil, fmt.Errorf("%s: can't get struct tags of %s, is not a struct", path, typ)
 	}
 
+	if !snakeCaseFieldNames {
+		snakeCaseFieldNames = embedsSnakeCaseFieldNames(typ)
+	}
+
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
+
+		if field.Anonymous && field.Type == snakeCaseFieldNamesType {
+			continue
+		}
 		if !field.IsExported() && !field.Anonymous {
 			// Skip unexported fields. Unexported embedded structs (anonymous fields) are allowed because they may
 			// contain exported fields that are promoted; which means they can be read/set.
@@ -92,7 +107,7 @@ func getStructTags(ctx context.Context, typ reflect.Type, path path.Path) (map[s
 				return nil, fmt.Errorf(`%s: embedded struct field %s cannot have tfsdk tag`, path.AtName(tag), field.Name)
 			}
 
-			embeddedTags, err := getStructTags(ctx, field.Type, path)
+			embeddedTags, err := getStructTagsWithFieldNames(ctx, field.Type, path, snakeCaseFieldNames)
 			if err != nil {
 				return nil, fmt.Errorf(`error retrieving embedded struct %q field tags: %w`, field.Name, err)
 			}
@@ -107,9 +122,14 @@ func getStructTags(ctx context.Context, typ reflect.Type, path path.Path) (map[s
 			continue
 		}
 
-		// All non-embedded fields must have a tfsdk tag
+		// All non-embedded fields must have a tfsdk tag, unless the field
+		// name is automatically converted to snake case.
 		if !tagExists {
-			return nil, fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, field.Name)
+			if !snakeCaseFieldNames {
+				return nil, fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, field.Name)
+			}
+
+			tag = snakeCase(field.Name)
 		}
 
 		// Ensure the tfsdk tag has a valid name
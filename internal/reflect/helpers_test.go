@@ -44,6 +44,10 @@ type StructWithInvalidTag struct {
 	InvalidField string `tfsdk:"*()-"`
 }
 
+type EmbeddedWithoutTags struct {
+	EmbeddedField string
+}
+
 func TestGetStructTags(t *testing.T) {
 	t.Parallel()
 
@@ -96,6 +100,45 @@ func TestGetStructTags(t *testing.T) {
 			}{},
 			expectedErr: errors.New(`: invalid tfsdk tag, must only use lowercase letters, underscores, and numbers, and must start with a letter`),
 		},
+		"snake-case-field-names": {
+			in: struct {
+				SnakeCaseFieldNames
+				APIToken  string
+				Name      string
+				Overrides string `tfsdk:"custom"`
+				Ignored   string `tfsdk:"-"`
+			}{},
+			expectedTags: map[string][]int{
+				"api_token": {1},
+				"name":      {2},
+				"custom":    {3},
+			},
+		},
+		"snake-case-field-names-embedded-struct": {
+			in: struct {
+				SnakeCaseFieldNames
+				EmbeddedWithoutTags
+				HTTPSPort int64
+			}{},
+			expectedTags: map[string][]int{
+				"embedded_field": {1, 0},
+				"https_port":     {2},
+			},
+		},
+		"snake-case-field-names-err-duplicate-fields": {
+			in: struct {
+				SnakeCaseFieldNames
+				StrField      string
+				OtherStrField string `tfsdk:"str_field"`
+			}{},
+			expectedErr: errors.New(`str_field: can't use tfsdk tag "str_field" for both StrField and OtherStrField fields`),
+		},
+		"snake-case-field-names-err-missing-tfsdk-tag-embedded-struct": {
+			in: struct {
+				EmbeddedWithoutTags
+			}{},
+			expectedErr: errors.New(`error retrieving embedded struct "EmbeddedWithoutTags" field tags: : need a struct tag for "tfsdk" on EmbeddedField`),
+		},
 		"ignore-embedded-struct": {
 			in: struct {
 				ExampleStruct `tfsdk:"-"`
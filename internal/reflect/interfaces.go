@@ -377,8 +377,10 @@ func FromValueCreator(ctx context.Context, typ attr.Type, val tftypes.ValueCreat
 }
 
 // NewAttributeValue creates a new reflect.Value by calling the
-// ValueFromTerraform method on `typ`. It will return an error if the returned
-// `attr.Value` is not the same type as `target`.
+// ValueFromTerraform method on `typ`. If the returned `attr.Value` is not the
+// same type as `target`, it is converted when both share the same Terraform
+// type, such as a custom string type and types.String. Otherwise, it will
+// return an error.
 //
 // It is meant to be called through Into, not directly.
 func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
@@ -417,6 +419,18 @@ func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 	}
 
 	if reflect.TypeOf(res) != target.Type() {
+		converted, ok, convertDiags := convertAttributeValue(ctx, typ, val, target.Type(), path)
+
+		diags.Append(convertDiags...)
+
+		if diags.HasError() {
+			return target, diags
+		}
+
+		if ok {
+			return converted, diags
+		}
+
 		diags.Append(diag.WithPath(path, DiagNewAttributeValueIntoWrongType{
 			ValType:    reflect.TypeOf(res),
 			TargetType: target.Type(),
@@ -533,6 +533,66 @@ func TestNewAttributeValue(t *testing.T) {
 			target:   reflect.ValueOf(types.String{}),
 			expected: types.StringValue("hello"),
 		},
+		"value-custom-type-into-base-type": {
+			typ:      testtypes.StringTypeWithSemanticEquals{},
+			val:      tftypes.NewValue(tftypes.String, "hello"),
+			target:   reflect.ValueOf(types.String{}),
+			expected: types.StringValue("hello"),
+		},
+		"value-base-type-into-custom-type": {
+			typ:    types.StringType,
+			val:    tftypes.NewValue(tftypes.String, "hello"),
+			target: reflect.ValueOf(testtypes.StringValueWithSemanticEquals{}),
+			expected: testtypes.StringValueWithSemanticEquals{
+				StringValue: types.StringValue("hello"),
+			},
+		},
+		"value-custom-type-into-custom-type": {
+			typ: testtypes.StringTypeWithSemanticEquals{
+				SemanticEquals: true,
+			},
+			val:    tftypes.NewValue(tftypes.String, "hello"),
+			target: reflect.ValueOf(testtypes.StringValueWithSemanticEquals{}),
+			expected: testtypes.StringValueWithSemanticEquals{
+				StringValue:    types.StringValue("hello"),
+				SemanticEquals: true,
+			},
+		},
+		"value-list-custom-type-into-base-type": {
+			typ: testtypes.ListTypeWithSemanticEquals{
+				ListType: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			val: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.String},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+				},
+			),
+			target: reflect.ValueOf(types.List{}),
+			expected: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("hello"),
+				},
+			),
+		},
+		"value-different-kind": {
+			typ:    types.BoolType,
+			val:    tftypes.NewValue(tftypes.Bool, true),
+			target: reflect.ValueOf(types.String{}),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(
+					path.Empty(),
+					refl.DiagNewAttributeValueIntoWrongType{
+						ValType:    reflect.TypeOf(types.Bool{}),
+						TargetType: reflect.TypeOf(types.String{}),
+						SchemaType: types.BoolType,
+					},
+				),
+			},
+		},
 		"validate-error": {
 			typ: testtypes.StringTypeWithValidateError{},
 			val: tftypes.NewValue(tftypes.String, "hello"),
@@ -564,12 +624,7 @@ func TestNewAttributeValue(t *testing.T) {
 				return
 			}
 
-			got, ok := res.Interface().(types.String)
-			if !ok {
-				t.Fatalf("Expected type of types.String, got %T", res.Interface())
-			}
-
-			if diff := cmp.Diff(got, tc.expected); diff != "" {
+			if diff := cmp.Diff(res.Interface(), tc.expected); diff != "" {
 				t.Errorf("unexpected result (+wanted, -got): %s", diff)
 			}
 		})
@@ -26,14 +26,29 @@ func TestFromValue_go_types(t *testing.T) {
 	}{
 		"nil-go-slice-to-list-value": {
 			typ:      types.ListType{ElemType: types.StringType},
-			value:    new([]string),
+			value:    []string(nil),
+			expected: types.ListNull(types.StringType),
+		},
+		"nil-go-slice-pointer-to-list-value": {
+			typ:      types.ListType{ElemType: types.StringType},
+			value:    (*[]string)(nil),
 			expected: types.ListNull(types.StringType),
 		},
+		"pointer-to-nil-go-slice-to-list-value": {
+			typ:      types.ListType{ElemType: types.StringType},
+			value:    new([]string),
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
 		"nil-go-slice-to-set-value": {
 			typ:      types.SetType{ElemType: types.StringType},
-			value:    new([]string),
+			value:    []string(nil),
 			expected: types.SetNull(types.StringType),
 		},
+		"pointer-to-nil-go-slice-to-set-value": {
+			typ:      types.SetType{ElemType: types.StringType},
+			value:    new([]string),
+			expected: types.SetValueMust(types.StringType, []attr.Value{}),
+		},
 		"nil-go-slice-to-tuple-value": {
 			typ:      types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
 			value:    new([]string),
@@ -76,7 +76,8 @@ func pointerSafeZeroValue(_ context.Context, target reflect.Value) reflect.Value
 // FromPointer turns a pointer into an attr.Value using `typ`. If the pointer
 // is nil, the attr.Value will use its null representation. If it is not nil,
 // it will recurse into FromValue to find the attr.Value of the type the value
-// the pointer is referencing.
+// the pointer is referencing. A non-nil pointer to a nil slice or map uses the
+// empty list, map, or set representation, rather than null.
 //
 // It is meant to be called through FromValue, not directly.
 func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
@@ -135,7 +136,23 @@ func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path p
 		return attrVal, diags
 	}
 
-	attrVal, attrValDiags := FromValue(ctx, typ, value.Elem().Interface(), path)
+	pointed := value.Elem()
+
+	// A non-nil pointer to a nil slice or map is a known, empty collection.
+	// This enables pointer collection types to distinguish null (nil
+	// pointer) from empty collections in both directions, since Into always
+	// populates a non-nil pointer for a known collection. Tuples are excluded
+	// as their element count is fixed by the type.
+	if _, ok := typ.(attr.TypeWithElementType); ok {
+		switch {
+		case pointed.Kind() == reflect.Slice && pointed.IsNil():
+			pointed = reflect.MakeSlice(pointed.Type(), 0, 0)
+		case pointed.Kind() == reflect.Map && pointed.IsNil():
+			pointed = reflect.MakeMap(pointed.Type())
+		}
+	}
+
+	attrVal, attrValDiags := FromValue(ctx, typ, pointed.Interface(), path)
 	diags.Append(attrValDiags...)
 
 	return attrVal, diags
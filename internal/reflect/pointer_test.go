@@ -119,6 +119,41 @@ func TestFromPointer(t *testing.T) {
 			val:      reflect.ValueOf(new(*string)),
 			expected: types.StringNull(),
 		},
+		"slice-null": {
+			typ:      types.ListType{ElemType: types.StringType},
+			val:      reflect.ValueOf(new(*[]string)).Elem(),
+			expected: types.ListNull(types.StringType),
+		},
+		"slice-nil": {
+			typ:      types.ListType{ElemType: types.StringType},
+			val:      reflect.ValueOf(new([]string)),
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"slice-empty": {
+			typ:      types.ListType{ElemType: types.StringType},
+			val:      reflect.ValueOf(&[]string{}),
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"slice-value": {
+			typ:      types.SetType{ElemType: types.StringType},
+			val:      reflect.ValueOf(&[]string{"hello"}),
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("hello")}),
+		},
+		"slice-nil-tuple": {
+			typ:      types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+			val:      reflect.ValueOf(new([]string)),
+			expected: types.TupleNull([]attr.Type{types.StringType}),
+		},
+		"map-null": {
+			typ:      types.MapType{ElemType: types.StringType},
+			val:      reflect.ValueOf(new(*map[string]string)).Elem(),
+			expected: types.MapNull(types.StringType),
+		},
+		"map-nil": {
+			typ:      types.MapType{ElemType: types.StringType},
+			val:      reflect.ValueOf(new(map[string]string)),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
 		"WithValidateError": {
 			typ: testtypes.StringTypeWithValidateError{},
 			val: reflect.ValueOf(new(*string)),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -120,7 +121,17 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 	// now that we know they match perfectly, fill the struct with the
 	// values in the object
 	result := reflect.New(target.Type()).Elem()
-	for field, fieldIndex := range targetFields {
+
+	// fill fields in sorted order, so the diagnostics returned for the first
+	// value which cannot be built are deterministic
+	fields := make([]string, 0, len(targetFields))
+	for field := range targetFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		fieldIndex := targetFields[field]
 		attrType, ok := attrTypes[field]
 		if !ok {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package scaffold contains the code generation logic for creating skeleton
+// provider code, such as a resource.Resource implementation with its model,
+// schema, and tests, from a JSON definition.
+package scaffold
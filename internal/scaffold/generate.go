@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"text/template"
+)
+
+// GeneratedResource is the generated Go source code for a resource.
+type GeneratedResource struct {
+	// Source is the resource.Resource implementation, including its model
+	// struct, Metadata, Schema, and CRUD methods.
+	Source []byte
+
+	// TestSource contains the table-driven unit tests for the Source.
+	TestSource []byte
+}
+
+// GenerateResource returns the Go source code for the given resource
+// definition, which is validated before generation.
+func GenerateResource(r Resource) (GeneratedResource, error) {
+	if err := r.Validate(); err != nil {
+		return GeneratedResource{}, err
+	}
+
+	data := newResourceTemplateData(r)
+
+	source, err := executeTemplate(resourceTemplate, data)
+
+	if err != nil {
+		return GeneratedResource{}, fmt.Errorf("unable to generate resource source: %w", err)
+	}
+
+	testSource, err := executeTemplate(resourceTestTemplate, data)
+
+	if err != nil {
+		return GeneratedResource{}, fmt.Errorf("unable to generate resource test source: %w", err)
+	}
+
+	return GeneratedResource{
+		Source:     source,
+		TestSource: testSource,
+	}, nil
+}
+
+// executeTemplate executes the template and formats the result as Go source
+// code.
+func executeTemplate(tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+type resourceTemplateData struct {
+	Attributes  []attributeTemplateData
+	Description string
+	GoName      string
+	HasComputed bool
+	Name        string
+	Package     string
+}
+
+type attributeTemplateData struct {
+	Computed    bool
+	Description string
+	ElementType string
+	GoName      string
+	Name        string
+	Optional    bool
+	Required    bool
+	Sensitive   bool
+	Type        string
+}
+
+func newResourceTemplateData(r Resource) resourceTemplateData {
+	data := resourceTemplateData{
+		Attributes:  make([]attributeTemplateData, 0, len(r.Attributes)),
+		Description: r.Description,
+		GoName:      goName(r.Name),
+		Name:        r.Name,
+		Package:     r.Package,
+	}
+
+	for _, attribute := range r.Attributes {
+		data.Attributes = append(data.Attributes, attributeTemplateData{
+			Computed:    attribute.Computed,
+			Description: attribute.Description,
+			ElementType: attributeTypes[attribute.ElementType],
+			GoName:      goName(attribute.Name),
+			Name:        attribute.Name,
+			Optional:    attribute.Optional,
+			Required:    attribute.Required,
+			Sensitive:   attribute.Sensitive,
+			Type:        attributeTypes[attribute.Type],
+		})
+
+		if attribute.Computed {
+			data.HasComputed = true
+		}
+	}
+
+	return data
+}
+
+var templateFuncs = template.FuncMap{
+	"quote": strconv.Quote,
+}
+
+var resourceTemplate = template.Must(template.New("resource").Funcs(templateFuncs).Parse(`// Code generated by the terraform-plugin-framework scaffold command. Update
+// the TODO comments with the resource implementation.
+
+package {{ .Package }}
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &{{ .GoName }}Resource{}
+
+// New{{ .GoName }}Resource returns a new {{ .Name }} resource.
+func New{{ .GoName }}Resource() resource.Resource {
+	return &{{ .GoName }}Resource{}
+}
+
+// {{ .GoName }}Resource defines the {{ .Name }} resource implementation.
+type {{ .GoName }}Resource struct{}
+
+// {{ .GoName }}ResourceModel describes the {{ .Name }} resource data model.
+type {{ .GoName }}ResourceModel struct {
+{{- range .Attributes }}
+	{{ .GoName }} types.{{ .Type }} ` + "`" + `tfsdk:{{ quote .Name }}` + "`" + `
+{{- end }}
+}
+
+func (r *{{ .GoName }}Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + {{ quote (printf "_%s" .Name) }}
+}
+
+func (r *{{ .GoName }}Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+{{- if .Description }}
+		Description: {{ quote .Description }},
+{{- end }}
+		Attributes: map[string]schema.Attribute{
+{{- range .Attributes }}
+			{{ quote .Name }}: schema.{{ .Type }}Attribute{
+{{- if .ElementType }}
+				ElementType: types.{{ .ElementType }}Type,
+{{- end }}
+{{- if .Description }}
+				Description: {{ quote .Description }},
+{{- end }}
+{{- if .Required }}
+				Required: true,
+{{- end }}
+{{- if .Optional }}
+				Optional: true,
+{{- end }}
+{{- if .Computed }}
+				Computed: true,
+{{- end }}
+{{- if .Sensitive }}
+				Sensitive: true,
+{{- end }}
+			},
+{{- end }}
+		},
+	}
+}
+
+func (r *{{ .GoName }}Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data {{ .GoName }}ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// TODO: Create the resource.
+{{- if .HasComputed }}
+	// TODO: Set all unknown computed attribute values in data, since state
+	// cannot contain unknown values.
+{{- end }}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *{{ .GoName }}Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data {{ .GoName }}ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// TODO: Refresh data from the remote system. Call
+	// resp.State.RemoveResource if the resource no longer exists.
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *{{ .GoName }}Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data {{ .GoName }}ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// TODO: Update the resource.
+{{- if .HasComputed }}
+	// TODO: Set all unknown computed attribute values in data, since state
+	// cannot contain unknown values.
+{{- end }}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *{{ .GoName }}Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data {{ .GoName }}ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// TODO: Delete the resource.
+}
+`))
+
+var resourceTestTemplate = template.Must(template.New("resource_test").Funcs(templateFuncs).Parse(`// Code generated by the terraform-plugin-framework scaffold command. Add
+// further tests for the resource implementation.
+
+package {{ .Package }}
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func Test{{ .GoName }}ResourceMetadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &resource.MetadataResponse{}
+
+	New{{ .GoName }}Resource().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "test"}, resp)
+
+	if resp.TypeName != {{ quote (printf "test_%s" .Name) }} {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func Test{{ .GoName }}ResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resp := &resource.SchemaResponse{}
+
+	New{{ .GoName }}Resource().Schema(ctx, resource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("unexpected schema validation diagnostics: %+v", diags)
+	}
+
+	testCases := map[string]struct {
+		required bool
+		optional bool
+		computed bool
+	}{
+{{- range .Attributes }}
+		{{ quote .Name }}: {
+{{- if .Required }}
+			required: true,
+{{- end }}
+{{- if .Optional }}
+			optional: true,
+{{- end }}
+{{- if .Computed }}
+			computed: true,
+{{- end }}
+		},
+{{- end }}
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attribute, ok := resp.Schema.Attributes[name]
+
+			if !ok {
+				t.Fatalf("expected attribute %q in schema", name)
+			}
+
+			if got := attribute.IsRequired(); got != testCase.required {
+				t.Errorf("expected required %t, got %t", testCase.required, got)
+			}
+
+			if got := attribute.IsOptional(); got != testCase.optional {
+				t.Errorf("expected optional %t, got %t", testCase.optional, got)
+			}
+
+			if got := attribute.IsComputed(); got != testCase.computed {
+				t.Errorf("expected computed %t, got %t", testCase.computed, got)
+			}
+		})
+	}
+}
+`))
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerateResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource                   Resource
+		expectedSourceContains     []string
+		expectedSourceNotContains  []string
+		expectedTestSourceContains []string
+		expectedError              error
+	}{
+		"attributes": {
+			resource: Resource{
+				Name:        "thing_widget",
+				Package:     "provider",
+				Description: `Manages a "widget".`,
+				Attributes: []Attribute{
+					{Name: "id", Type: "string", Computed: true},
+					{Name: "name", Type: "string", Required: true, Description: "Name of the widget."},
+					{Name: "tags", Type: "map", ElementType: "string", Optional: true},
+					{Name: "size", Type: "int64", Optional: true, Computed: true, Sensitive: true},
+				},
+			},
+			expectedSourceContains: []string{
+				"package provider\n",
+				"func NewThingWidgetResource() resource.Resource {",
+				"\tID   types.String `tfsdk:\"id\"`\n",
+				"\tTags types.Map    `tfsdk:\"tags\"`\n",
+				`resp.TypeName = req.ProviderTypeName + "_thing_widget"`,
+				`Description: "Manages a \"widget\".",`,
+				"\"name\": schema.StringAttribute{\n\t\t\t\tDescription: \"Name of the widget.\",\n\t\t\t\tRequired:    true,\n\t\t\t},",
+				"\"tags\": schema.MapAttribute{\n\t\t\t\tElementType: types.StringType,\n\t\t\t\tOptional:    true,\n\t\t\t},",
+				"\"size\": schema.Int64Attribute{\n\t\t\t\tOptional:  true,\n\t\t\t\tComputed:  true,\n\t\t\t\tSensitive: true,\n\t\t\t},",
+				"func (r *ThingWidgetResource) Create(",
+				"func (r *ThingWidgetResource) Read(",
+				"func (r *ThingWidgetResource) Update(",
+				"func (r *ThingWidgetResource) Delete(",
+				"// TODO: Set all unknown computed attribute values in data",
+			},
+			expectedTestSourceContains: []string{
+				"package provider\n",
+				"func TestThingWidgetResourceMetadata(t *testing.T) {",
+				`if resp.TypeName != "test_thing_widget" {`,
+				"func TestThingWidgetResourceSchema(t *testing.T) {",
+				"\"name\": {\n\t\t\trequired: true,\n\t\t},",
+				"\"size\": {\n\t\t\toptional: true,\n\t\t\tcomputed: true,\n\t\t},",
+			},
+		},
+		"no-computed": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "name", Type: "string", Required: true},
+				},
+			},
+			expectedSourceContains: []string{
+				"func NewThingResource() resource.Resource {",
+			},
+			expectedSourceNotContains: []string{
+				"// TODO: Set all unknown computed attribute values in data",
+			},
+		},
+		"invalid": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+			},
+			expectedError: errors.New("resource must define at least one attribute"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GenerateResource(testCase.resource)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			for filename, source := range map[string][]byte{"resource.go": got.Source, "resource_test.go": got.TestSource} {
+				if _, err := parser.ParseFile(token.NewFileSet(), filename, source, parser.AllErrors); err != nil {
+					t.Errorf("unexpected error parsing generated %s: %s", filename, err)
+				}
+			}
+
+			for _, expected := range testCase.expectedSourceContains {
+				if !strings.Contains(string(got.Source), expected) {
+					t.Errorf("expected generated source to contain %q, got:\n%s", expected, got.Source)
+				}
+			}
+
+			for _, expected := range testCase.expectedTestSourceContains {
+				if !strings.Contains(string(got.TestSource), expected) {
+					t.Errorf("expected generated test source to contain %q, got:\n%s", expected, got.TestSource)
+				}
+			}
+
+			for _, unexpected := range testCase.expectedSourceNotContains {
+				if strings.Contains(string(got.Source), unexpected) {
+					t.Errorf("expected generated source to not contain %q, got:\n%s", unexpected, got.Source)
+				}
+			}
+		})
+	}
+}
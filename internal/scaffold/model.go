@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// GenerateModel returns the Go source code of a typed model for the given
+// definition, which is validated before generation. The source contains the
+// model struct with Get and Set methods, a variable with the path of each
+// attribute, and typed functions for reading and writing each attribute.
+func GenerateModel(r Resource) ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	source, err := executeTemplate(modelTemplate, newResourceTemplateData(r))
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate model source: %w", err)
+	}
+
+	return source, nil
+}
+
+var modelTemplate = template.Must(template.New("model").Funcs(templateFuncs).Parse(`// Code generated by the terraform-plugin-framework modelgen command. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// {{ .GoName }}Model describes the {{ .Name }} data model.
+type {{ .GoName }}Model struct {
+{{- range .Attributes }}
+	{{ .GoName }} types.{{ .Type }} ` + "`" + `tfsdk:{{ quote .Name }}` + "`" + `
+{{- end }}
+}
+
+// Get reads the entire model from the data, such as a tfsdk.Config,
+// tfsdk.Plan, or tfsdk.State.
+func (m *{{ .GoName }}Model) Get(ctx context.Context, data interface {
+	Get(context.Context, any) diag.Diagnostics
+}) diag.Diagnostics {
+	return data.Get(ctx, m)
+}
+
+// Set writes the entire model to the data, such as a tfsdk.Plan or
+// tfsdk.State.
+func (m {{ .GoName }}Model) Set(ctx context.Context, data interface {
+	Set(context.Context, any) diag.Diagnostics
+}) diag.Diagnostics {
+	return data.Set(ctx, m)
+}
+
+// {{ .GoName }}Paths contains the path of each {{ .Name }} attribute.
+var {{ .GoName }}Paths = struct {
+{{- range .Attributes }}
+	{{ .GoName }} path.Path
+{{- end }}
+}{
+{{- range .Attributes }}
+	{{ .GoName }}: path.Root({{ quote .Name }}),
+{{- end }}
+}
+{{- $model := .GoName }}
+{{ range .Attributes }}
+// Get{{ $model }}{{ .GoName }} reads the {{ .Name }} attribute value from the data.
+func Get{{ $model }}{{ .GoName }}(ctx context.Context, data interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}) (types.{{ .Type }}, diag.Diagnostics) {
+	var value types.{{ .Type }}
+
+	diags := data.GetAttribute(ctx, {{ $model }}Paths.{{ .GoName }}, &value)
+
+	return value, diags
+}
+
+// Set{{ $model }}{{ .GoName }} writes the {{ .Name }} attribute value to the data.
+func Set{{ $model }}{{ .GoName }}(ctx context.Context, data interface {
+	SetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}, value types.{{ .Type }}) diag.Diagnostics {
+	return data.SetAttribute(ctx, {{ $model }}Paths.{{ .GoName }}, value)
+}
+{{ end }}`))
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerateModel(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource               Resource
+		expectedSourceContains []string
+		expectedError          error
+	}{
+		"attributes": {
+			resource: Resource{
+				Name:    "thing_widget",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "id", Type: "string", Computed: true},
+					{Name: "disk_size", Type: "int64", Optional: true},
+					{Name: "tags", Type: "map", ElementType: "string", Optional: true},
+				},
+			},
+			expectedSourceContains: []string{
+				"// Code generated by the terraform-plugin-framework modelgen command. DO NOT EDIT.\n",
+				"package provider\n",
+				"type ThingWidgetModel struct {\n\tID       types.String `tfsdk:\"id\"`\n\tDiskSize types.Int64  `tfsdk:\"disk_size\"`\n\tTags     types.Map    `tfsdk:\"tags\"`\n}",
+				"func (m *ThingWidgetModel) Get(ctx context.Context, data interface {",
+				"func (m ThingWidgetModel) Set(ctx context.Context, data interface {",
+				"var ThingWidgetPaths = struct {\n\tID       path.Path\n\tDiskSize path.Path\n\tTags     path.Path\n}{",
+				"\tDiskSize: path.Root(\"disk_size\"),\n",
+				"func GetThingWidgetDiskSize(ctx context.Context, data interface {",
+				"}) (types.Int64, diag.Diagnostics) {",
+				"diags := data.GetAttribute(ctx, ThingWidgetPaths.DiskSize, &value)",
+				"}, value types.Map) diag.Diagnostics {",
+				"return data.SetAttribute(ctx, ThingWidgetPaths.Tags, value)",
+			},
+		},
+		"invalid": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+			},
+			expectedError: errors.New("resource must define at least one attribute"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GenerateModel(testCase.resource)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if _, err := parser.ParseFile(token.NewFileSet(), "model.go", got, parser.AllErrors); err != nil {
+				t.Errorf("unexpected error parsing generated source: %s", err)
+			}
+
+			for _, expected := range testCase.expectedSourceContains {
+				if !strings.Contains(string(got), expected) {
+					t.Errorf("expected generated source to contain %q, got:\n%s", expected, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"regexp"
+	"strings"
+)
+
+// validNameRegex matches valid resource and attribute names.
+var validNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// attributeTypes maps the supported attribute types to the framework schema
+// attribute type and types package value type name prefixes.
+var attributeTypes = map[string]string{
+	"bool":    "Bool",
+	"float32": "Float32",
+	"float64": "Float64",
+	"int32":   "Int32",
+	"int64":   "Int64",
+	"list":    "List",
+	"map":     "Map",
+	"number":  "Number",
+	"set":     "Set",
+	"string":  "String",
+}
+
+// collectionTypes are the attribute types which require an element type.
+var collectionTypes = map[string]bool{
+	"list": true,
+	"map":  true,
+	"set":  true,
+}
+
+// Resource is the definition of a resource to generate.
+type Resource struct {
+	// Name is the resource type name without the provider type name prefix,
+	// such as "thing" for an examplecloud_thing resource.
+	Name string `json:"name"`
+
+	// Package is the Go package name of the generated code.
+	Package string `json:"package"`
+
+	// Description is the optional resource description.
+	Description string `json:"description,omitempty"`
+
+	// Attributes are the resource schema attributes.
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Attribute is the definition of a resource schema attribute to generate.
+type Attribute struct {
+	// Name is the attribute name.
+	Name string `json:"name"`
+
+	// Type is the attribute type, which must be one of bool, float32,
+	// float64, int32, int64, list, map, number, set, or string.
+	Type string `json:"type"`
+
+	// ElementType is the element type of list, map, and set attributes,
+	// which must be one of the non-collection attribute types.
+	ElementType string `json:"element_type,omitempty"`
+
+	// Description is the optional attribute description.
+	Description string `json:"description,omitempty"`
+
+	Required  bool `json:"required,omitempty"`
+	Optional  bool `json:"optional,omitempty"`
+	Computed  bool `json:"computed,omitempty"`
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+// ParseResource returns the Resource from the given JSON definition, which
+// is also validated.
+func ParseResource(data []byte) (Resource, error) {
+	var resource Resource
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&resource); err != nil {
+		return Resource{}, fmt.Errorf("unable to parse resource definition: %w", err)
+	}
+
+	if err := resource.Validate(); err != nil {
+		return Resource{}, err
+	}
+
+	return resource, nil
+}
+
+// Validate returns an error if the resource definition cannot be generated.
+func (r Resource) Validate() error {
+	var errs []error
+
+	if !validNameRegex.MatchString(r.Name) {
+		errs = append(errs, fmt.Errorf("resource name %q must start with a lowercase letter and only contain lowercase letters, numbers, and underscores", r.Name))
+	}
+
+	if !token.IsIdentifier(r.Package) || token.IsKeyword(r.Package) {
+		errs = append(errs, fmt.Errorf("package %q must be a valid Go package name", r.Package))
+	}
+
+	if len(r.Attributes) == 0 {
+		errs = append(errs, errors.New("resource must define at least one attribute"))
+	}
+
+	names := make(map[string]bool, len(r.Attributes))
+	goNames := make(map[string]string, len(r.Attributes))
+
+	for _, attribute := range r.Attributes {
+		if names[attribute.Name] {
+			errs = append(errs, fmt.Errorf("attribute %q is defined multiple times", attribute.Name))
+		} else if other, ok := goNames[goName(attribute.Name)]; ok {
+			errs = append(errs, fmt.Errorf("attributes %q and %q have the same Go field name %s", other, attribute.Name, goName(attribute.Name)))
+		}
+
+		names[attribute.Name] = true
+		goNames[goName(attribute.Name)] = attribute.Name
+
+		if err := attribute.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (a Attribute) validate() error {
+	if !validNameRegex.MatchString(a.Name) {
+		return fmt.Errorf("attribute name %q must start with a lowercase letter and only contain lowercase letters, numbers, and underscores", a.Name)
+	}
+
+	if _, ok := attributeTypes[a.Type]; !ok {
+		return fmt.Errorf("attribute %q has unsupported type %q", a.Name, a.Type)
+	}
+
+	if collectionTypes[a.Type] {
+		if _, ok := attributeTypes[a.ElementType]; !ok || collectionTypes[a.ElementType] {
+			return fmt.Errorf("attribute %q has unsupported element type %q, which must be a non-collection type", a.Name, a.ElementType)
+		}
+	} else if a.ElementType != "" {
+		return fmt.Errorf("attribute %q has element type %q, which is only supported for list, map, and set types", a.Name, a.ElementType)
+	}
+
+	if !a.Required && !a.Optional && !a.Computed {
+		return fmt.Errorf("attribute %q must be required, optional, or computed", a.Name)
+	}
+
+	if a.Required && (a.Optional || a.Computed) {
+		return fmt.Errorf("attribute %q cannot be required with optional or computed", a.Name)
+	}
+
+	return nil
+}
+
+// initialisms are attribute and resource name parts which are fully
+// uppercased in Go names, following Go naming conventions.
+var initialisms = map[string]bool{
+	"api":  true,
+	"arn":  true,
+	"http": true,
+	"id":   true,
+	"ip":   true,
+	"json": true,
+	"url":  true,
+}
+
+// goName converts the underscore separated name into an exported Go name,
+// such as example_id into ExampleID.
+func goName(name string) string {
+	var builder strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+
+		if initialisms[part] {
+			builder.WriteString(strings.ToUpper(part))
+
+			continue
+		}
+
+		builder.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return builder.String()
+}
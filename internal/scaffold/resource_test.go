@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          string
+		expected      Resource
+		expectedError error
+	}{
+		"valid": {
+			data: `{"name": "thing", "package": "provider", "attributes": [{"name": "tags", "type": "set", "element_type": "string", "optional": true, "computed": true}]}`,
+			expected: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "tags", Type: "set", ElementType: "string", Optional: true, Computed: true},
+				},
+			},
+		},
+		"unknown-field": {
+			data:          `{"name": "thing", "package": "provider", "attributes": [{"name": "id", "type": "string", "computed": true, "default": "one"}]}`,
+			expectedError: errors.New(`unable to parse resource definition: json: unknown field "default"`),
+		},
+		"invalid": {
+			data:          `{"name": "Thing", "package": "provider", "attributes": [{"name": "id", "type": "string", "computed": true}]}`,
+			expectedError: errors.New(`resource name "Thing" must start with a lowercase letter and only contain lowercase letters, numbers, and underscores`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseResource([]byte(testCase.data))
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource      Resource
+		expectedError error
+	}{
+		"valid": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "id", Type: "string", Computed: true},
+					{Name: "ports", Type: "list", ElementType: "int64", Required: true},
+				},
+			},
+		},
+		"package-invalid": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "func",
+				Attributes: []Attribute{
+					{Name: "id", Type: "string", Computed: true},
+				},
+			},
+			expectedError: errors.New(`package "func" must be a valid Go package name`),
+		},
+		"attribute-duplicate": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "id", Type: "string", Computed: true},
+					{Name: "id", Type: "string", Computed: true},
+				},
+			},
+			expectedError: errors.New(`attribute "id" is defined multiple times`),
+		},
+		"attribute-duplicate-go-name": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "example_id", Type: "string", Computed: true},
+					{Name: "exampleid", Type: "string", Computed: true},
+					{Name: "example__id", Type: "string", Computed: true},
+				},
+			},
+			expectedError: errors.New(`attributes "example_id" and "example__id" have the same Go field name ExampleID`),
+		},
+		"attribute-type-invalid": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "id", Type: "uuid", Computed: true},
+				},
+			},
+			expectedError: errors.New(`attribute "id" has unsupported type "uuid"`),
+		},
+		"attribute-element-type-missing": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "ports", Type: "list", Required: true},
+				},
+			},
+			expectedError: errors.New(`attribute "ports" has unsupported element type "", which must be a non-collection type`),
+		},
+		"attribute-element-type-collection": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "ports", Type: "list", ElementType: "set", Required: true},
+				},
+			},
+			expectedError: errors.New(`attribute "ports" has unsupported element type "set", which must be a non-collection type`),
+		},
+		"attribute-element-type-primitive": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "port", Type: "int64", ElementType: "string", Required: true},
+				},
+			},
+			expectedError: errors.New(`attribute "port" has element type "string", which is only supported for list, map, and set types`),
+		},
+		"attribute-behavior-missing": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "id", Type: "string"},
+				},
+			},
+			expectedError: errors.New(`attribute "id" must be required, optional, or computed`),
+		},
+		"attribute-required-computed": {
+			resource: Resource{
+				Name:    "thing",
+				Package: "provider",
+				Attributes: []Attribute{
+					{Name: "id", Type: "string", Required: true, Computed: true},
+				},
+			},
+			expectedError: errors.New(`attribute "id" cannot be required with optional or computed`),
+		},
+		"multiple-errors": {
+			resource: Resource{
+				Name:    "1thing",
+				Package: "provider",
+			},
+			expectedError: errors.New(`resource name "1thing" must start with a lowercase letter and only contain lowercase letters, numbers, and underscores` + "\n" +
+				`resource must define at least one attribute`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.resource.Validate()
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"thing":          "Thing",
+		"thing_widget":   "ThingWidget",
+		"id":             "ID",
+		"example_url":    "ExampleURL",
+		"ip_address_v4":  "IPAddressV4",
+		"trailing_":      "Trailing",
+		"http_api_token": "HTTPAPIToken",
+	}
+
+	for name, expected := range testCases {
+		name, expected := name, expected
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := goName(name); got != expected {
+				t.Errorf("expected %q, got %q", expected, got)
+			}
+		})
+	}
+}
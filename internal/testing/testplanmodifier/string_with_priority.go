@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testplanmodifier
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var (
+	_ planmodifier.String      = &StringWithPriority{}
+	_ planmodifier.Prioritizer = &StringWithPriority{}
+)
+
+// Declarative planmodifier.String and planmodifier.Prioritizer for unit
+// testing.
+type StringWithPriority struct {
+	String
+
+	// Prioritizer interface methods
+	PriorityMethod func() int
+}
+
+// Priority satisfies the planmodifier.Prioritizer interface.
+func (v StringWithPriority) Priority() int {
+	if v.PriorityMethod == nil {
+		return 0
+	}
+
+	return v.PriorityMethod()
+}
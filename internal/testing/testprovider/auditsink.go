@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.AuditSink = &AuditSink{}
+
+// Declarative provider.AuditSink for unit testing.
+type AuditSink struct {
+	// AuditSink interface methods
+	RecordMethod func(context.Context, provider.AuditRecord)
+}
+
+// Record satisfies the provider.AuditSink interface.
+func (s *AuditSink) Record(ctx context.Context, record provider.AuditRecord) {
+	if s.RecordMethod == nil {
+		return
+	}
+
+	s.RecordMethod(ctx, record)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.RunHook = &FunctionRunHook{}
+
+// Declarative function.RunHook for unit testing.
+type FunctionRunHook struct {
+	// RunHook interface methods
+	AfterRunMethod  func(context.Context, function.AfterRunHookRequest)
+	BeforeRunMethod func(context.Context, function.BeforeRunHookRequest)
+}
+
+// AfterRun satisfies the function.RunHook interface.
+func (h *FunctionRunHook) AfterRun(ctx context.Context, req function.AfterRunHookRequest) {
+	if h.AfterRunMethod == nil {
+		return
+	}
+
+	h.AfterRunMethod(ctx, req)
+}
+
+// BeforeRun satisfies the function.RunHook interface.
+func (h *FunctionRunHook) BeforeRun(ctx context.Context, req function.BeforeRunHookRequest) {
+	if h.BeforeRunMethod == nil {
+		return
+	}
+
+	h.BeforeRunMethod(ctx, req)
+}
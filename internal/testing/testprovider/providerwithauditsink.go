@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var (
+	_ provider.Provider              = &ProviderWithAuditSink{}
+	_ provider.ProviderWithAuditSink = &ProviderWithAuditSink{}
+)
+
+// Declarative provider.ProviderWithAuditSink for unit testing.
+type ProviderWithAuditSink struct {
+	*Provider
+
+	// ProviderWithAuditSink interface methods
+	AuditSinkMethod func(context.Context) provider.AuditSink
+}
+
+// AuditSink satisfies the provider.ProviderWithAuditSink interface.
+func (p *ProviderWithAuditSink) AuditSink(ctx context.Context) provider.AuditSink {
+	if p.AuditSinkMethod == nil {
+		return nil
+	}
+
+	return p.AuditSinkMethod(ctx)
+}
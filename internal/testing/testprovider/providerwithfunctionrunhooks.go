@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var (
+	_ provider.Provider                     = &ProviderWithFunctionRunHooks{}
+	_ provider.ProviderWithFunctions        = &ProviderWithFunctionRunHooks{}
+	_ provider.ProviderWithFunctionRunHooks = &ProviderWithFunctionRunHooks{}
+)
+
+// Declarative provider.ProviderWithFunctionRunHooks for unit testing.
+type ProviderWithFunctionRunHooks struct {
+	*ProviderWithFunctions
+
+	// ProviderWithFunctionRunHooks interface methods
+	FunctionRunHooksMethod func(context.Context) []function.RunHook
+}
+
+// FunctionRunHooks satisfies the provider.ProviderWithFunctionRunHooks interface.
+func (p *ProviderWithFunctionRunHooks) FunctionRunHooks(ctx context.Context) []function.RunHook {
+	if p.FunctionRunHooksMethod == nil {
+		return nil
+	}
+
+	return p.FunctionRunHooksMethod(ctx)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var (
+	_ provider.Provider          = &ProviderWithHooks{}
+	_ provider.ProviderWithHooks = &ProviderWithHooks{}
+)
+
+// Declarative provider.ProviderWithHooks for unit testing.
+type ProviderWithHooks struct {
+	*Provider
+
+	// ProviderWithHooks interface methods
+	HooksMethod func(context.Context) provider.Hooks
+}
+
+// Hooks satisfies the provider.ProviderWithHooks interface.
+func (p *ProviderWithHooks) Hooks(ctx context.Context) provider.Hooks {
+	if p.HooksMethod == nil {
+		return provider.Hooks{}
+	}
+
+	return p.HooksMethod(ctx)
+}
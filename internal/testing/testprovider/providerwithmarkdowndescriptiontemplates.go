@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var (
+	_ provider.Provider                                 = &ProviderWithMarkdownDescriptionTemplates{}
+	_ provider.ProviderWithMarkdownDescriptionTemplates = &ProviderWithMarkdownDescriptionTemplates{}
+)
+
+// Declarative provider.ProviderWithMarkdownDescriptionTemplates for unit
+// testing.
+type ProviderWithMarkdownDescriptionTemplates struct {
+	*Provider
+
+	// ProviderWithMarkdownDescriptionTemplates interface methods
+	MarkdownDescriptionTemplatesMethod func(context.Context) bool
+}
+
+// MarkdownDescriptionTemplates satisfies the
+// provider.ProviderWithMarkdownDescriptionTemplates interface.
+func (p *ProviderWithMarkdownDescriptionTemplates) MarkdownDescriptionTemplates(ctx context.Context) bool {
+	if p.MarkdownDescriptionTemplatesMethod == nil {
+		return false
+	}
+
+	return p.MarkdownDescriptionTemplatesMethod(ctx)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var (
+	_ provider.Provider                     = &ProviderWithStateTransformer{}
+	_ provider.ProviderWithStateTransformer = &ProviderWithStateTransformer{}
+)
+
+// Declarative provider.ProviderWithStateTransformer for unit testing.
+type ProviderWithStateTransformer struct {
+	*Provider
+
+	// ProviderWithStateTransformer interface methods
+	StateTransformerMethod func(context.Context) provider.StateTransformer
+}
+
+// StateTransformer satisfies the provider.ProviderWithStateTransformer
+// interface.
+func (p *ProviderWithStateTransformer) StateTransformer(ctx context.Context) provider.StateTransformer {
+	if p.StateTransformerMethod == nil {
+		return nil
+	}
+
+	return p.StateTransformerMethod(ctx)
+}
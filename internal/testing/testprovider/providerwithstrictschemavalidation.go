@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var (
+	_ provider.Provider                           = &ProviderWithStrictSchemaValidation{}
+	_ provider.ProviderWithStrictSchemaValidation = &ProviderWithStrictSchemaValidation{}
+)
+
+// Declarative provider.ProviderWithStrictSchemaValidation for unit testing.
+type ProviderWithStrictSchemaValidation struct {
+	*Provider
+
+	// ProviderWithStrictSchemaValidation interface methods
+	StrictSchemaValidationMethod func(context.Context) bool
+}
+
+// StrictSchemaValidation satisfies the
+// provider.ProviderWithStrictSchemaValidation interface.
+func (p *ProviderWithStrictSchemaValidation) StrictSchemaValidation(ctx context.Context) bool {
+	if p.StrictSchemaValidationMethod == nil {
+		return false
+	}
+
+	return p.StrictSchemaValidationMethod(ctx)
+}
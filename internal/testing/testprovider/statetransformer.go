@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.StateTransformer = &StateTransformer{}
+
+// Declarative provider.StateTransformer for unit testing.
+type StateTransformer struct {
+	// StateTransformer interface methods
+	DecodeMethod func(context.Context, provider.StateTransformRequest, *provider.StateTransformResponse)
+	EncodeMethod func(context.Context, provider.StateTransformRequest, *provider.StateTransformResponse)
+}
+
+// Decode satisfies the provider.StateTransformer interface.
+func (t *StateTransformer) Decode(ctx context.Context, req provider.StateTransformRequest, resp *provider.StateTransformResponse) {
+	if t.DecodeMethod == nil {
+		return
+	}
+
+	t.DecodeMethod(ctx, req, resp)
+}
+
+// Encode satisfies the provider.StateTransformer interface.
+func (t *StateTransformer) Encode(ctx context.Context, req provider.StateTransformRequest, resp *provider.StateTransformResponse) {
+	if t.EncodeMethod == nil {
+		return
+	}
+
+	t.EncodeMethod(ctx, req, resp)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
 )
 
@@ -28,6 +29,10 @@ func DiagnosticSeverity(s diag.Severity) tfprotov5.DiagnosticSeverity {
 func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov5.Diagnostic {
 	var results []*tfprotov5.Diagnostic
 
+	diagnostics = fwcontext.SuppressedWarnings(ctx).Apply(diagnostics)
+	diagnostics = fwcontext.DiagnosticLimits(ctx).Apply(diagnostics)
+	diagnostics = fwcontext.DiagnosticMessageCatalog(ctx).Apply(diagnostics)
+
 	for _, diagnostic := range diagnostics {
 		tfprotov5Diagnostic := &tfprotov5.Diagnostic{
 			Detail:   diagnostic.Detail(),
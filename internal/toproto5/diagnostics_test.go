@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
@@ -131,6 +132,91 @@ func TestDiagnostics(t *testing.T) {
 
 var _ diag.Diagnostic = invalidSeverityDiagnostic{}
 
+func TestDiagnostics_Limits(t *testing.T) {
+	t.Parallel()
+
+	ctx := fwcontext.WithDiagnosticLimits(context.Background(), diag.Limits{
+		MaxPerSummary: 1,
+	})
+
+	got := toproto5.Diagnostics(ctx, diag.Diagnostics{
+		diag.NewErrorDiagnostic("one summary", "one detail"),
+		diag.NewErrorDiagnostic("one summary", "one detail"),
+		diag.NewErrorDiagnostic("one summary", "one detail"),
+	})
+	expected := []*tfprotov5.Diagnostic{
+		{
+			Detail:   "one detail",
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "one summary",
+		},
+		{
+			Detail:   "2 additional diagnostics with this summary were omitted to limit output.",
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "one summary",
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDiagnostics_SuppressedWarnings(t *testing.T) {
+	t.Parallel()
+
+	ctx := fwcontext.WithSuppressedWarnings(context.Background(), diag.SuppressedWarnings{"test_key"})
+
+	got := toproto5.Diagnostics(ctx, diag.Diagnostics{
+		diag.NewWarningDiagnosticWithKey("test_key", "one summary", "one detail"),
+		diag.NewWarningDiagnosticWithKey("other_key", "two summary", "two detail"),
+	})
+	expected := []*tfprotov5.Diagnostic{
+		{
+			Detail:   "two detail",
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "two summary",
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDiagnostics_MessageCatalog(t *testing.T) {
+	t.Parallel()
+
+	ctx := fwcontext.WithDiagnosticMessageCatalog(context.Background(), diag.MessageCatalog{
+		"one summary": {
+			Summary: "translated summary",
+			Detail:  "translated: {{.Detail}}",
+		},
+	})
+
+	got := toproto5.Diagnostics(ctx, diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+		diag.NewWarningDiagnostic("two summary", "two detail"),
+	})
+	expected := []*tfprotov5.Diagnostic{
+		{
+			Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+			Detail:    "translated: one detail",
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "translated summary",
+		},
+		{
+			Detail:   "two detail",
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "two summary",
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 type invalidSeverityDiagnostic struct{}
 
 func (d invalidSeverityDiagnostic) Detail() string {
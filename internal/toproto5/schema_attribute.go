@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -46,7 +47,7 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 	}
 
 	if a.GetMarkdownDescription() != "" {
-		schemaAttribute.Description = a.GetMarkdownDescription()
+		schemaAttribute.Description = fwxschema.AttributeMarkdownDescription(ctx, a)
 		schemaAttribute.DescriptionKind = tfprotov5.StringKindMarkdown
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
 )
 
@@ -28,6 +29,10 @@ func DiagnosticSeverity(s diag.Severity) tfprotov6.DiagnosticSeverity {
 func Diagnostics(ctx context.Context, diagnostics diag.Diagnostics) []*tfprotov6.Diagnostic {
 	var results []*tfprotov6.Diagnostic
 
+	diagnostics = fwcontext.SuppressedWarnings(ctx).Apply(diagnostics)
+	diagnostics = fwcontext.DiagnosticLimits(ctx).Apply(diagnostics)
+	diagnostics = fwcontext.DiagnosticMessageCatalog(ctx).Apply(diagnostics)
+
 	for _, diagnostic := range diagnostics {
 		tfprotov6Diagnostic := &tfprotov6.Diagnostic{
 			Detail:   diagnostic.Detail(),
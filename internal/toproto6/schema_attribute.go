@@ -8,6 +8,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -39,7 +40,7 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 	}
 
 	if a.GetMarkdownDescription() != "" {
-		schemaAttribute.Description = a.GetMarkdownDescription()
+		schemaAttribute.Description = fwxschema.AttributeMarkdownDescription(ctx, a)
 		schemaAttribute.DescriptionKind = tfprotov6.StringKindMarkdown
 	}
 
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// AuditOperation is the name of a resource or data source operation in an
+// AuditRecord.
+type AuditOperation string
+
+const (
+	// AuditOperationPlan is a resource plan, via the PlanResourceChange RPC.
+	AuditOperationPlan AuditOperation = "Plan"
+
+	// AuditOperationCreate is a resource create, via the ApplyResourceChange
+	// RPC.
+	AuditOperationCreate AuditOperation = "Create"
+
+	// AuditOperationRead is a resource or data source read, via the
+	// ReadResource or ReadDataSource RPCs.
+	AuditOperationRead AuditOperation = "Read"
+
+	// AuditOperationUpdate is a resource update, via the
+	// ApplyResourceChange RPC.
+	AuditOperationUpdate AuditOperation = "Update"
+
+	// AuditOperationDelete is a resource delete, via the ApplyResourceChange
+	// RPC.
+	AuditOperationDelete AuditOperation = "Delete"
+
+	// AuditOperationImport is a resource import, via the
+	// ImportResourceState RPC.
+	AuditOperationImport AuditOperation = "Import"
+)
+
+// AuditOutcome is the result of the operation in an AuditRecord.
+type AuditOutcome string
+
+const (
+	// AuditOutcomeSuccess indicates the operation returned no error
+	// diagnostics.
+	AuditOutcomeSuccess AuditOutcome = "Success"
+
+	// AuditOutcomeFailure indicates the operation returned error
+	// diagnostics.
+	AuditOutcomeFailure AuditOutcome = "Failure"
+
+	// AuditOutcomeDeferred indicates the operation returned no error
+	// diagnostics and a deferred response.
+	AuditOutcomeDeferred AuditOutcome = "Deferred"
+)
+
+// AuditRecord is a structured record of a completed resource or data source
+// operation, which the framework sends to the AuditSink.
+type AuditRecord struct {
+	// Operation is the completed operation.
+	Operation AuditOperation
+
+	// ResourceTypeName is the resource type of the operation, if the
+	// operation is for a resource.
+	ResourceTypeName string
+
+	// DataSourceTypeName is the data source type of the operation, if the
+	// operation is for a data source.
+	DataSourceTypeName string
+
+	// Identity identifies the remote object of the operation. For imports,
+	// this is the import identifier. Otherwise, this is the known value of
+	// the root id string attribute of the resulting state, or the prior
+	// state for deletes. It is empty if there is no such value or the id
+	// attribute is Sensitive.
+	Identity string
+
+	// StartTime is when the framework started the operation.
+	StartTime time.Time
+
+	// Duration is the time spent on the operation.
+	Duration time.Duration
+
+	// Outcome is the result of the operation.
+	Outcome AuditOutcome
+
+	// ErrorCount is the number of error diagnostics returned by the
+	// operation.
+	ErrorCount int
+
+	// WarningCount is the number of warning diagnostics returned by the
+	// operation.
+	WarningCount int
+}
+
+// AuditSink receives structured audit records, such as for compliance
+// logging. Register the sink with the ProviderWithAuditSink interface.
+type AuditSink interface {
+	// Record is called once after each resource and data source operation
+	// completes, even if the operation returns error diagnostics. The
+	// framework may call Record concurrently, so implementations must be
+	// safe for concurrent use.
+	Record(context.Context, AuditRecord)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BoolRequest represents a request to resolve a bool provider configuration
+// value.
+type BoolRequest struct {
+	// Path is the provider schema path of the value, which is used for
+	// diagnostics.
+	Path path.Path
+
+	// ConfigValue is the provider configuration value, which takes
+	// precedence over environment variables when not null.
+	ConfigValue types.Bool
+
+	// EnvVars are the environment variable names which are checked, in
+	// order, when the configuration value is null. Values are parsed with
+	// strconv.ParseBool.
+	EnvVars []string
+
+	// Required returns an error diagnostic if neither the configuration
+	// value nor any environment variable is set.
+	Required bool
+}
+
+// Bool returns the known configuration value, if not null, otherwise the
+// first non-empty environment variable value. A null value is returned if no
+// value is found and the value is not required.
+func Bool(_ context.Context, req BoolRequest) (types.Bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.ConfigValue.IsUnknown() {
+		diags.Append(unknownValueDiagnostic(req.Path, req.EnvVars))
+
+		return types.BoolUnknown(), diags
+	}
+
+	if !req.ConfigValue.IsNull() {
+		return req.ConfigValue, diags
+	}
+
+	if envVar, value, ok := lookupEnvVars(req.EnvVars); ok {
+		boolValue, err := strconv.ParseBool(value)
+
+		if err != nil {
+			diags.Append(invalidEnvVarDiagnostic(req.Path, envVar, value, "boolean", err))
+
+			return types.BoolNull(), diags
+		}
+
+		return types.BoolValue(boolValue), diags
+	}
+
+	if req.Required {
+		diags.Append(missingValueDiagnostic(req.Path, req.EnvVars))
+	}
+
+	return types.BoolNull(), diags
+}
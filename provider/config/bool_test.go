@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/config"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+//nolint:paralleltest // t.Setenv is not compatible with t.Parallel
+func TestBool(t *testing.T) {
+	testCases := map[string]struct {
+		env           map[string]string
+		request       config.BoolRequest
+		expected      types.Bool
+		expectedDiags diag.Diagnostics
+	}{
+		"config-value": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_BOOL": "true",
+			},
+			request: config.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolValue(false),
+				EnvVars:     []string{"TF_TEST_CONFIG_BOOL"},
+			},
+			expected: types.BoolValue(false),
+		},
+		"config-unknown": {
+			request: config.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolUnknown(),
+			},
+			expected: types.BoolUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Unknown Provider Configuration Value",
+					"The provider cannot be configured as there is an unknown configuration value for test. "+
+						"Either target apply the source of the value first or set the value statically in the configuration.",
+				),
+			},
+		},
+		"env-value": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_BOOL": "true",
+			},
+			request: config.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolNull(),
+				EnvVars:     []string{"TF_TEST_CONFIG_BOOL"},
+			},
+			expected: types.BoolValue(true),
+		},
+		"env-value-invalid": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_BOOL": "yes",
+			},
+			request: config.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolNull(),
+				EnvVars:     []string{"TF_TEST_CONFIG_BOOL"},
+			},
+			expected: types.BoolNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Provider Environment Variable Value",
+					"The provider cannot be configured as the TF_TEST_CONFIG_BOOL environment variable value \"yes\" is not a valid boolean.\n\n"+
+						"Error: strconv.ParseBool: parsing \"yes\": invalid syntax",
+				),
+			},
+		},
+		"missing": {
+			request: config.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolNull(),
+				EnvVars:     []string{"TF_TEST_CONFIG_BOOL"},
+			},
+			expected: types.BoolNull(),
+		},
+		"missing-required": {
+			request: config.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolNull(),
+				EnvVars:     []string{"TF_TEST_CONFIG_BOOL"},
+				Required:    true,
+			},
+			expected: types.BoolNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Missing Provider Configuration Value",
+					"The provider cannot be configured as there is a missing or empty value for test. "+
+						"Set the value in the configuration or use the TF_TEST_CONFIG_BOOL environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for key, value := range testCase.env {
+				t.Setenv(key, value)
+			}
+
+			got, diags := config.Bool(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// unknownValueDiagnostic returns an error diagnostic for an unknown
+// configuration value.
+func unknownValueDiagnostic(attributePath path.Path, envVars []string) diag.Diagnostic {
+	detail := fmt.Sprintf("The provider cannot be configured as there is an unknown configuration value for %s. ", attributePath) +
+		"Either target apply the source of the value first or set the value statically in the configuration"
+
+	if len(envVars) > 0 {
+		detail += fmt.Sprintf(", or use the %s environment variable", envVarsString(envVars))
+	}
+
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Unknown Provider Configuration Value",
+		detail+".",
+	)
+}
+
+// missingValueDiagnostic returns an error diagnostic for a missing required
+// configuration value.
+func missingValueDiagnostic(attributePath path.Path, envVars []string) diag.Diagnostic {
+	detail := fmt.Sprintf("The provider cannot be configured as there is a missing or empty value for %s. ", attributePath) +
+		"Set the value in the configuration"
+
+	if len(envVars) > 0 {
+		detail += fmt.Sprintf(" or use the %s environment variable", envVarsString(envVars))
+	}
+
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Missing Provider Configuration Value",
+		detail+". If either is already set, ensure the value is not empty.",
+	)
+}
+
+// invalidEnvVarDiagnostic returns an error diagnostic for an environment
+// variable value which cannot be parsed into the value type.
+func invalidEnvVarDiagnostic(attributePath path.Path, envVar string, value string, valueType string, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Invalid Provider Environment Variable Value",
+		fmt.Sprintf("The provider cannot be configured as the %s environment variable value %q is not a valid %s.\n\n", envVar, value, valueType)+
+			fmt.Sprintf("Error: %s", err),
+	)
+}
+
+// envVarsString returns a human-readable list of environment variable names.
+func envVarsString(envVars []string) string {
+	if len(envVars) == 1 {
+		return envVars[0]
+	}
+
+	return strings.Join(envVars[:len(envVars)-1], ", ") + " or " + envVars[len(envVars)-1]
+}
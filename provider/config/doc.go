@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package config contains helpers for resolving provider configuration
+// values, which are commonly set either in the provider configuration or in
+// environment variables.
+//
+// Each function returns the known configuration value, if set. Otherwise, the
+// first non-empty environment variable value is returned. Unknown
+// configuration values, invalid environment variable values, and missing
+// required values are returned as attribute error diagnostics with consistent
+// practitioner-facing messages.
+package config
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"os"
+)
+
+// lookupEnvVars returns the name and value of the first environment variable
+// with a non-empty value.
+func lookupEnvVars(envVars []string) (string, string, bool) {
+	for _, envVar := range envVars {
+		if value := os.Getenv(envVar); value != "" {
+			return envVar, value, true
+		}
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Int64Request represents a request to resolve an int64 provider
+// configuration value.
+type Int64Request struct {
+	// Path is the provider schema path of the value, which is used for
+	// diagnostics.
+	Path path.Path
+
+	// ConfigValue is the provider configuration value, which takes
+	// precedence over environment variables when not null.
+	ConfigValue types.Int64
+
+	// EnvVars are the environment variable names which are checked, in
+	// order, when the configuration value is null. Values are parsed with
+	// strconv.ParseInt.
+	EnvVars []string
+
+	// Required returns an error diagnostic if neither the configuration
+	// value nor any environment variable is set.
+	Required bool
+}
+
+// Int64 returns the known configuration value, if not null, otherwise the
+// first non-empty environment variable value. A null value is returned if no
+// value is found and the value is not required.
+func Int64(_ context.Context, req Int64Request) (types.Int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.ConfigValue.IsUnknown() {
+		diags.Append(unknownValueDiagnostic(req.Path, req.EnvVars))
+
+		return types.Int64Unknown(), diags
+	}
+
+	if !req.ConfigValue.IsNull() {
+		return req.ConfigValue, diags
+	}
+
+	if envVar, value, ok := lookupEnvVars(req.EnvVars); ok {
+		int64Value, err := strconv.ParseInt(value, 10, 64)
+
+		if err != nil {
+			diags.Append(invalidEnvVarDiagnostic(req.Path, envVar, value, "integer", err))
+
+			return types.Int64Null(), diags
+		}
+
+		return types.Int64Value(int64Value), diags
+	}
+
+	if req.Required {
+		diags.Append(missingValueDiagnostic(req.Path, req.EnvVars))
+	}
+
+	return types.Int64Null(), diags
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/config"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+//nolint:paralleltest // t.Setenv is not compatible with t.Parallel
+func TestInt64(t *testing.T) {
+	testCases := map[string]struct {
+		env           map[string]string
+		request       config.Int64Request
+		expected      types.Int64
+		expectedDiags diag.Diagnostics
+	}{
+		"config-value": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_INT64": "2",
+			},
+			request: config.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Value(1),
+				EnvVars:     []string{"TF_TEST_CONFIG_INT64"},
+			},
+			expected: types.Int64Value(1),
+		},
+		"env-value": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_INT64": "2",
+			},
+			request: config.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Null(),
+				EnvVars:     []string{"TF_TEST_CONFIG_INT64"},
+			},
+			expected: types.Int64Value(2),
+		},
+		"env-value-invalid": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_INT64": "two",
+			},
+			request: config.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Null(),
+				EnvVars:     []string{"TF_TEST_CONFIG_INT64"},
+			},
+			expected: types.Int64Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Provider Environment Variable Value",
+					"The provider cannot be configured as the TF_TEST_CONFIG_INT64 environment variable value \"two\" is not a valid integer.\n\n"+
+						"Error: strconv.ParseInt: parsing \"two\": invalid syntax",
+				),
+			},
+		},
+		"missing": {
+			request: config.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int64Null(),
+				EnvVars:     []string{"TF_TEST_CONFIG_INT64"},
+			},
+			expected: types.Int64Null(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for key, value := range testCase.env {
+				t.Setenv(key, value)
+			}
+
+			got, diags := config.Int64(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StringRequest represents a request to resolve a string provider
+// configuration value.
+type StringRequest struct {
+	// Path is the provider schema path of the value, which is used for
+	// diagnostics.
+	Path path.Path
+
+	// ConfigValue is the provider configuration value, which takes
+	// precedence over environment variables when not null.
+	ConfigValue types.String
+
+	// EnvVars are the environment variable names which are checked, in
+	// order, when the configuration value is null.
+	EnvVars []string
+
+	// Required returns an error diagnostic if neither the configuration
+	// value nor any environment variable is set.
+	Required bool
+}
+
+// String returns the known configuration value, if not null, otherwise the
+// first non-empty environment variable value. A null value is returned if no
+// value is found and the value is not required.
+func String(_ context.Context, req StringRequest) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.ConfigValue.IsUnknown() {
+		diags.Append(unknownValueDiagnostic(req.Path, req.EnvVars))
+
+		return types.StringUnknown(), diags
+	}
+
+	if !req.ConfigValue.IsNull() {
+		return req.ConfigValue, diags
+	}
+
+	if _, value, ok := lookupEnvVars(req.EnvVars); ok {
+		return types.StringValue(value), diags
+	}
+
+	if req.Required {
+		diags.Append(missingValueDiagnostic(req.Path, req.EnvVars))
+	}
+
+	return types.StringNull(), diags
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/config"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+//nolint:paralleltest // t.Setenv is not compatible with t.Parallel
+func TestString(t *testing.T) {
+	testCases := map[string]struct {
+		env           map[string]string
+		request       config.StringRequest
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"config-value": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_STRING": "env-value",
+			},
+			request: config.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("config-value"),
+				EnvVars:     []string{"TF_TEST_CONFIG_STRING"},
+			},
+			expected: types.StringValue("config-value"),
+		},
+		"config-unknown": {
+			request: config.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+				EnvVars:     []string{"TF_TEST_CONFIG_STRING"},
+			},
+			expected: types.StringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Unknown Provider Configuration Value",
+					"The provider cannot be configured as there is an unknown configuration value for test. "+
+						"Either target apply the source of the value first or set the value statically in the configuration, "+
+						"or use the TF_TEST_CONFIG_STRING environment variable.",
+				),
+			},
+		},
+		"env-value": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_STRING": "env-value",
+			},
+			request: config.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+				EnvVars:     []string{"TF_TEST_CONFIG_STRING"},
+			},
+			expected: types.StringValue("env-value"),
+		},
+		"env-value-precedence": {
+			env: map[string]string{
+				"TF_TEST_CONFIG_STRING_1": "",
+				"TF_TEST_CONFIG_STRING_2": "env-value-2",
+				"TF_TEST_CONFIG_STRING_3": "env-value-3",
+			},
+			request: config.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+				EnvVars:     []string{"TF_TEST_CONFIG_STRING_1", "TF_TEST_CONFIG_STRING_2", "TF_TEST_CONFIG_STRING_3"},
+			},
+			expected: types.StringValue("env-value-2"),
+		},
+		"missing": {
+			request: config.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+				EnvVars:     []string{"TF_TEST_CONFIG_STRING"},
+			},
+			expected: types.StringNull(),
+		},
+		"missing-required": {
+			request: config.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+				EnvVars:     []string{"TF_TEST_CONFIG_STRING_1", "TF_TEST_CONFIG_STRING_2"},
+				Required:    true,
+			},
+			expected: types.StringNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Missing Provider Configuration Value",
+					"The provider cannot be configured as there is a missing or empty value for test. "+
+						"Set the value in the configuration or use the TF_TEST_CONFIG_STRING_1 or TF_TEST_CONFIG_STRING_2 environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				),
+			},
+		},
+		"missing-required-no-env-vars": {
+			request: config.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+				Required:    true,
+			},
+			expected: types.StringNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Missing Provider Configuration Value",
+					"The provider cannot be configured as there is a missing or empty value for test. "+
+						"Set the value in the configuration. "+
+						"If either is already set, ensure the value is not empty.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for key, value := range testCase.env {
+				t.Setenv(key, value)
+			}
+
+			got, diags := config.String(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -76,4 +76,29 @@ type ConfigureResponse struct {
 	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
 	// to change or break without warning. It is not protected by version compatibility guarantees.
 	Deferred *Deferred
+
+	// DisabledDataSources contains data source type names which are not
+	// enabled by the provider configuration, such as beta data sources that
+	// require practitioner opt-in, mapped to a practitioner-facing reason,
+	// which should include how to enable the data source. The framework
+	// returns an error diagnostic when reading a disabled data source.
+	DisabledDataSources map[string]string
+
+	// DisabledResources contains resource type names which are not enabled
+	// by the provider configuration, such as beta resources that require
+	// practitioner opt-in, mapped to a practitioner-facing reason, which
+	// should include how to enable the resource. The framework returns an
+	// error diagnostic when planning the creation or update of a disabled
+	// resource, or when importing it. Reading and destroying existing
+	// resources are still supported, so practitioners can remove them.
+	DisabledResources map[string]string
+
+	// SuppressedWarnings contains warning diagnostic keys which the
+	// framework removes from all responses after the provider is
+	// configured, including this response. Warnings are classified with
+	// keys by functionality such as the diag.NewWarningDiagnosticWithKey
+	// function. Practitioners typically choose the keys with a provider
+	// configuration attribute created by the provider/schema package
+	// SuppressWarningsAttribute function.
+	SuppressedWarnings diag.SuppressedWarnings
 }
@@ -24,6 +24,14 @@ const (
 type Deferred struct {
 	// Reason is the reason for deferring the change.
 	Reason DeferredReason
+
+	// Explanation is an optional human-readable explanation of why the
+	// change was deferred, such as which provider configuration value is not
+	// yet known. When set, the framework logs the explanation and returns it
+	// in a warning diagnostic for each automatically deferred resource, data
+	// source, and ephemeral resource, so practitioners can determine why the
+	// change was deferred in large plans.
+	Explanation string
 }
 
 // DeferredReason represents different reasons for deferring a change.
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// HookPoint is the name of a point in the resource and data source
+// lifecycle at which the framework calls provider-defined hook functions.
+type HookPoint string
+
+const (
+	// HookPointBeforeValidate is called before resource or data source
+	// configuration validation.
+	HookPointBeforeValidate HookPoint = "BeforeValidate"
+
+	// HookPointAfterValidate is called after resource or data source
+	// configuration validation.
+	HookPointAfterValidate HookPoint = "AfterValidate"
+
+	// HookPointBeforePlan is called before resource plan modification.
+	HookPointBeforePlan HookPoint = "BeforePlan"
+
+	// HookPointAfterPlan is called after resource plan modification.
+	HookPointAfterPlan HookPoint = "AfterPlan"
+
+	// HookPointBeforeApply is called before a resource is created, updated,
+	// or deleted.
+	HookPointBeforeApply HookPoint = "BeforeApply"
+
+	// HookPointAfterApply is called after a resource is created, updated,
+	// or deleted.
+	HookPointAfterApply HookPoint = "AfterApply"
+
+	// HookPointBeforeRead is called before a resource or data source is
+	// read.
+	HookPointBeforeRead HookPoint = "BeforeRead"
+
+	// HookPointAfterRead is called after a resource or data source is read.
+	HookPointAfterRead HookPoint = "AfterRead"
+)
+
+// HookFunc is a provider-defined function which the framework calls at a
+// HookPoint.
+type HookFunc func(context.Context, HookRequest)
+
+// Hooks are provider-defined functions, attached to named hook points, which
+// the framework calls around resource and data source operations. Hooks
+// enable cross-cutting concerns, such as audit logging or cache
+// invalidation, without wrapping every resource and data source
+// implementation. Register hooks with the ProviderWithHooks interface.
+//
+// Functions for each hook point are called in slice order. After hooks are
+// always called once the before hooks are called, even if the operation
+// returns error diagnostics.
+type Hooks struct {
+	// BeforeValidate and AfterValidate are called around the
+	// ValidateResourceConfig and ValidateDataSourceConfig RPCs.
+	BeforeValidate []HookFunc
+	AfterValidate  []HookFunc
+
+	// BeforePlan and AfterPlan are called around the PlanResourceChange RPC.
+	BeforePlan []HookFunc
+	AfterPlan  []HookFunc
+
+	// BeforeApply and AfterApply are called around the ApplyResourceChange
+	// RPC.
+	BeforeApply []HookFunc
+	AfterApply  []HookFunc
+
+	// BeforeRead and AfterRead are called around the ReadResource and
+	// ReadDataSource RPCs.
+	BeforeRead []HookFunc
+	AfterRead  []HookFunc
+}
+
+// HookRequest represents a request to a HookFunc.
+type HookRequest struct {
+	// HookPoint is the hook point being called.
+	HookPoint HookPoint
+
+	// ResourceTypeName is the resource type of the operation, if the
+	// operation is for a resource.
+	ResourceTypeName string
+
+	// DataSourceTypeName is the data source type of the operation, if the
+	// operation is for a data source.
+	DataSourceTypeName string
+
+	// Diagnostics is the diagnostics returned by the operation. It is only
+	// populated for after hook points.
+	Diagnostics diag.Diagnostics
+
+	// Duration is the time spent on the operation. It is only populated for
+	// after hook points.
+	Duration time.Duration
+}
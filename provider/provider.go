@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,7 +20,15 @@ import (
 //   - Validation: Schema-based or entire configuration
 //     via ProviderWithConfigValidators or ProviderWithValidateConfig.
 //   - Functions: ProviderWithFunctions
+//   - Function Telemetry: ProviderWithFunctionRunHooks
+//   - Resource and Data Source Hooks: ProviderWithHooks
+//   - Audit Logging: ProviderWithAuditSink
 //   - Meta Schema: ProviderWithMetaSchema
+//   - Diagnostic Messages: ProviderWithDiagnosticMessageCatalog
+//   - Diagnostic Limits: ProviderWithDiagnosticLimits
+//   - Markdown Description Templates: ProviderWithMarkdownDescriptionTemplates
+//   - State Transformation: ProviderWithStateTransformer
+//   - Strict Schema Validation: ProviderWithStrictSchemaValidation
 type Provider interface {
 	// Metadata should return the metadata for the provider, such as
 	// a type name and version data.
@@ -71,6 +80,30 @@ type ProviderWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// ProviderWithDiagnosticLimits is an interface type that extends Provider to
+// limit the number of diagnostics returned to Terraform in a single response.
+// Omitted diagnostics are replaced with summary diagnostics containing counts.
+type ProviderWithDiagnosticLimits interface {
+	Provider
+
+	// DiagnosticLimits returns the limits to apply to every response. It is
+	// called once per provider server.
+	DiagnosticLimits(context.Context) diag.Limits
+}
+
+// ProviderWithDiagnosticMessageCatalog is an interface type that extends
+// Provider to replace diagnostic messages, including those emitted by the
+// framework, before they are returned to Terraform. This enables providers to
+// translate messages for practitioners who do not read English.
+type ProviderWithDiagnosticMessageCatalog interface {
+	Provider
+
+	// DiagnosticMessageCatalog returns the catalog of replacement diagnostic
+	// messages, keyed by original diagnostic summary. It is called once per
+	// provider server.
+	DiagnosticMessageCatalog(context.Context) diag.MessageCatalog
+}
+
 // ProviderWithFunctions is an interface type that extends Provider to
 // include provider defined functions for usage in practitioner configurations.
 //
@@ -86,6 +119,39 @@ type ProviderWithFunctions interface {
 	Functions(context.Context) []func() function.Function
 }
 
+// ProviderWithFunctionRunHooks is an interface type that extends
+// ProviderWithFunctions to include hooks which the framework calls before and
+// after each provider-defined function call, such as for recording telemetry.
+type ProviderWithFunctionRunHooks interface {
+	ProviderWithFunctions
+
+	// FunctionRunHooks returns the hooks to call around each function call.
+	// Hooks are called in slice order. The framework calls this method once
+	// and reuses the hooks for every function call.
+	FunctionRunHooks(context.Context) []function.RunHook
+}
+
+// ProviderWithHooks is an interface type that extends Provider to include
+// hooks which the framework calls around resource and data source operations,
+// such as for audit logging or cache invalidation.
+type ProviderWithHooks interface {
+	Provider
+
+	// Hooks returns the functions to call at each hook point.
+	Hooks(context.Context) Hooks
+}
+
+// ProviderWithAuditSink is an interface type that extends Provider to include
+// a sink which receives a structured audit record after each resource and
+// data source operation, such as for compliance logging.
+type ProviderWithAuditSink interface {
+	Provider
+
+	// AuditSink returns the sink to receive audit records. Returning nil
+	// disables audit records.
+	AuditSink(context.Context) AuditSink
+}
+
 // ProviderWithEphemeralResources is an interface type that extends Provider to
 // include ephemeral resources for usage in practitioner configurations.
 //
@@ -104,6 +170,20 @@ type ProviderWithEphemeralResources interface {
 	EphemeralResources(context.Context) []func() ephemeral.EphemeralResource
 }
 
+// ProviderWithMarkdownDescriptionTemplates is an interface type that extends
+// Provider to render attribute MarkdownDescription values containing template
+// actions, such as {{ .Default }}, as text/template templates with attribute
+// metadata when the provider schema is returned to Terraform. Descriptions
+// are never rendered unless this is enabled, so descriptions which contain
+// literal "{{" text are unaffected by default.
+type ProviderWithMarkdownDescriptionTemplates interface {
+	Provider
+
+	// MarkdownDescriptionTemplates should return true to enable rendering
+	// attribute MarkdownDescription templates.
+	MarkdownDescriptionTemplates(context.Context) bool
+}
+
 // ProviderWithMetaSchema is a provider with a provider meta schema, which
 // is configured by practitioners via the provider_meta configuration block
 // and the configuration data is included with certain data source and resource
@@ -125,6 +205,34 @@ type ProviderWithMetaSchema interface {
 	MetaSchema(context.Context, MetaSchemaRequest, *MetaSchemaResponse)
 }
 
+// ProviderWithStateTransformer is an interface type that extends Provider to
+// transform sensitive resource attribute values, such as by encrypting or
+// tokenizing them, before they are saved in state. Only resource attributes
+// with the StateTransform schema field enabled are transformed.
+type ProviderWithStateTransformer interface {
+	Provider
+
+	// StateTransformer returns the transformer for resource state values.
+	// Returning nil disables state transformation.
+	StateTransformer(context.Context) StateTransformer
+}
+
+// ProviderWithStrictSchemaValidation is an interface type that extends
+// Provider to enable additional resource schema checks when the provider
+// schema is requested by Terraform. The checks return warning diagnostics
+// for schema definitions which commonly cause provider bugs, such as
+// Optional and Computed attributes without a Default, a UseStateForUnknown
+// plan modifier, or semantic equality, which are planned as unknown on every
+// resource update and can cause "Provider produced inconsistent result after
+// apply" errors.
+type ProviderWithStrictSchemaValidation interface {
+	Provider
+
+	// StrictSchemaValidation should return true to enable the additional
+	// resource schema checks.
+	StrictSchemaValidation(context.Context) bool
+}
+
 // ProviderWithValidateConfig is an interface type that extends Provider to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
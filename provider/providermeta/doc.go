@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package providermeta contains helpers for reading provider meta data, which
+// modules set in the provider_meta block of the terraform block, into a
+// provider-defined model type. This enables the model type to be declared once
+// and read across all resources and data sources.
+//
+// The provider must implement the provider.ProviderWithMetaSchema interface
+// for Terraform to send provider meta data.
+package providermeta
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providermeta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Get returns the provider meta data as the model type T, which must be
+// compatible with the provider meta schema, such as a struct with tfsdk field
+// tags. The providerMeta is the ProviderMeta field of the request, such as
+// resource.CreateRequest.ProviderMeta.
+//
+// The zero value of T is returned without diagnostics if the module does not
+// contain a provider_meta block for the provider. An error diagnostic is
+// returned if the provider does not define a provider meta schema.
+func Get[T any](ctx context.Context, providerMeta tfsdk.Config) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var result T
+
+	if providerMeta.Schema == nil {
+		diags.AddError(
+			"Missing Provider Meta Schema",
+			"The provider meta data was requested, but the provider does not define a provider meta schema. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Implement the provider.ProviderWithMetaSchema interface to define the provider meta schema.",
+		)
+
+		return result, diags
+	}
+
+	if providerMeta.Raw.IsNull() {
+		return result, diags
+	}
+
+	diags.Append(providerMeta.Get(ctx, &result)...)
+
+	return result, diags
+}
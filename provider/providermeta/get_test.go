@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providermeta_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+	"github.com/hashicorp/terraform-plugin-framework/provider/providermeta"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testModel struct {
+	ModuleName types.String `tfsdk:"module_name"`
+}
+
+var testSchema = metaschema.Schema{
+	Attributes: map[string]metaschema.Attribute{
+		"module_name": metaschema.StringAttribute{
+			Optional: true,
+		},
+	},
+}
+
+var testSchemaType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"module_name": tftypes.String,
+	},
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerMeta  tfsdk.Config
+		expected      testModel
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			providerMeta: tfsdk.Config{
+				Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"module_name": tftypes.NewValue(tftypes.String, "test-module"),
+				}),
+				Schema: testSchema,
+			},
+			expected: testModel{
+				ModuleName: types.StringValue("test-module"),
+			},
+		},
+		"attribute-null": {
+			providerMeta: tfsdk.Config{
+				Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"module_name": tftypes.NewValue(tftypes.String, nil),
+				}),
+				Schema: testSchema,
+			},
+			expected: testModel{
+				ModuleName: types.StringNull(),
+			},
+		},
+		"null": {
+			providerMeta: tfsdk.Config{
+				Raw:    tftypes.NewValue(testSchemaType, nil),
+				Schema: testSchema,
+			},
+			expected: testModel{},
+		},
+		"missing-schema": {
+			providerMeta: tfsdk.Config{},
+			expected:     testModel{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Provider Meta Schema",
+					"The provider meta data was requested, but the provider does not define a provider meta schema. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Implement the provider.ProviderWithMetaSchema interface to define the provider meta schema.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := providermeta.Get[testModel](context.Background(), testCase.providerMeta)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateModel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		diags := providermeta.ValidateModel[testModel](context.Background(), testSchema)
+
+		if diags.HasError() {
+			t.Errorf("unexpected error: %v", diags)
+		}
+	})
+
+	t.Run("missing-field", func(t *testing.T) {
+		t.Parallel()
+
+		type model struct{}
+
+		diags := providermeta.ValidateModel[model](context.Background(), testSchema)
+
+		if !diags.HasError() {
+			t.Error("expected error, got none")
+		}
+	})
+
+	t.Run("null-primitive", func(t *testing.T) {
+		t.Parallel()
+
+		type model struct {
+			ModuleName string `tfsdk:"module_name"`
+		}
+
+		diags := providermeta.ValidateModel[model](context.Background(), testSchema)
+
+		if !diags.HasError() {
+			t.Error("expected error, got none")
+		}
+	})
+
+	t.Run("invalid-schema", func(t *testing.T) {
+		t.Parallel()
+
+		schema := metaschema.Schema{
+			Attributes: map[string]metaschema.Attribute{
+				"invalid-name": metaschema.StringAttribute{
+					Optional: true,
+				},
+			},
+		}
+
+		diags := providermeta.ValidateModel[testModel](context.Background(), schema)
+
+		if !diags.HasError() {
+			t.Error("expected error, got none")
+		}
+
+		if len(diags) > 0 && diags[0].Summary() != "Invalid Attribute/Block Name" {
+			t.Errorf("unexpected diagnostic summary: %s", diags[0].Summary())
+		}
+	})
+}
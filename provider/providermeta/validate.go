@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providermeta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ValidateModel returns error diagnostics if the model type T cannot hold all
+// provider meta data which modules can set according to the schema, such as
+// missing or extra struct fields, or Go types which cannot hold null values
+// of attributes which modules may omit. This is intended to be called in
+// provider unit testing alongside the schema ValidateImplementation method.
+func ValidateModel[T any](ctx context.Context, schema metaschema.Schema) diag.Diagnostics {
+	diags := schema.ValidateImplementation(ctx)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	attributeValues := make(map[string]tftypes.Value, len(schema.Attributes))
+
+	for name, attribute := range schema.Attributes {
+		attributeValues[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+	}
+
+	providerMeta := tfsdk.Config{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), attributeValues),
+		Schema: schema,
+	}
+
+	_, getDiags := Get[T](ctx, providerMeta)
+
+	diags.Append(getDiags...)
+
+	return diags
+}
@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                             = BoolAttribute{}
+	_ fwschema.AttributeWithDefaultEnv      = BoolAttribute{}
 	_ fwxschema.AttributeWithBoolValidators = BoolAttribute{}
 )
 
@@ -101,6 +102,19 @@ type BoolAttribute struct {
 	//
 	DeprecationMessage string
 
+	// DefaultEnv defines the names of environment variables to read, in order
+	// of precedence, when the configuration value is null. The framework sets
+	// the value of the first environment variable which is set and not empty
+	// in the configuration passed to the provider Configure method. If no
+	// environment variable is set, the value remains null. Unknown
+	// configuration values are not changed. Values which cannot be converted
+	// to the attribute type return an error diagnostic.
+	//
+	// This field should only be used with Optional attributes, since
+	// Terraform requires values for Required attributes in the configuration.
+	// Validators are not run on environment variable values.
+	DefaultEnv []string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -135,6 +149,11 @@ func (a BoolAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetDefaultEnv returns the DefaultEnv field value.
+func (a BoolAttribute) GetDefaultEnv() []string {
+	return a.DefaultEnv
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a BoolAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
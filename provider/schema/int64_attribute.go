@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                              = Int64Attribute{}
+	_ fwschema.AttributeWithDefaultEnv       = Int64Attribute{}
 	_ fwxschema.AttributeWithInt64Validators = Int64Attribute{}
 )
 
@@ -104,6 +105,19 @@ type Int64Attribute struct {
 	//
 	DeprecationMessage string
 
+	// DefaultEnv defines the names of environment variables to read, in order
+	// of precedence, when the configuration value is null. The framework sets
+	// the value of the first environment variable which is set and not empty
+	// in the configuration passed to the provider Configure method. If no
+	// environment variable is set, the value remains null. Unknown
+	// configuration values are not changed. Values which cannot be converted
+	// to the attribute type return an error diagnostic.
+	//
+	// This field should only be used with Optional attributes, since
+	// Terraform requires values for Required attributes in the configuration.
+	// Validators are not run on environment variable values.
+	DefaultEnv []string
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -133,6 +147,11 @@ func (a Int64Attribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetDefaultEnv returns the DefaultEnv field value.
+func (a Int64Attribute) GetDefaultEnv() []string {
+	return a.DefaultEnv
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a Int64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
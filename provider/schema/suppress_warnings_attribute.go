@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SuppressWarningsAttribute returns an optional set of strings attribute for
+// practitioners to choose warning diagnostic keys to suppress. The keys
+// argument maps each warning key the provider can return, such as with the
+// diag.NewWarningDiagnosticWithKey function, to a description of the warning,
+// which is included in the attribute documentation. A warning diagnostic is
+// returned for configured keys which are not in the keys argument, so
+// configurations are not rejected when a key is removed.
+//
+// Read the attribute value in the provider Configure method and set the
+// provider.ConfigureResponse type SuppressedWarnings field:
+//
+//	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("suppress_warnings"), &resp.SuppressedWarnings)...)
+func SuppressWarningsAttribute(keys map[string]string) SetAttribute {
+	sortedKeys := make([]string, 0, len(keys))
+
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+
+	sort.Strings(sortedKeys)
+
+	var description, markdownDescription strings.Builder
+
+	description.WriteString("Warning keys to suppress.")
+	markdownDescription.WriteString("Warning keys to suppress.")
+
+	if len(sortedKeys) > 0 {
+		description.WriteString(" Valid keys are:")
+		markdownDescription.WriteString(" Valid keys are:\n")
+	}
+
+	for _, key := range sortedKeys {
+		description.WriteString(fmt.Sprintf(" %q (%s)", key, keys[key]))
+		markdownDescription.WriteString(fmt.Sprintf("\n  - `%s`: %s", key, keys[key]))
+	}
+
+	return SetAttribute{
+		Description:         description.String(),
+		ElementType:         types.StringType,
+		MarkdownDescription: markdownDescription.String(),
+		Optional:            true,
+		Validators: []validator.Set{
+			suppressWarningsValidator{
+				keys: sortedKeys,
+			},
+		},
+	}
+}
+
+var _ validator.Set = suppressWarningsValidator{}
+
+// suppressWarningsValidator returns a warning diagnostic for unknown warning
+// keys.
+type suppressWarningsValidator struct {
+	keys []string
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v suppressWarningsValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v suppressWarningsValidator) MarkdownDescription(_ context.Context) string {
+	return "elements should be known warning keys"
+}
+
+// ValidateSet performs the validation.
+func (v suppressWarningsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var elements []types.String
+
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &elements, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, element := range elements {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+
+		key := element.ValueString()
+		index := sort.SearchStrings(v.keys, key)
+
+		if index < len(v.keys) && v.keys[index] == key {
+			continue
+		}
+
+		detail := fmt.Sprintf("The warning key %q is not returned by this provider version and has no effect.", key)
+
+		if len(v.keys) > 0 {
+			detail += fmt.Sprintf(" Valid keys are: %s", strings.Join(v.keys, ", "))
+		}
+
+		resp.Diagnostics.AddAttributeWarning(req.Path.AtSetValue(element), "Unknown Warning Key", detail)
+	}
+}
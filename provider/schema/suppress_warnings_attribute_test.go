@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressWarningsAttributeDescription(t *testing.T) {
+	t.Parallel()
+
+	attribute := schema.SuppressWarningsAttribute(map[string]string{
+		"legacy_field":  "Usage of the legacy field.",
+		"beta_resource": "Usage of beta resources.",
+	})
+
+	expectedDescription := `Warning keys to suppress. Valid keys are: "beta_resource" (Usage of beta resources.) "legacy_field" (Usage of the legacy field.)`
+
+	if diff := cmp.Diff(attribute.GetDescription(), expectedDescription); diff != "" {
+		t.Errorf("unexpected description difference: %s", diff)
+	}
+
+	expectedMarkdownDescription := "Warning keys to suppress. Valid keys are:\n\n" +
+		"  - `beta_resource`: Usage of beta resources.\n" +
+		"  - `legacy_field`: Usage of the legacy field."
+
+	if diff := cmp.Diff(attribute.GetMarkdownDescription(), expectedMarkdownDescription); diff != "" {
+		t.Errorf("unexpected markdown description difference: %s", diff)
+	}
+
+	if !attribute.IsOptional() {
+		t.Error("expected attribute to be optional")
+	}
+}
+
+func TestSuppressWarningsAttributeValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keys     map[string]string
+		value    types.Set
+		expected diag.Diagnostics
+	}{
+		"null": {
+			keys:  map[string]string{"test_key": "Test warning."},
+			value: types.SetNull(types.StringType),
+		},
+		"unknown": {
+			keys:  map[string]string{"test_key": "Test warning."},
+			value: types.SetUnknown(types.StringType),
+		},
+		"valid": {
+			keys: map[string]string{"test_key": "Test warning."},
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("test_key"),
+			}),
+		},
+		"unknown-key": {
+			keys: map[string]string{
+				"other_key": "Other warning.",
+				"test_key":  "Test warning.",
+			},
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("test_key"),
+				types.StringValue("removed_key"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("suppress_warnings").AtSetValue(types.StringValue("removed_key")),
+					"Unknown Warning Key",
+					`The warning key "removed_key" is not returned by this provider version and has no effect. Valid keys are: other_key, test_key`,
+				),
+			},
+		},
+		"unknown-key-no-keys": {
+			keys: nil,
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("removed_key"),
+			}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("suppress_warnings").AtSetValue(types.StringValue("removed_key")),
+					"Unknown Warning Key",
+					`The warning key "removed_key" is not returned by this provider version and has no effect.`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got diag.Diagnostics
+
+			for _, setValidator := range schema.SuppressWarningsAttribute(testCase.keys).SetValidators() {
+				resp := &validator.SetResponse{}
+
+				setValidator.ValidateSet(context.Background(), validator.SetRequest{
+					ConfigValue: testCase.value,
+					Path:        path.Root("suppress_warnings"),
+				}, resp)
+
+				got.Append(resp.Diagnostics...)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
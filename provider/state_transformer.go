@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// StateTransformer transforms the values of resource attributes with the
+// StateTransform schema field enabled, such as by encrypting or tokenizing
+// them, before they are saved in state and reverses the transformation when
+// they are read from state. Register the transformer with the
+// ProviderWithStateTransformer interface.
+//
+// Resource logic, such as plan modifiers and the Create, Read, and Update
+// methods, only receives decoded values. The framework keeps the stored
+// value of unchanged decoded values, so Encode is only called for new or
+// changed values and does not need to be deterministic.
+type StateTransformer interface {
+	// Encode transforms a decoded value into the value to save in state.
+	Encode(context.Context, StateTransformRequest, *StateTransformResponse)
+
+	// Decode transforms a value read from state back into the decoded
+	// value.
+	Decode(context.Context, StateTransformRequest, *StateTransformResponse)
+}
+
+// StateTransformRequest is the request for a StateTransformer Encode or
+// Decode call. The framework only calls the transformer for known, non-null
+// values.
+type StateTransformRequest struct {
+	// ResourceTypeName is the type name of the resource which contains the
+	// value.
+	ResourceTypeName string
+
+	// Path is the path of the value in the resource state.
+	Path path.Path
+
+	// Value is the value to transform.
+	Value string
+}
+
+// StateTransformResponse is the response for a StateTransformer Encode or
+// Decode call.
+type StateTransformResponse struct {
+	// Value is the transformed value. It is initialized to the request
+	// value.
+	Value string
+
+	// Diagnostics report errors or warnings related to transforming the
+	// value. An error diagnostic fails the operation.
+	Diagnostics diag.Diagnostics
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fixit"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -30,4 +31,10 @@ type ValidateConfigResponse struct {
 	// configuration. An empty slice indicates success, with no warnings or
 	// errors generated.
 	Diagnostics diag.Diagnostics
+
+	// SuggestedChanges are machine-readable configuration changes which
+	// would resolve the validation issues reported in Diagnostics. They are
+	// not sent to Terraform, but enable tests and tooling to verify the
+	// suggested remediation rather than only the diagnostic text.
+	SuggestedChanges fixit.SuggestedChanges
 }
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package providerfuzz contains a harness for fuzzing how the framework
+// decodes protocol data, such as configuration, plan, and state, for the
+// schemas of a provider. This hardens providers against malformed data from
+// buggy clients without calling any provider logic which may interact with
+// remote systems, such as Configure, Create, or Read methods.
+//
+// Call AddSeeds and Decode from a Go fuzz test in the provider codebase:
+//
+//	func FuzzProviderDecode(f *testing.F) {
+//		providerfuzz.AddSeeds(f, New("test")())
+//
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			providerfuzz.Decode(t, New("test")(), data)
+//		})
+//	}
+package providerfuzz
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerfuzz
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// AddSeeds adds msgpack encoded seed values to the fuzz test corpus for each
+// schema of the provider, including null values and objects with null
+// attributes, so the fuzzing engine starts from data close to what Terraform
+// sends.
+func AddSeeds(f *testing.F, p provider.Provider) {
+	f.Helper()
+
+	ctx := context.Background()
+
+	for _, schema := range providerSchemas(f, ctx, p) {
+		schemaType := schema.Type().TerraformType(ctx)
+
+		f.Add(msgPack(f, schemaType, nil))
+
+		objectType, ok := schemaType.(tftypes.Object)
+
+		if !ok {
+			continue
+		}
+
+		attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+
+		f.Add(msgPack(f, schemaType, attributes))
+	}
+}
+
+// Decode decodes the data as a msgpack DynamicValue of every schema of the
+// provider, as configuration, plan, and state, using both protocol version 5
+// and 6 decoding. Each top level attribute and block value is then read, so
+// framework value types are created from the data. Decoding errors are
+// expected for malformed data and are not reported.
+//
+// The test fails if decoding panics or successfully returns data which does
+// not conform to the schema.
+func Decode(t *testing.T, p provider.Provider, data []byte) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	for _, schema := range providerSchemas(t, ctx, p) {
+		proto5DynamicValue := &tfprotov5.DynamicValue{MsgPack: data}
+		proto6DynamicValue := &tfprotov6.DynamicValue{MsgPack: data}
+
+		proto5Config, diags := fromproto5.Config(ctx, proto5DynamicValue, schema)
+		checkDecoded(t, ctx, schema, proto5Config, diags)
+
+		proto5Plan, diags := fromproto5.Plan(ctx, proto5DynamicValue, schema)
+		checkDecoded(t, ctx, schema, proto5Plan, diags)
+
+		proto5State, diags := fromproto5.State(ctx, proto5DynamicValue, schema)
+		checkDecoded(t, ctx, schema, proto5State, diags)
+
+		proto6Config, diags := fromproto6.Config(ctx, proto6DynamicValue, schema)
+		checkDecoded(t, ctx, schema, proto6Config, diags)
+
+		proto6Plan, diags := fromproto6.Plan(ctx, proto6DynamicValue, schema)
+		checkDecoded(t, ctx, schema, proto6Plan, diags)
+
+		proto6State, diags := fromproto6.State(ctx, proto6DynamicValue, schema)
+		checkDecoded(t, ctx, schema, proto6State, diags)
+	}
+}
+
+// decodedData is the common interface of tfsdk.Config, tfsdk.Plan, and
+// tfsdk.State for reading decoded data.
+type decodedData interface {
+	*tfsdk.Config | *tfsdk.Plan | *tfsdk.State
+}
+
+// checkDecoded verifies successfully decoded data conforms to the schema and
+// reads each top level attribute and block value.
+func checkDecoded[T decodedData](t *testing.T, ctx context.Context, schema fwschema.Schema, data T, diags diag.Diagnostics) {
+	t.Helper()
+
+	if diags.HasError() || data == nil {
+		return
+	}
+
+	var raw tftypes.Value
+	var getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics
+
+	switch data := any(data).(type) {
+	case *tfsdk.Config:
+		raw, getAttribute = data.Raw, data.GetAttribute
+	case *tfsdk.Plan:
+		raw, getAttribute = data.Raw, data.GetAttribute
+	case *tfsdk.State:
+		raw, getAttribute = data.Raw, data.GetAttribute
+	}
+
+	if !raw.Type().Equal(schema.Type().TerraformType(ctx)) {
+		t.Errorf("decoded data type %s does not match schema type %s", raw.Type(), schema.Type().TerraformType(ctx))
+
+		return
+	}
+
+	if raw.IsNull() || !raw.IsKnown() {
+		return
+	}
+
+	for _, name := range sortedKeys(schema.GetAttributes()) {
+		var value attr.Value
+
+		// Diagnostics are expected for some decoded data, such as unknown
+		// values inside of state.
+		_ = getAttribute(ctx, path.Root(name), &value)
+	}
+
+	for _, name := range sortedKeys(schema.GetBlocks()) {
+		var value attr.Value
+
+		_ = getAttribute(ctx, path.Root(name), &value)
+	}
+}
+
+// providerSchemas returns all schemas of the provider, sorted by kind and
+// type name so fuzzing is deterministic.
+func providerSchemas(tb testing.TB, ctx context.Context, p provider.Provider) []fwschema.Schema {
+	tb.Helper()
+
+	server := &fwserver.Server{
+		Provider: p,
+	}
+
+	var diags diag.Diagnostics
+	var schemas []fwschema.Schema
+
+	providerSchema, schemaDiags := server.ProviderSchema(ctx)
+
+	diags.Append(schemaDiags...)
+
+	if providerSchema != nil {
+		schemas = append(schemas, providerSchema)
+	}
+
+	providerMetaSchema, schemaDiags := server.ProviderMetaSchema(ctx)
+
+	diags.Append(schemaDiags...)
+
+	if providerMetaSchema != nil {
+		schemas = append(schemas, providerMetaSchema)
+	}
+
+	resourceSchemas, schemaDiags := server.ResourceSchemas(ctx)
+
+	diags.Append(schemaDiags...)
+
+	for _, typeName := range sortedKeys(resourceSchemas) {
+		schemas = append(schemas, resourceSchemas[typeName])
+	}
+
+	dataSourceSchemas, schemaDiags := server.DataSourceSchemas(ctx)
+
+	diags.Append(schemaDiags...)
+
+	for _, typeName := range sortedKeys(dataSourceSchemas) {
+		schemas = append(schemas, dataSourceSchemas[typeName])
+	}
+
+	ephemeralResourceSchemas, schemaDiags := server.EphemeralResourceSchemas(ctx)
+
+	diags.Append(schemaDiags...)
+
+	for _, typeName := range sortedKeys(ephemeralResourceSchemas) {
+		schemas = append(schemas, ephemeralResourceSchemas[typeName])
+	}
+
+	if diags.HasError() {
+		tb.Fatalf("unable to get provider schemas: %v", diags)
+	}
+
+	return schemas
+}
+
+// msgPack returns the msgpack encoding of the value.
+func msgPack(tb testing.TB, valueType tftypes.Type, value interface{}) []byte {
+	tb.Helper()
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(valueType, tftypes.NewValue(valueType, value))
+
+	if err != nil {
+		tb.Fatalf("unable to encode seed value: %s", err)
+	}
+
+	return dynamicValue.MsgPack
+}
+
+// sortedKeys returns the keys of the given map in sorted order.
+func sortedKeys[M ~map[string]V, V any](m M) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerfuzz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver/providerfuzz"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testFuzzProvider() provider.Provider {
+	return &testprovider.Provider{
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testprovider.Resource{
+						MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+							resp.TypeName = "test_resource"
+						},
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = schema.Schema{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed: true,
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"rule": schema.ListNestedAttribute{
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"port": schema.Int64Attribute{
+													Required: true,
+												},
+											},
+										},
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"setting": schema.SetNestedBlock{
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"enabled": schema.BoolAttribute{
+													Optional: true,
+												},
+												"ratio": schema.Float64Attribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							}
+						},
+					}
+				},
+			}
+		},
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	providerfuzz.AddSeeds(f, testFuzzProvider())
+
+	// Malformed msgpack data
+	f.Add([]byte{})
+	f.Add([]byte{0xc1})
+	f.Add([]byte{0x81, 0xa2, 'i', 'd', 0xc3})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		providerfuzz.Decode(t, testFuzzProvider(), data)
+	})
+}
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+)
+
+const (
+	// executablePrefix is the prefix of provider executable names, which
+	// Terraform requires for discovering installed providers.
+	executablePrefix = "terraform-provider-"
+
+	// envTfReattachProviders is the environment variable Terraform CLI uses
+	// to connect to providers running in debug mode.
+	envTfReattachProviders = "TF_REATTACH_PROVIDERS"
+
+	// debugReattachConfigTimeout is the duration to wait for each provider
+	// to start in debug mode.
+	debugReattachConfigTimeout = 2 * time.Second
+)
+
+// ServeMultipleOpts are options for serving multiple providers from a single
+// binary.
+type ServeMultipleOpts struct {
+	// Debug runs all providers in a mode acceptable for debugging and testing
+	// processes, such as delve, by managing the process lifecycle.
+	// Information needed for Terraform CLI to connect to every provider is
+	// output to stdout. os.Interrupt (Ctrl-c) can be used to stop the
+	// providers.
+	Debug bool
+
+	// ProtocolVersion is the protocol version that should be used when
+	// serving the providers. Either protocol version 5 or protocol version 6
+	// can be used. Defaults to protocol version 6.
+	ProtocolVersion int
+}
+
+// Validation checks for provider defined ServeMultipleOpts.
+//
+// Current checks which return errors:
+//
+//   - ProtocolVersion, if set, is 5 or 6
+func (opts ServeMultipleOpts) validate(_ context.Context) error {
+	switch opts.ProtocolVersion {
+	// 0 represents unset, which ServeMultiple will use default.
+	case 0, 5, 6:
+	default:
+		return fmt.Errorf("ProtocolVersion, if set, must be 5 or 6")
+	}
+
+	return nil
+}
+
+// ServeMultiple serves multiple providers from a single binary, blocking
+// until the context is canceled. The providerFuncs keys are the full
+// addresses of each provider, such as registry.terraform.io/example/thing.
+//
+// Terraform starts a separate process for each provider, using an executable
+// named terraform-provider-TYPE, optionally followed by a version suffix
+// such as _v1.2.3. Outside of debug mode, the provider whose address type
+// matches the executable name is served, so the binary must be installed
+// under the executable name of each provider, such as with copies or
+// symbolic links.
+//
+// In debug mode, all providers are served by the current process and the
+// TF_REATTACH_PROVIDERS value for all of them is output to stdout.
+//
+// Each provider is served by its own framework server, so data such as the
+// provider configuration is never shared between providers.
+func ServeMultiple(ctx context.Context, providerFuncs map[string]func() provider.Provider, opts ServeMultipleOpts) error {
+	err := opts.validate(ctx)
+
+	if err != nil {
+		return fmt.Errorf("unable to validate ServeMultipleOpts: %w", err)
+	}
+
+	if len(providerFuncs) == 0 {
+		return fmt.Errorf("at least one provider must be provided")
+	}
+
+	addresses := make([]string, 0, len(providerFuncs))
+
+	for address := range providerFuncs {
+		err := ServeOpts{Address: address}.validateAddress(ctx)
+
+		if err != nil {
+			return fmt.Errorf("unable to validate provider address: %w", err)
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	sort.Strings(addresses)
+
+	if opts.Debug {
+		return serveMultipleDebug(ctx, addresses, providerFuncs, opts.ProtocolVersion)
+	}
+
+	address, err := executableProviderAddress(os.Args[0], addresses)
+
+	if err != nil {
+		return err
+	}
+
+	return Serve(ctx, providerFuncs[address], ServeOpts{
+		Address:         address,
+		ProtocolVersion: opts.ProtocolVersion,
+	})
+}
+
+// executableProviderAddress returns the provider address, from the given
+// sorted addresses, whose provider type matches the executable name.
+func executableProviderAddress(executable string, addresses []string) (string, error) {
+	name := strings.TrimSuffix(filepath.Base(executable), ".exe")
+
+	if !strings.HasPrefix(name, executablePrefix) {
+		return "", fmt.Errorf("unable to determine provider type from executable name %q, expected %sTYPE format", name, executablePrefix)
+	}
+
+	// Provider types cannot contain underscores, which separate the
+	// optional version suffix.
+	providerType, _, _ := strings.Cut(strings.TrimPrefix(name, executablePrefix), "_")
+
+	var matches []string
+
+	for _, address := range addresses {
+		if address[strings.LastIndex(address, "/")+1:] == providerType {
+			matches = append(matches, address)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no provider with type %q found for executable name %q, available providers: %s", providerType, name, strings.Join(addresses, ", "))
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("multiple providers with type %q found for executable name %q: %s", providerType, name, strings.Join(matches, ", "))
+	}
+}
+
+// serveMultipleDebug serves all providers in debug mode, outputting the
+// reattach configuration for all of them once every provider has started.
+func serveMultipleDebug(ctx context.Context, addresses []string, providerFuncs map[string]func() provider.Provider, protocolVersion int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	signalCh := make(chan os.Signal, 1)
+
+	signal.Notify(signalCh, os.Interrupt)
+
+	defer func() {
+		signal.Stop(signalCh)
+		cancel()
+	}()
+
+	go func() {
+		select {
+		case <-signalCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// Duplicate implementation is required because the go-plugin
+	// ReattachConfig.Addr implementation is not friendly for JSON encoding
+	// and to avoid importing terraform-exec.
+	type reattachConfigAddr struct {
+		Network string
+		String  string
+	}
+
+	type reattachConfig struct {
+		Protocol        string
+		ProtocolVersion int
+		Pid             int
+		Test            bool
+		Addr            reattachConfigAddr
+	}
+
+	reattachConfigs := make(map[string]reattachConfig, len(addresses))
+	closeChs := make([]chan struct{}, 0, len(addresses))
+	errCh := make(chan error, len(addresses))
+
+	for _, address := range addresses {
+		reattachCh := make(chan *plugin.ReattachConfig)
+		closeCh := make(chan struct{})
+		closeChs = append(closeChs, closeCh)
+
+		go func(address string, providerFunc func() provider.Provider) {
+			errCh <- serveDebug(ctx, address, providerFunc, protocolVersion, reattachCh, closeCh)
+		}(address, providerFuncs[address])
+
+		var pluginReattachConfig *plugin.ReattachConfig
+
+		select {
+		case pluginReattachConfig = <-reattachCh:
+		case err := <-errCh:
+			return fmt.Errorf("unable to serve provider %s: %w", address, err)
+		case <-time.After(debugReattachConfigTimeout):
+			return fmt.Errorf("timeout waiting on reattach configuration for provider %s", address)
+		}
+
+		if pluginReattachConfig == nil {
+			return fmt.Errorf("nil reattach configuration received for provider %s", address)
+		}
+
+		reattachConfigs[address] = reattachConfig{
+			Protocol:        string(pluginReattachConfig.Protocol),
+			ProtocolVersion: pluginReattachConfig.ProtocolVersion,
+			Pid:             pluginReattachConfig.Pid,
+			Test:            pluginReattachConfig.Test,
+			Addr: reattachConfigAddr{
+				Network: pluginReattachConfig.Addr.Network(),
+				String:  pluginReattachConfig.Addr.String(),
+			},
+		}
+	}
+
+	reattachBytes, err := json.Marshal(reattachConfigs)
+
+	if err != nil {
+		return fmt.Errorf("unable to build reattach string: %w", err)
+	}
+
+	reattachStr := string(reattachBytes)
+
+	// This is intended to be executed via provider main function and human
+	// friendly, so output directly to stdout.
+	fmt.Printf("Providers started. To attach Terraform CLI, set the %s environment variable with the following:\n\n", envTfReattachProviders)
+
+	switch runtime.GOOS {
+	case "windows":
+		fmt.Printf("\tCommand Prompt:\tset \"%s=%s\"\n", envTfReattachProviders, reattachStr)
+		fmt.Printf("\tPowerShell:\t$env:%s='%s'\n", envTfReattachProviders, strings.ReplaceAll(reattachStr, `'`, `''`))
+	default:
+		fmt.Printf("\t%s='%s'\n", envTfReattachProviders, strings.ReplaceAll(reattachStr, `'`, `'"'"'`))
+	}
+
+	fmt.Println("")
+
+	// Wait for all servers to be done.
+	for _, closeCh := range closeChs {
+		<-closeCh
+	}
+
+	var errs []error
+
+	for range addresses {
+		errs = append(errs, <-errCh)
+	}
+
+	return errors.Join(errs...)
+}
+
+// serveDebug serves a single provider in debug mode, sending its reattach
+// configuration to reattachCh and closing closeCh once the server stops.
+func serveDebug(ctx context.Context, address string, providerFunc func() provider.Provider, protocolVersion int, reattachCh chan *plugin.ReattachConfig, closeCh chan struct{}) error {
+	switch protocolVersion {
+	case 5:
+		return tf5server.Serve(
+			address,
+			func() tfprotov5.ProviderServer {
+				provider := providerFunc()
+
+				return &proto5server.Server{
+					FrameworkServer: fwserver.Server{
+						Provider: provider,
+					},
+				}
+			},
+			tf5server.WithDebug(ctx, reattachCh, closeCh),
+		)
+	default:
+		return tf6server.Serve(
+			address,
+			func() tfprotov6.ProviderServer {
+				provider := providerFunc()
+
+				return &proto6server.Server{
+					FrameworkServer: fwserver.Server{
+						Provider: provider,
+					},
+				}
+			},
+			tf6server.WithDebug(ctx, reattachCh, closeCh),
+		)
+	}
+}
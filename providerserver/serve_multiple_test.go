@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestServeMultiple_validation(t *testing.T) {
+	t.Parallel()
+
+	providerFunc := func() provider.Provider {
+		return &testprovider.Provider{}
+	}
+
+	testCases := map[string]struct {
+		providerFuncs map[string]func() provider.Provider
+		opts          ServeMultipleOpts
+		expectedError error
+	}{
+		"providers-missing": {
+			providerFuncs: map[string]func() provider.Provider{},
+			expectedError: fmt.Errorf("at least one provider must be provided"),
+		},
+		"address-invalid": {
+			providerFuncs: map[string]func() provider.Provider{
+				"registry.terraform.io/hashicorp/one": providerFunc,
+				"two":                                 providerFunc,
+			},
+			expectedError: fmt.Errorf("unable to validate provider address: expected hostname/namespace/type format, got: two"),
+		},
+		"ProtocolVersion-invalid": {
+			providerFuncs: map[string]func() provider.Provider{
+				"registry.terraform.io/hashicorp/one": providerFunc,
+			},
+			opts: ServeMultipleOpts{
+				ProtocolVersion: 999,
+			},
+			expectedError: fmt.Errorf("unable to validate ServeMultipleOpts: ProtocolVersion, if set, must be 5 or 6"),
+		},
+		"executable-unmatched": {
+			// The test binary name does not match any provider executable.
+			providerFuncs: map[string]func() provider.Provider{
+				"registry.terraform.io/hashicorp/one": providerFunc,
+			},
+			expectedError: fmt.Errorf("unable to determine provider type from executable name"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ServeMultiple(context.Background(), testCase.providerFuncs, testCase.opts)
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+				t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestExecutableProviderAddress(t *testing.T) {
+	t.Parallel()
+
+	addresses := []string{
+		"example.com/example/one",
+		"registry.terraform.io/example/one-two",
+		"registry.terraform.io/example/three",
+		"registry.terraform.io/other/three",
+	}
+
+	testCases := map[string]struct {
+		executable    string
+		expected      string
+		expectedError error
+	}{
+		"type": {
+			executable: "terraform-provider-one",
+			expected:   "example.com/example/one",
+		},
+		"type-hyphen": {
+			executable: "terraform-provider-one-two",
+			expected:   "registry.terraform.io/example/one-two",
+		},
+		"type-version": {
+			executable: "terraform-provider-one-two_v1.2.3",
+			expected:   "registry.terraform.io/example/one-two",
+		},
+		"type-windows": {
+			executable: "terraform-provider-one_v1.2.3.exe",
+			expected:   "example.com/example/one",
+		},
+		"directory": {
+			executable: "/plugins/registry.terraform.io/example/one/1.2.3/linux_amd64/terraform-provider-one_v1.2.3",
+			expected:   "example.com/example/one",
+		},
+		"prefix-missing": {
+			executable:    "__debug_bin",
+			expectedError: fmt.Errorf(`unable to determine provider type from executable name "__debug_bin", expected terraform-provider-TYPE format`),
+		},
+		"type-missing": {
+			executable:    "terraform-provider-four",
+			expectedError: fmt.Errorf(`no provider with type "four" found for executable name "terraform-provider-four", available providers: example.com/example/one, registry.terraform.io/example/one-two, registry.terraform.io/example/three, registry.terraform.io/other/three`),
+		},
+		"type-ambiguous": {
+			executable:    "terraform-provider-three",
+			expectedError: fmt.Errorf(`multiple providers with type "three" found for executable name "terraform-provider-three": registry.terraform.io/example/three, registry.terraform.io/other/three`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := executableProviderAddress(testCase.executable, addresses)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError.Error() {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
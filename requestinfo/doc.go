@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package requestinfo contains accessors for metadata about the Terraform
+// request being handled, such as the RPC and resource type, which the
+// framework adds to the context passed to all provider-defined logic.
+//
+// This enables logic which only receives the context, such as logging and
+// telemetry layers within API clients, to tag remote calls without plumbing
+// additional arguments through the provider.
+package requestinfo
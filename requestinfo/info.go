@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package requestinfo
+
+import (
+	"context"
+)
+
+// Operation is the framework operation of a resource or data source request,
+// which is more specific than the RPC for some RPCs, such as create, update,
+// and delete for the ApplyResourceChange RPC.
+type Operation string
+
+const (
+	// OperationPlan is a resource plan, via the PlanResourceChange RPC.
+	OperationPlan Operation = "Plan"
+
+	// OperationCreate is a resource create, via the ApplyResourceChange RPC.
+	OperationCreate Operation = "Create"
+
+	// OperationRead is a resource or data source read, via the ReadResource
+	// or ReadDataSource RPCs.
+	OperationRead Operation = "Read"
+
+	// OperationUpdate is a resource update, via the ApplyResourceChange RPC.
+	OperationUpdate Operation = "Update"
+
+	// OperationDelete is a resource delete, via the ApplyResourceChange RPC.
+	OperationDelete Operation = "Delete"
+
+	// OperationImport is a resource import, via the ImportResourceState RPC.
+	OperationImport Operation = "Import"
+)
+
+// Info is the metadata of the Terraform request being handled.
+type Info struct {
+	// RPC is the name of the protocol RPC, such as ApplyResourceChange.
+	RPC string
+
+	// Operation is the framework operation, if the request is for one of the
+	// Operation values. Otherwise, it is empty.
+	Operation Operation
+
+	// ResourceTypeName is the resource type of the request, if the request
+	// is for a managed resource. For the MoveResourceState RPC, this is the
+	// target resource type.
+	ResourceTypeName string
+
+	// DataSourceTypeName is the data source type of the request, if the
+	// request is for a data source.
+	DataSourceTypeName string
+
+	// EphemeralResourceTypeName is the ephemeral resource type of the
+	// request, if the request is for an ephemeral resource.
+	EphemeralResourceTypeName string
+
+	// FunctionName is the function name of the request, if the request is
+	// for a function.
+	FunctionName string
+}
+
+// infoKey is the context key for Info.
+type infoKey struct{}
+
+// NewContext returns a new context containing the given Info. The framework
+// calls this before calling provider-defined logic, so providers typically
+// only call this in unit testing.
+func NewContext(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, infoKey{}, info)
+}
+
+// FromContext returns the Info from the context, if any. The boolean is false
+// if the context was not created by the framework for a Terraform request,
+// such as in unit testing.
+func FromContext(ctx context.Context) (Info, bool) {
+	info, ok := ctx.Value(infoKey{}).(Info)
+
+	return info, ok
+}
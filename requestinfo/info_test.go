@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package requestinfo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/requestinfo"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx          context.Context
+		expected     requestinfo.Info
+		expectedBool bool
+	}{
+		"missing": {
+			ctx:          context.Background(),
+			expected:     requestinfo.Info{},
+			expectedBool: false,
+		},
+		"info": {
+			ctx: requestinfo.NewContext(context.Background(), requestinfo.Info{
+				RPC:              "ApplyResourceChange",
+				Operation:        requestinfo.OperationCreate,
+				ResourceTypeName: "test_resource",
+			}),
+			expected: requestinfo.Info{
+				RPC:              "ApplyResourceChange",
+				Operation:        requestinfo.OperationCreate,
+				ResourceTypeName: "test_resource",
+			},
+			expectedBool: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := requestinfo.FromContext(testCase.ctx)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if ok != testCase.expectedBool {
+				t.Errorf("expected %t, got %t", testCase.expectedBool, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package descriptor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Describe returns the descriptor for the resource type name and schema.
+// The Operations field is not populated, since it depends on the
+// resource.Resource implementation.
+func Describe(ctx context.Context, typeName string, s schema.Schema) (Descriptor, diag.Diagnostics) {
+	attributes, diags := describeAttributes(ctx, path.Empty(), s.GetAttributes())
+	blocks, blocksDiags := describeBlocks(ctx, path.Empty(), s.GetBlocks())
+
+	diags.Append(blocksDiags...)
+
+	return Descriptor{
+		TypeName:           typeName,
+		SchemaVersion:      s.GetVersion(),
+		Description:        s.GetDescription(),
+		DeprecationMessage: s.GetDeprecationMessage(),
+		Attributes:         attributes,
+		Blocks:             blocks,
+	}, diags
+}
+
+// DescribeResource returns the descriptor for the resource, using its
+// Metadata and Schema methods. The providerTypeName is passed to the
+// Metadata method, such as examplecloud.
+func DescribeResource(ctx context.Context, providerTypeName string, r resource.Resource) (Descriptor, diag.Diagnostics) {
+	metadataResp := &resource.MetadataResponse{}
+
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, metadataResp)
+
+	schemaResp := &resource.SchemaResponse{}
+
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	diags := schemaResp.Diagnostics
+
+	if diags.HasError() {
+		return Descriptor{}, diags
+	}
+
+	result, describeDiags := Describe(ctx, metadataResp.TypeName, schemaResp.Schema)
+
+	diags.Append(describeDiags...)
+
+	result.Operations = describeOperations(r)
+
+	return result, diags
+}
+
+// DescribeProvider returns the descriptors for all resources of the
+// provider, ordered by resource type name. The provider is not configured,
+// so resource Metadata and Schema methods must not depend on provider data.
+func DescribeProvider(ctx context.Context, p provider.Provider) ([]Descriptor, diag.Diagnostics) {
+	metadataResp := &provider.MetadataResponse{}
+
+	p.Metadata(ctx, provider.MetadataRequest{}, metadataResp)
+
+	var diags diag.Diagnostics
+	var result []Descriptor
+
+	for _, resourceFunc := range p.Resources(ctx) {
+		r := resourceFunc()
+
+		if r == nil {
+			diags.AddError(
+				"Unable to Describe Resource",
+				"A nil resource was returned by the provider Resources method. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+
+			continue
+		}
+
+		descriptor, resourceDiags := DescribeResource(ctx, metadataResp.TypeName, r)
+
+		diags.Append(resourceDiags...)
+
+		if resourceDiags.HasError() {
+			continue
+		}
+
+		result = append(result, descriptor)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].TypeName < result[j].TypeName
+	})
+
+	return result, diags
+}
+
+// describeAttributes returns the descriptors of the attributes, in
+// alphabetical order.
+func describeAttributes(ctx context.Context, parentPath path.Path, attributes map[string]fwschema.Attribute) ([]Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var result []Attribute
+
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		attributePath := parentPath.AtName(name)
+
+		descriptor := Attribute{
+			Name:               name,
+			Required:           attribute.IsRequired(),
+			Optional:           attribute.IsOptional(),
+			Computed:           attribute.IsComputed(),
+			Sensitive:          attribute.IsSensitive(),
+			Description:        attribute.GetDescription(),
+			DeprecationMessage: attribute.GetDeprecationMessage(),
+		}
+
+		nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+		if ok {
+			nestedAttributes, nestedDiags := describeAttributes(ctx, attributePath, nestedAttribute.GetNestedObject().GetAttributes())
+
+			diags.Append(nestedDiags...)
+
+			descriptor.NestingMode = nestingMode(nestedAttribute.GetNestingMode())
+			descriptor.Attributes = nestedAttributes
+		} else {
+			typeJSON, err := json.Marshal(attribute.GetType().TerraformType(ctx))
+
+			if err != nil {
+				diags.AddAttributeError(
+					attributePath,
+					"Unable to Describe Resource Attribute",
+					"An unexpected error occurred encoding the attribute type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						fmt.Sprintf("Error: %s", err),
+				)
+			}
+
+			descriptor.Type = typeJSON
+		}
+
+		result = append(result, descriptor)
+	}
+
+	return result, diags
+}
+
+// describeBlocks returns the descriptors of the blocks, in alphabetical
+// order.
+func describeBlocks(ctx context.Context, parentPath path.Path, blocks map[string]fwschema.Block) ([]Block, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var result []Block
+
+	for _, name := range sortedKeys(blocks) {
+		block := blocks[name]
+		blockPath := parentPath.AtName(name)
+		nestedObject := block.GetNestedObject()
+
+		attributes, attributesDiags := describeAttributes(ctx, blockPath, nestedObject.GetAttributes())
+
+		diags.Append(attributesDiags...)
+
+		nestedBlocks, blocksDiags := describeBlocks(ctx, blockPath, nestedObject.GetBlocks())
+
+		diags.Append(blocksDiags...)
+
+		result = append(result, Block{
+			Name:               name,
+			NestingMode:        blockNestingMode(block.GetNestingMode()),
+			Attributes:         attributes,
+			Blocks:             nestedBlocks,
+			Description:        block.GetDescription(),
+			DeprecationMessage: block.GetDeprecationMessage(),
+		})
+	}
+
+	return result, diags
+}
+
+// describeOperations returns the optional operations of the resource.
+func describeOperations(r resource.Resource) Operations {
+	var result Operations
+
+	_, result.Import = r.(resource.ResourceWithImportState)
+	_, result.ModifyPlan = r.(resource.ResourceWithModifyPlan)
+	_, result.MoveState = r.(resource.ResourceWithMoveState)
+	_, result.UpgradeState = r.(resource.ResourceWithUpgradeState)
+
+	_, withConfigValidators := r.(resource.ResourceWithConfigValidators)
+	_, withValidateConfig := r.(resource.ResourceWithValidateConfig)
+
+	result.ValidateConfig = withConfigValidators || withValidateConfig
+
+	return result
+}
+
+// nestingMode returns the descriptor nesting mode of a nested attribute.
+func nestingMode(mode fwschema.NestingMode) string {
+	switch mode {
+	case fwschema.NestingModeList:
+		return NestingModeList
+	case fwschema.NestingModeMap:
+		return NestingModeMap
+	case fwschema.NestingModeSet:
+		return NestingModeSet
+	default:
+		return NestingModeSingle
+	}
+}
+
+// blockNestingMode returns the descriptor nesting mode of a block.
+func blockNestingMode(mode fwschema.BlockNestingMode) string {
+	switch mode {
+	case fwschema.BlockNestingModeList:
+		return NestingModeList
+	case fwschema.BlockNestingModeSet:
+		return NestingModeSet
+	default:
+		return NestingModeSingle
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
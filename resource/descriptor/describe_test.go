@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package descriptor_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/descriptor"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        schema.Schema
+		expected      descriptor.Descriptor
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			schema: schema.Schema{},
+			expected: descriptor.Descriptor{
+				TypeName: "test_resource",
+			},
+		},
+		"schema": {
+			schema: schema.Schema{
+				Description:        "test description",
+				DeprecationMessage: "use test_other",
+				Version:            2,
+			},
+			expected: descriptor.Descriptor{
+				TypeName:           "test_resource",
+				SchemaVersion:      2,
+				Description:        "test description",
+				DeprecationMessage: "use test_other",
+			},
+		},
+		"attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "name description",
+						Required:    true,
+					},
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:           true,
+						Sensitive:          true,
+						DeprecationMessage: "use password_wo",
+					},
+					"tags": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+			expected: descriptor.Descriptor{
+				TypeName: "test_resource",
+				Attributes: []descriptor.Attribute{
+					{
+						Name:     "id",
+						Type:     json.RawMessage(`"string"`),
+						Computed: true,
+					},
+					{
+						Name:        "name",
+						Type:        json.RawMessage(`"string"`),
+						Required:    true,
+						Description: "name description",
+					},
+					{
+						Name:               "password",
+						Type:               json.RawMessage(`"string"`),
+						Optional:           true,
+						Sensitive:          true,
+						DeprecationMessage: "use password_wo",
+					},
+					{
+						Name:     "tags",
+						Type:     json.RawMessage(`["map","string"]`),
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"nested-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"rules": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Required: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"settings": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Optional: true,
+							},
+						},
+						Required: true,
+					},
+				},
+			},
+			expected: descriptor.Descriptor{
+				TypeName: "test_resource",
+				Attributes: []descriptor.Attribute{
+					{
+						Name:        "rules",
+						NestingMode: descriptor.NestingModeList,
+						Attributes: []descriptor.Attribute{
+							{
+								Name:     "port",
+								Type:     json.RawMessage(`"number"`),
+								Required: true,
+							},
+						},
+						Optional: true,
+					},
+					{
+						Name:        "settings",
+						NestingMode: descriptor.NestingModeSingle,
+						Attributes: []descriptor.Attribute{
+							{
+								Name:     "enabled",
+								Type:     json.RawMessage(`"bool"`),
+								Optional: true,
+							},
+						},
+						Required: true,
+					},
+				},
+			},
+		},
+		"blocks": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"network": schema.SetNestedBlock{
+						Description: "network description",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"cidr": schema.StringAttribute{
+									Required: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"route": schema.SingleNestedBlock{},
+							},
+						},
+					},
+				},
+			},
+			expected: descriptor.Descriptor{
+				TypeName: "test_resource",
+				Blocks: []descriptor.Block{
+					{
+						Name:        "network",
+						NestingMode: descriptor.NestingModeSet,
+						Attributes: []descriptor.Attribute{
+							{
+								Name:     "cidr",
+								Type:     json.RawMessage(`"string"`),
+								Required: true,
+							},
+						},
+						Blocks: []descriptor.Block{
+							{
+								Name:        "route",
+								NestingMode: descriptor.NestingModeSingle,
+							},
+						},
+						Description: "network description",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := descriptor.Describe(context.Background(), "test_resource", testCase.schema)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDescribeProvider(t *testing.T) {
+	t.Parallel()
+
+	testResource := func(typeName string) *testprovider.Resource {
+		return &testprovider.Resource{
+			MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+				resp.TypeName = req.ProviderTypeName + "_" + typeName
+			},
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				}
+			},
+		}
+	}
+
+	p := &testprovider.Provider{
+		MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+			resp.TypeName = "test"
+		},
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testprovider.ResourceWithImportState{
+						Resource: testResource("zone"),
+					}
+				},
+				func() resource.Resource {
+					return testResource("instance")
+				},
+			}
+		},
+	}
+
+	expectedAttributes := []descriptor.Attribute{
+		{
+			Name:     "id",
+			Type:     json.RawMessage(`"string"`),
+			Computed: true,
+		},
+	}
+
+	expected := []descriptor.Descriptor{
+		{
+			TypeName:   "test_instance",
+			Attributes: expectedAttributes,
+		},
+		{
+			TypeName:   "test_zone",
+			Attributes: expectedAttributes,
+			Operations: descriptor.Operations{
+				Import: true,
+			},
+		},
+	}
+
+	got, diags := descriptor.DescribeProvider(context.Background(), p)
+
+	if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package descriptor
+
+import (
+	"encoding/json"
+)
+
+// Nesting modes of nested attributes and blocks.
+const (
+	NestingModeList   = "list"
+	NestingModeMap    = "map"
+	NestingModeSet    = "set"
+	NestingModeSingle = "single"
+)
+
+// Descriptor is the structured description of a managed resource.
+type Descriptor struct {
+	// TypeName is the resource type name, such as examplecloud_thing.
+	TypeName string `json:"type_name"`
+
+	// SchemaVersion is the version of the resource schema.
+	SchemaVersion int64 `json:"schema_version"`
+
+	// Description is the plain text description of the resource.
+	Description string `json:"description,omitempty"`
+
+	// DeprecationMessage is the deprecation message of the resource, if
+	// the resource is deprecated.
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+
+	// Attributes are the top level attributes of the resource, in
+	// alphabetical order.
+	Attributes []Attribute `json:"attributes,omitempty"`
+
+	// Blocks are the top level blocks of the resource, in alphabetical
+	// order.
+	Blocks []Block `json:"blocks,omitempty"`
+
+	// Operations describes the optional operations which the resource
+	// implements.
+	Operations Operations `json:"operations"`
+}
+
+// Attribute is the structured description of a schema attribute.
+type Attribute struct {
+	// Name is the attribute name.
+	Name string `json:"name"`
+
+	// Type is the JSON encoding of the Terraform type of the attribute, such
+	// as "string" or ["list","string"]. It is omitted for nested attributes,
+	// which instead have NestingMode and Attributes.
+	Type json.RawMessage `json:"type,omitempty"`
+
+	// NestingMode is the nesting mode of a nested attribute, such as
+	// NestingModeList.
+	NestingMode string `json:"nesting_mode,omitempty"`
+
+	// Attributes are the attributes of a nested attribute object, in
+	// alphabetical order.
+	Attributes []Attribute `json:"attributes,omitempty"`
+
+	// Required is true if the attribute must be configured.
+	Required bool `json:"required,omitempty"`
+
+	// Optional is true if the attribute can be configured.
+	Optional bool `json:"optional,omitempty"`
+
+	// Computed is true if the provider can set the attribute value.
+	Computed bool `json:"computed,omitempty"`
+
+	// Sensitive is true if the attribute value should be masked in output.
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// Description is the plain text description of the attribute.
+	Description string `json:"description,omitempty"`
+
+	// DeprecationMessage is the deprecation message of the attribute, if
+	// the attribute is deprecated.
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+}
+
+// Block is the structured description of a schema block.
+type Block struct {
+	// Name is the block name.
+	Name string `json:"name"`
+
+	// NestingMode is the nesting mode of the block, such as
+	// NestingModeList.
+	NestingMode string `json:"nesting_mode"`
+
+	// Attributes are the attributes of the block, in alphabetical order.
+	Attributes []Attribute `json:"attributes,omitempty"`
+
+	// Blocks are the nested blocks of the block, in alphabetical order.
+	Blocks []Block `json:"blocks,omitempty"`
+
+	// Description is the plain text description of the block.
+	Description string `json:"description,omitempty"`
+
+	// DeprecationMessage is the deprecation message of the block, if the
+	// block is deprecated.
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+}
+
+// Operations describes the optional operations which a resource implements.
+// All resources implement the create, read, update, and delete operations.
+type Operations struct {
+	// Import is true if the resource implements the
+	// resource.ResourceWithImportState interface.
+	Import bool `json:"import"`
+
+	// ModifyPlan is true if the resource implements the
+	// resource.ResourceWithModifyPlan interface.
+	ModifyPlan bool `json:"modify_plan"`
+
+	// MoveState is true if the resource implements the
+	// resource.ResourceWithMoveState interface.
+	MoveState bool `json:"move_state"`
+
+	// UpgradeState is true if the resource implements the
+	// resource.ResourceWithUpgradeState interface.
+	UpgradeState bool `json:"upgrade_state"`
+
+	// ValidateConfig is true if the resource implements the
+	// resource.ResourceWithConfigValidators or
+	// resource.ResourceWithValidateConfig interfaces.
+	ValidateConfig bool `json:"validate_config"`
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package descriptor contains helpers for exporting structured descriptions
+// of managed resources, such as for generating custom resource definitions
+// for control planes which embed a provider outside of Terraform.
+//
+// The main starting point for implementations in this package is the
+// DescribeProvider function, which returns a Descriptor for each resource of
+// a provider.Provider implementation. Use DescribeResource for a single
+// resource.Resource implementation or Describe for a resource type name and
+// schema.
+//
+// Descriptors are generated from the same Metadata and Schema methods which
+// the framework uses for Terraform, and are intended to be encoded as JSON,
+// such as when the provider binary is run with a custom command line flag.
+package descriptor
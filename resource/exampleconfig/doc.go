@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package exampleconfig contains helpers for generating example Terraform
+// configurations from a managed resource schema, such as for documentation
+// or bootstrapping acceptance tests.
+//
+// The main starting point for implementations in this package is the
+// Generate function, which returns a minimal configuration containing only
+// required attributes and a full configuration containing all configurable
+// attributes and blocks. Use GenerateResource to generate the configurations
+// from a resource.Resource implementation.
+//
+// Attribute values are placeholders based on the attribute type, such as
+// "example" for strings. Validators can provide a more meaningful value, such
+// as an allowed value, by implementing the ValidatorWithExampleValue
+// interface.
+package exampleconfig
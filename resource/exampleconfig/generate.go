@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package exampleconfig
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// ResourceName is the resource name used in generated configurations, such
+// as example in resource "examplecloud_thing" "example".
+const ResourceName = "example"
+
+// Configs are the example configurations generated from a resource schema.
+type Configs struct {
+	// Minimal is the configuration containing only required attributes,
+	// including the required attributes of required nested attributes.
+	//
+	// Blocks are not included, so resources which require a block, such as
+	// with a list validator, need the block added to be valid.
+	Minimal string
+
+	// Full is the configuration containing all required and optional
+	// attributes, including nested attributes, and one of each block.
+	Full string
+}
+
+// Generate returns the example configurations for the resource type name and
+// schema. Computed-only attributes are never included, since they cannot be
+// configured, nor are deprecated attributes and blocks unless required.
+//
+// Attributes, including the keys of object values, are written in
+// alphabetical order and blocks are written after attributes.
+func Generate(ctx context.Context, typeName string, s schema.Schema) Configs {
+	return Configs{
+		Minimal: generate(ctx, typeName, s, false),
+		Full:    generate(ctx, typeName, s, true),
+	}
+}
+
+// GenerateResource returns the example configurations for the resource,
+// using its Metadata and Schema methods. The providerTypeName is passed to
+// the Metadata method, such as examplecloud.
+func GenerateResource(ctx context.Context, providerTypeName string, r resource.Resource) (Configs, diag.Diagnostics) {
+	metadataResp := &resource.MetadataResponse{}
+
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, metadataResp)
+
+	schemaResp := &resource.SchemaResponse{}
+
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	if schemaResp.Diagnostics.HasError() {
+		return Configs{}, schemaResp.Diagnostics
+	}
+
+	return Generate(ctx, metadataResp.TypeName, schemaResp.Schema), schemaResp.Diagnostics
+}
+
+func generate(ctx context.Context, typeName string, s schema.Schema, full bool) string {
+	items := attributeItems(ctx, s.GetAttributes(), full)
+
+	if full {
+		items = append(items, blockItems(ctx, s.GetBlocks())...)
+	}
+
+	return renderBlock(fmt.Sprintf("resource %s %s", hclString(typeName), hclString(ResourceName)), items)
+}
+
+// attributeItems returns the body items for the attributes which are
+// required or, if full is enabled, optional.
+func attributeItems(ctx context.Context, attributes map[string]fwschema.Attribute, full bool) []bodyItem {
+	var items []bodyItem
+
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+
+		if !attribute.IsRequired() && (!full || !attribute.IsOptional() || attribute.GetDeprecationMessage() != "") {
+			continue
+		}
+
+		items = append(items, bodyItem{
+			name:  name,
+			value: attributeValue(ctx, attribute, full),
+		})
+	}
+
+	return items
+}
+
+// attributeValue returns the rendered example value for the attribute.
+func attributeValue(ctx context.Context, attribute fwschema.Attribute, full bool) []string {
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok {
+		return renderValue(exampleValue(ctx, attribute))
+	}
+
+	object := renderObject(attributeItems(ctx, nestedAttribute.GetNestedObject().GetAttributes(), full))
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeList, fwschema.NestingModeSet:
+		return renderList([][]string{object})
+	case fwschema.NestingModeMap:
+		return renderObject([]bodyItem{{name: "key", value: object}})
+	default:
+		return object
+	}
+}
+
+// blockItems returns the body items containing one of each block, which
+// are all configurable.
+func blockItems(ctx context.Context, blocks map[string]fwschema.Block) []bodyItem {
+	var items []bodyItem
+
+	for _, name := range sortedKeys(blocks) {
+		block := blocks[name]
+
+		if block.GetDeprecationMessage() != "" {
+			continue
+		}
+
+		nestedObject := block.GetNestedObject()
+		blockBody := attributeItems(ctx, nestedObject.GetAttributes(), true)
+		blockBody = append(blockBody, blockItems(ctx, nestedObject.GetBlocks())...)
+
+		items = append(items, bodyItem{
+			name:  name,
+			block: blockBody,
+		})
+	}
+
+	return items
+}
+
+// exampleValue returns the value of the first attribute validator
+// implementing ValidatorWithExampleValue, otherwise a placeholder value
+// based on the attribute type.
+func exampleValue(ctx context.Context, attribute fwschema.Attribute) tftypes.Value {
+	for _, v := range attributeValidators(attribute) {
+		validatorWithExampleValue, ok := v.(ValidatorWithExampleValue)
+
+		if !ok {
+			continue
+		}
+
+		value := validatorWithExampleValue.ExampleValue(ctx)
+
+		if value == nil || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil || !tfValue.IsFullyKnown() {
+			continue
+		}
+
+		return tfValue
+	}
+
+	return placeholderValue(attribute.GetType().TerraformType(ctx))
+}
+
+// attributeValidators returns the validators of the attribute, if any.
+func attributeValidators(attribute fwschema.Attribute) []any {
+	var result []any
+
+	switch a := attribute.(type) {
+	case fwxschema.AttributeWithBoolValidators:
+		for _, v := range a.BoolValidators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithDynamicValidators:
+		for _, v := range a.DynamicValidators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithFloat32Validators:
+		for _, v := range a.Float32Validators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithFloat64Validators:
+		for _, v := range a.Float64Validators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithInt32Validators:
+		for _, v := range a.Int32Validators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithInt64Validators:
+		for _, v := range a.Int64Validators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithListValidators:
+		for _, v := range a.ListValidators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithMapValidators:
+		for _, v := range a.MapValidators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithNumberValidators:
+		for _, v := range a.NumberValidators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithObjectValidators:
+		for _, v := range a.ObjectValidators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithSetValidators:
+		for _, v := range a.SetValidators() {
+			result = append(result, v)
+		}
+	case fwxschema.AttributeWithStringValidators:
+		for _, v := range a.StringValidators() {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// placeholderValue returns the placeholder value for the type. Collections
+// contain a single placeholder element.
+func placeholderValue(typ tftypes.Type) tftypes.Value {
+	switch {
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, true)
+	case typ.Is(tftypes.Number):
+		return tftypes.NewValue(typ, 1)
+	case typ.Is(tftypes.List{}):
+		return tftypes.NewValue(typ, []tftypes.Value{placeholderValue(typ.(tftypes.List).ElementType)})
+	case typ.Is(tftypes.Set{}):
+		return tftypes.NewValue(typ, []tftypes.Value{placeholderValue(typ.(tftypes.Set).ElementType)})
+	case typ.Is(tftypes.Map{}):
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"key": placeholderValue(typ.(tftypes.Map).ElementType),
+		})
+	case typ.Is(tftypes.Object{}):
+		attributeTypes := typ.(tftypes.Object).AttributeTypes
+		values := make(map[string]tftypes.Value, len(attributeTypes))
+
+		for name, attributeType := range attributeTypes {
+			values[name] = placeholderValue(attributeType)
+		}
+
+		return tftypes.NewValue(typ, values)
+	case typ.Is(tftypes.Tuple{}):
+		elementTypes := typ.(tftypes.Tuple).ElementTypes
+		values := make([]tftypes.Value, 0, len(elementTypes))
+
+		for _, elementType := range elementTypes {
+			values = append(values, placeholderValue(elementType))
+		}
+
+		return tftypes.NewValue(typ, values)
+	default:
+		// Strings and dynamic values.
+		return tftypes.NewValue(tftypes.String, "example")
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package exampleconfig_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/exampleconfig"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/enum"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testSizeEnum = enum.Must(map[int]string{
+	1: "small",
+	2: "large",
+})
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected exampleconfig.Configs
+	}{
+		"empty": {
+			schema: schema.Schema{},
+			expected: exampleconfig.Configs{
+				Minimal: "resource \"test_resource\" \"example\" {\n}\n",
+				Full:    "resource \"test_resource\" \"example\" {\n}\n",
+			},
+		},
+		"attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"computed": schema.StringAttribute{
+						Computed: true,
+					},
+					"deprecated": schema.StringAttribute{
+						DeprecationMessage: "use name",
+						Optional:           true,
+					},
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"port": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+					"ratio": schema.Float64Attribute{
+						Required: true,
+					},
+				},
+			},
+			expected: exampleconfig.Configs{
+				Minimal: `resource "test_resource" "example" {
+  name  = "example"
+  ratio = 1
+}
+`,
+				Full: `resource "test_resource" "example" {
+  enabled = true
+  name    = "example"
+  port    = 1
+  ratio   = 1
+}
+`,
+			},
+		},
+		"attributes-collections": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+					},
+					"map": schema.MapAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+					"object": schema.ObjectAttribute{
+						AttributeTypes: map[string]attr.Type{
+							"id":   types.StringType,
+							"tags": types.SetType{ElemType: types.StringType},
+						},
+						Optional: true,
+					},
+					"set": schema.SetAttribute{
+						ElementType: types.BoolType,
+						Optional:    true,
+					},
+				},
+			},
+			expected: exampleconfig.Configs{
+				Minimal: `resource "test_resource" "example" {
+  list = ["example"]
+}
+`,
+				Full: `resource "test_resource" "example" {
+  list = ["example"]
+  map = {
+    key = 1
+  }
+  object = {
+    id   = "example"
+    tags = ["example"]
+  }
+  set = [true]
+}
+`,
+			},
+		},
+		"attributes-nested": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list_nested": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Required: true,
+								},
+								"description": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Required: true,
+					},
+					"map_nested": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"single_nested": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Optional: true,
+							},
+						},
+						Required: true,
+					},
+				},
+			},
+			expected: exampleconfig.Configs{
+				Minimal: `resource "test_resource" "example" {
+  list_nested = [
+    {
+      id = "example"
+    },
+  ]
+  single_nested = {}
+}
+`,
+				Full: `resource "test_resource" "example" {
+  list_nested = [
+    {
+      description = "example"
+      id          = "example"
+    },
+  ]
+  map_nested = {
+    key = {
+      value = "example"
+    }
+  }
+  single_nested = {
+    enabled = true
+  }
+}
+`,
+			},
+		},
+		"attributes-validators": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"size": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							testSizeEnum.Validator(),
+						},
+					},
+				},
+			},
+			expected: exampleconfig.Configs{
+				Minimal: `resource "test_resource" "example" {
+  size = "large"
+}
+`,
+				Full: `resource "test_resource" "example" {
+  size = "large"
+}
+`,
+			},
+		},
+		"blocks": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"deprecated": schema.SingleNestedBlock{
+						DeprecationMessage: "use rule",
+					},
+					"rule": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"action": schema.StringAttribute{
+									Optional: true,
+								},
+								"id": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"match": schema.SingleNestedBlock{
+									Attributes: map[string]schema.Attribute{
+										"pattern": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+					"timeouts": schema.SingleNestedBlock{},
+				},
+			},
+			expected: exampleconfig.Configs{
+				Minimal: `resource "test_resource" "example" {
+  name = "example"
+}
+`,
+				Full: `resource "test_resource" "example" {
+  name = "example"
+
+  rule {
+    action = "example"
+
+    match {
+      pattern = "example"
+    }
+  }
+
+  timeouts {
+  }
+}
+`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := exampleconfig.Generate(context.Background(), "test_resource", testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource            resource.Resource
+		expected            exampleconfig.Configs
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"schema": {
+			resource: &testprovider.Resource{
+				MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+					resp.TypeName = req.ProviderTypeName + "_thing"
+				},
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					}
+				},
+			},
+			expected: exampleconfig.Configs{
+				Minimal: `resource "examplecloud_thing" "example" {
+  name = "example"
+}
+`,
+				Full: `resource "examplecloud_thing" "example" {
+  name = "example"
+}
+`,
+			},
+		},
+		"schema-diagnostics": {
+			resource: &testprovider.Resource{
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Diagnostics.AddError("test summary", "test detail")
+				},
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := exampleconfig.GenerateResource(context.Background(), "examplecloud", testCase.resource)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package exampleconfig
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// indent is the indentation of each nesting level, matching terraform fmt.
+const indent = "  "
+
+// identifierRegex matches keys which do not require quoting.
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// bodyItem is an attribute or block within a configuration body. Rendered
+// values are lines where all lines after the first are indented relative to
+// the attribute.
+type bodyItem struct {
+	name string
+
+	// value is the rendered attribute value.
+	value []string
+
+	// block is the body of a block, which is used instead of value when the
+	// item is a block.
+	block []bodyItem
+}
+
+func (i bodyItem) isBlock() bool {
+	return i.value == nil
+}
+
+// renderBlock returns the block with the given header, such as resource
+// labels, followed by a trailing newline.
+func renderBlock(header string, items []bodyItem) string {
+	lines := []string{header + " {"}
+	lines = append(lines, indentLines(renderBody(items))...)
+	lines = append(lines, "}")
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// renderBody returns the lines of the body items. The equals signs of
+// consecutive single line attributes are aligned as terraform fmt does.
+func renderBody(items []bodyItem) []string {
+	var lines []string
+
+	for start := 0; start < len(items); {
+		if items[start].isBlock() {
+			if start > 0 {
+				lines = append(lines, "")
+			}
+
+			lines = append(lines, items[start].name+" {")
+			lines = append(lines, indentLines(renderBody(items[start].block))...)
+			lines = append(lines, "}")
+			start++
+
+			continue
+		}
+
+		if start > 0 && items[start-1].isBlock() {
+			lines = append(lines, "")
+		}
+
+		end := start
+		width := 0
+
+		for end < len(items) && !items[end].isBlock() {
+			if len(items[end].value) > 1 {
+				// Multiple line values are written on their own without
+				// alignment.
+				if end == start {
+					width = len(items[end].name)
+					end++
+				}
+
+				break
+			}
+
+			width = max(width, len(items[end].name))
+			end++
+		}
+
+		for _, item := range items[start:end] {
+			lines = append(lines, item.name+strings.Repeat(" ", width-len(item.name))+" = "+item.value[0])
+			lines = append(lines, item.value[1:]...)
+		}
+
+		start = end
+	}
+
+	return lines
+}
+
+// renderValue returns the lines of the known value.
+func renderValue(value tftypes.Value) []string {
+	typ := value.Type()
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		_ = value.As(&b)
+
+		return []string{strconv.FormatBool(b)}
+	case typ.Is(tftypes.Number):
+		n := big.NewFloat(0)
+
+		_ = value.As(&n)
+
+		return []string{n.Text('f', -1)}
+	case typ.Is(tftypes.String):
+		var s string
+
+		_ = value.As(&s)
+
+		return []string{hclString(s)}
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		_ = value.As(&elements)
+
+		result := make([][]string, 0, len(elements))
+
+		for _, element := range elements {
+			result = append(result, renderValue(element))
+		}
+
+		return renderList(result)
+	default:
+		// Maps and objects.
+		var elements map[string]tftypes.Value
+
+		_ = value.As(&elements)
+
+		items := make([]bodyItem, 0, len(elements))
+
+		for _, key := range sortedKeys(elements) {
+			items = append(items, bodyItem{
+				name:  hclKey(key),
+				value: renderValue(elements[key]),
+			})
+		}
+
+		return renderObject(items)
+	}
+}
+
+// renderList returns the lines of a list containing the rendered elements,
+// which is written on a single line when all elements are single line.
+func renderList(elements [][]string) []string {
+	multiline := false
+	singleLine := make([]string, 0, len(elements))
+
+	for _, element := range elements {
+		if len(element) > 1 {
+			multiline = true
+
+			break
+		}
+
+		singleLine = append(singleLine, element[0])
+	}
+
+	if !multiline {
+		return []string{"[" + strings.Join(singleLine, ", ") + "]"}
+	}
+
+	lines := []string{"["}
+
+	for _, element := range elements {
+		last := len(element) - 1
+
+		lines = append(lines, indentLines(element[:last])...)
+		lines = append(lines, indent+element[last]+",")
+	}
+
+	return append(lines, "]")
+}
+
+// renderObject returns the lines of an object or map containing the items.
+func renderObject(items []bodyItem) []string {
+	if len(items) == 0 {
+		return []string{"{}"}
+	}
+
+	lines := []string{"{"}
+	lines = append(lines, indentLines(renderBody(items))...)
+
+	return append(lines, "}")
+}
+
+func indentLines(lines []string) []string {
+	result := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		if line == "" {
+			result = append(result, line)
+
+			continue
+		}
+
+		result = append(result, indent+line)
+	}
+
+	return result
+}
+
+// hclKey returns the object key, quoted if it is not a valid identifier.
+func hclKey(key string) string {
+	if identifierRegex.MatchString(key) {
+		return key
+	}
+
+	return hclString(key)
+}
+
+// hclString returns the quoted string, escaping template sequences.
+func hclString(s string) string {
+	s = strconv.Quote(s)
+	s = strings.ReplaceAll(s, "${", "$${")
+	s = strings.ReplaceAll(s, "%{", "%%{")
+
+	return s
+}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package exampleconfig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// ValidatorWithExampleValue is an optional interface on schema validators
+// which provides a configuration value that passes the validator, such as
+// one of the allowed values. Example configurations use the value of the
+// first validator implementing this interface instead of the type based
+// placeholder value.
+//
+// The returned value must be of the attribute type. Null and unknown values
+// are ignored.
+type ValidatorWithExampleValue interface {
+	// ExampleValue should return a value which passes the validator.
+	ExampleValue(context.Context) attr.Value
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package longrunning contains helpers for managed resource operations which
+// can take tens of minutes to complete, such as creating a database cluster.
+//
+// The remote operation identifier and progress are checkpointed into the
+// resource private state while polling, so a later Terraform operation can
+// resume polling the same remote operation after an apply is interrupted,
+// canceled, or times out, rather than starting a duplicate operation.
+//
+// The main starting point for implementations in this package is the Wait
+// function, which is typically called from resource Update logic. Use Load in
+// subsequent resource logic, such as Read or Update, to determine whether
+// there is a pending operation to resume. Terraform discards the private state
+// when Create returns an error, so checkpoints saved by Create can only be
+// resumed if Create also saves the resource state without an error.
+//
+// The WaitUntilGone function is typically called from resource Delete logic
+// for remote systems which delete asynchronously.
+package longrunning
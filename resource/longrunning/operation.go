@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package longrunning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// PrivateStateKey is the resource private state key used to checkpoint the
+// pending operation.
+const PrivateStateKey = "longrunning_operation"
+
+// Operation is a checkpoint of a pending remote operation, which is saved
+// into resource private state.
+type Operation struct {
+	// ID is the remote system identifier for the operation, which is used
+	// to poll the operation status. This field is required.
+	ID string `json:"id"`
+
+	// Status is an optional, provider-defined description of the current
+	// operation progress, such as "PROVISIONING" or "50%". It is included
+	// in timeout and cancellation diagnostics.
+	Status string `json:"status,omitempty"`
+
+	// StartedAt is when the operation was first started. If zero, Wait
+	// sets this to the current time.
+	StartedAt time.Time `json:"started_at"`
+}
+
+// PrivateState is the resource private state data which operation
+// checkpoints are read from and saved to, such as the Private field of the
+// resource.CreateResponse, resource.ReadRequest, resource.ReadResponse,
+// resource.UpdateRequest, and resource.UpdateResponse types.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// Load returns the pending operation checkpointed in private state, if any.
+// A nil Operation is returned if there is no pending operation.
+func Load(ctx context.Context, private PrivateState) (*Operation, diag.Diagnostics) {
+	if private == nil {
+		return nil, nil
+	}
+
+	value, diags := private.GetKey(ctx, PrivateStateKey)
+
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var operation Operation
+
+	if err := json.Unmarshal(value, &operation); err != nil {
+		diags.AddError(
+			"Invalid Long-Running Operation Checkpoint",
+			"An unexpected error occurred while reading the long-running operation checkpoint from private state. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+
+		return nil, diags
+	}
+
+	return &operation, diags
+}
+
+// Save checkpoints the pending operation into private state.
+func Save(ctx context.Context, private PrivateState, operation Operation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if private == nil {
+		diags.AddError(
+			"Missing Private State",
+			"An unexpected error occurred while saving the long-running operation checkpoint. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Private state must be provided to save the operation checkpoint.",
+		)
+
+		return diags
+	}
+
+	if operation.ID == "" {
+		diags.AddError(
+			"Missing Long-Running Operation ID",
+			"An unexpected error occurred while saving the long-running operation checkpoint. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"The operation ID must not be empty.",
+		)
+
+		return diags
+	}
+
+	value, err := json.Marshal(operation)
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Long-Running Operation Checkpoint",
+			"An unexpected error occurred while saving the long-running operation checkpoint to private state. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Error: %s", err),
+		)
+
+		return diags
+	}
+
+	return private.SetKey(ctx, PrivateStateKey, value)
+}
+
+// Clear removes the pending operation checkpoint from private state. This is
+// automatically called by Wait once the operation is complete.
+func Clear(ctx context.Context, private PrivateState) diag.Diagnostics {
+	if private == nil {
+		return nil
+	}
+
+	return private.SetKey(ctx, PrivateStateKey, nil)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package longrunning_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/longrunning"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		private             longrunning.PrivateState
+		expected            *longrunning.Operation
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"nil": {
+			private:  nil,
+			expected: nil,
+		},
+		"missing": {
+			private:  privatestate.EmptyProviderData(context.Background()),
+			expected: nil,
+		},
+		"operation": {
+			private: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+				"longrunning_operation": []byte(`{"id":"op-123","status":"RUNNING","started_at":"2024-01-02T03:04:05Z"}`),
+			})),
+			expected: &longrunning.Operation{
+				ID:        "op-123",
+				Status:    "RUNNING",
+				StartedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+		},
+		"invalid": {
+			private: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+				"longrunning_operation": []byte(`{"id":true}`),
+			})),
+			expected: nil,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Long-Running Operation Checkpoint",
+					"An unexpected error occurred while reading the long-running operation checkpoint from private state. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Error: json: cannot unmarshal bool into Go struct field Operation.id of type string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := longrunning.Load(context.Background(), testCase.private)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSave(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operation           longrunning.Operation
+		expected            []byte
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"operation": {
+			operation: longrunning.Operation{
+				ID:        "op-123",
+				StartedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			expected: []byte(`{"id":"op-123","started_at":"2024-01-02T03:04:05Z"}`),
+		},
+		"missing-id": {
+			operation: longrunning.Operation{},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Long-Running Operation ID",
+					"An unexpected error occurred while saving the long-running operation checkpoint. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"The operation ID must not be empty.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			private := privatestate.EmptyProviderData(context.Background())
+
+			diags := longrunning.Save(context.Background(), private, testCase.operation)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			got, _ := private.GetKey(context.Background(), longrunning.PrivateStateKey)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestClear(t *testing.T) {
+	t.Parallel()
+
+	private := privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+		"longrunning_operation": []byte(`{"id":"op-123"}`),
+		"other":                 []byte(`{}`),
+	}))
+
+	diags := longrunning.Clear(context.Background(), private)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	got, _ := private.GetKey(context.Background(), longrunning.PrivateStateKey)
+
+	if got != nil {
+		t.Errorf("expected operation to be removed, got: %s", got)
+	}
+
+	got, _ = private.GetKey(context.Background(), "other")
+
+	if diff := cmp.Diff(got, []byte(`{}`)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
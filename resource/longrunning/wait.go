@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package longrunning
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultPollInterval is the WaitRequest PollInterval used when unset.
+const DefaultPollInterval = 10 * time.Second
+
+// RefreshFunc checks the remote status of the operation, returning true once
+// the operation is complete. The function may update the Status field of the
+// operation, which is then checkpointed into private state.
+//
+// Returning error diagnostics ends waiting. The operation checkpoint is kept
+// in private state, so implementations should call Clear if the operation
+// failed and should not be resumed.
+type RefreshFunc func(ctx context.Context, operation *Operation) (bool, diag.Diagnostics)
+
+// WaitRequest represents a request to Wait for an operation to complete.
+type WaitRequest struct {
+	// Operation is the remote operation to wait for, which is either newly
+	// started or loaded from private state via the Load function.
+	Operation Operation
+
+	// PollInterval is the duration between calls to Refresh. Defaults to
+	// DefaultPollInterval.
+	PollInterval time.Duration
+
+	// Timeout is the maximum duration to wait, in addition to any context
+	// deadline. A zero value only waits until the context is done, such as
+	// when using the timeouts defined in resource configuration.
+	Timeout time.Duration
+
+	// Refresh is called to check the operation status. This field is
+	// required.
+	Refresh RefreshFunc
+}
+
+// Wait checkpoints the operation into private state and calls the Refresh
+// function until the operation is complete, at which point the checkpoint is
+// removed from private state.
+//
+// If the context is canceled, such as when a practitioner interrupts
+// Terraform, or the timeout is reached before completion, an error
+// diagnostic is returned and the checkpoint is kept in private state. The
+// remote operation is not canceled, so subsequent resource logic can Load
+// the checkpoint and call Wait again to resume polling.
+//
+// Resuming requires Terraform to keep the private state, which only happens
+// for resources which already exist, such as when waiting in Update. When
+// Create returns an error diagnostic, Terraform discards the private state,
+// so a checkpoint saved in Create is never seen by later resource logic.
+// To resume an operation started in Create, set the resource state, such as
+// the resource identifier, and return without an error diagnostic instead of
+// waiting for the operation to complete. Subsequent Read or Update logic can
+// then Load the checkpoint.
+func Wait(ctx context.Context, req WaitRequest, private PrivateState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.Refresh == nil {
+		diags.AddError(
+			"Missing Long-Running Operation Refresh Function",
+			"An unexpected error occurred while waiting for a long-running operation. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"The Refresh function must be provided to check the operation status.",
+		)
+
+		return diags
+	}
+
+	operation := req.Operation
+
+	if operation.StartedAt.IsZero() {
+		operation.StartedAt = time.Now().UTC()
+	}
+
+	diags.Append(Save(ctx, private, operation)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	pollInterval := req.PollInterval
+
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	if req.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, req.Timeout)
+
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(pollInterval)
+
+	defer ticker.Stop()
+
+	for {
+		status := operation.Status
+		done, refreshDiags := req.Refresh(ctx, &operation)
+
+		diags.Append(refreshDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if done {
+			diags.Append(Clear(ctx, private)...)
+
+			return diags
+		}
+
+		if operation.Status != status {
+			diags.Append(Save(ctx, private, operation)...)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.Append(contextDoneDiagnostic(ctx, operation))
+
+			return diags
+		case <-ticker.C:
+		}
+	}
+}
+
+func contextDoneDiagnostic(ctx context.Context, operation Operation) diag.Diagnostic {
+	detail := "The operation was not canceled and may still complete. " +
+		"The operation has been saved in the resource private state. If the resource existed before this operation, waiting will resume during the next Terraform operation.\n\n" +
+		"Operation ID: " + operation.ID
+
+	if operation.Status != "" {
+		detail += "\nLast Status: " + operation.Status
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return diag.NewErrorDiagnostic(
+			"Long-Running Operation Timeout",
+			"The remote operation did not complete before the timeout. "+detail,
+		)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Long-Running Operation Canceled",
+		"Waiting for the remote operation was canceled. "+detail,
+	)
+}
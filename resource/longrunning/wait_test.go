@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package longrunning_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/longrunning"
+)
+
+func TestWait(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		ctx                 func() (context.Context, context.CancelFunc)
+		request             longrunning.WaitRequest
+		expectedOperation   *longrunning.Operation
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"complete": {
+			request: longrunning.WaitRequest{
+				Operation:    longrunning.Operation{ID: "op-123", StartedAt: startedAt},
+				PollInterval: time.Millisecond,
+				Refresh: func(_ context.Context, operation *longrunning.Operation) (bool, diag.Diagnostics) {
+					if operation.Status == "" {
+						operation.Status = "RUNNING"
+
+						return false, nil
+					}
+
+					return true, nil
+				},
+			},
+			expectedOperation: nil,
+		},
+		"refresh-error": {
+			request: longrunning.WaitRequest{
+				Operation:    longrunning.Operation{ID: "op-123", StartedAt: startedAt},
+				PollInterval: time.Millisecond,
+				Refresh: func(_ context.Context, operation *longrunning.Operation) (bool, diag.Diagnostics) {
+					return false, diag.Diagnostics{
+						diag.NewErrorDiagnostic("error summary", "error detail"),
+					}
+				},
+			},
+			expectedOperation: &longrunning.Operation{ID: "op-123", StartedAt: startedAt},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"timeout": {
+			request: longrunning.WaitRequest{
+				Operation:    longrunning.Operation{ID: "op-123", StartedAt: startedAt},
+				PollInterval: time.Millisecond,
+				Timeout:      10 * time.Millisecond,
+				Refresh: func(_ context.Context, operation *longrunning.Operation) (bool, diag.Diagnostics) {
+					operation.Status = "RUNNING"
+
+					return false, nil
+				},
+			},
+			expectedOperation: &longrunning.Operation{ID: "op-123", Status: "RUNNING", StartedAt: startedAt},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Long-Running Operation Timeout",
+					"The remote operation did not complete before the timeout. "+
+						"The operation was not canceled and may still complete. "+
+						"The operation has been saved in the resource private state. If the resource existed before this operation, waiting will resume during the next Terraform operation.\n\n"+
+						"Operation ID: op-123\n"+
+						"Last Status: RUNNING",
+				),
+			},
+		},
+		"canceled": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+
+				cancel()
+
+				return ctx, cancel
+			},
+			request: longrunning.WaitRequest{
+				Operation:    longrunning.Operation{ID: "op-123", StartedAt: startedAt},
+				PollInterval: time.Hour,
+				Refresh: func(_ context.Context, _ *longrunning.Operation) (bool, diag.Diagnostics) {
+					return false, nil
+				},
+			},
+			expectedOperation: &longrunning.Operation{ID: "op-123", StartedAt: startedAt},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Long-Running Operation Canceled",
+					"Waiting for the remote operation was canceled. "+
+						"The operation was not canceled and may still complete. "+
+						"The operation has been saved in the resource private state. If the resource existed before this operation, waiting will resume during the next Terraform operation.\n\n"+
+						"Operation ID: op-123",
+				),
+			},
+		},
+		"missing-refresh": {
+			request: longrunning.WaitRequest{
+				Operation: longrunning.Operation{ID: "op-123", StartedAt: startedAt},
+			},
+			expectedOperation: nil,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Long-Running Operation Refresh Function",
+					"An unexpected error occurred while waiting for a long-running operation. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"The Refresh function must be provided to check the operation status.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if testCase.ctx != nil {
+				var cancel context.CancelFunc
+
+				ctx, cancel = testCase.ctx()
+
+				defer cancel()
+			}
+
+			private := privatestate.EmptyProviderData(context.Background())
+
+			diags := longrunning.Wait(ctx, testCase.request, private)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			got, _ := longrunning.Load(context.Background(), private)
+
+			if diff := cmp.Diff(got, testCase.expectedOperation); diff != "" {
+				t.Errorf("unexpected operation difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWait_Resume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	private := privatestate.MustProviderData(ctx, privatestate.MustMarshalToJson(map[string][]byte{
+		"longrunning_operation": []byte(`{"id":"op-123","status":"RUNNING","started_at":"2024-01-02T03:04:05Z"}`),
+	}))
+
+	operation, diags := longrunning.Load(ctx, private)
+
+	if diags.HasError() || operation == nil {
+		t.Fatalf("expected operation, got: %v, %v", operation, diags)
+	}
+
+	var refreshedID string
+
+	diags = longrunning.Wait(ctx, longrunning.WaitRequest{
+		Operation: *operation,
+		Refresh: func(_ context.Context, operation *longrunning.Operation) (bool, diag.Diagnostics) {
+			refreshedID = operation.ID
+
+			return true, nil
+		},
+	}, private)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if refreshedID != "op-123" {
+		t.Errorf("expected refresh of op-123, got: %s", refreshedID)
+	}
+
+	operation, _ = longrunning.Load(ctx, private)
+
+	if operation != nil {
+		t.Errorf("expected operation to be cleared, got: %v", operation)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package longrunning
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	// DefaultMinPollInterval is the WaitUntilGoneRequest MinPollInterval used
+	// when unset.
+	DefaultMinPollInterval = time.Second
+
+	// DefaultMaxPollInterval is the WaitUntilGoneRequest MaxPollInterval used
+	// when unset.
+	DefaultMaxPollInterval = 30 * time.Second
+)
+
+// ExistsFunc checks whether the remote resource still exists, returning false
+// once it is gone. Returning error diagnostics ends waiting.
+type ExistsFunc func(ctx context.Context) (bool, diag.Diagnostics)
+
+// WaitUntilGoneRequest represents a request to WaitUntilGone.
+type WaitUntilGoneRequest struct {
+	// Exists is called to check whether the remote resource still exists.
+	// This field is required.
+	Exists ExistsFunc
+
+	// MinPollInterval is the duration between the first calls to Exists,
+	// which is doubled after each call up to MaxPollInterval. Defaults to
+	// DefaultMinPollInterval.
+	MinPollInterval time.Duration
+
+	// MaxPollInterval is the maximum duration between calls to Exists.
+	// Defaults to DefaultMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// Timeout is the maximum duration to wait, in addition to any context
+	// deadline. A zero value only waits until the context is done, such as
+	// when using the timeouts defined in resource configuration.
+	Timeout time.Duration
+}
+
+// WaitUntilGone calls the Exists function, with exponential backoff between
+// calls, until the remote resource no longer exists. This is typically called
+// in resource Delete logic after calling the remote deletion API, for remote
+// systems which delete asynchronously.
+//
+// If the context is canceled, such as when a practitioner interrupts
+// Terraform, or the timeout is reached while the remote resource still
+// exists, an error diagnostic is returned. Since the framework keeps the
+// resource in state when Delete returns an error diagnostic, the deletion
+// will be retried during the next Terraform operation.
+func WaitUntilGone(ctx context.Context, req WaitUntilGoneRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.Exists == nil {
+		diags.AddError(
+			"Missing Resource Exists Function",
+			"An unexpected error occurred while waiting for resource deletion. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"The Exists function must be provided to check whether the resource still exists.",
+		)
+
+		return diags
+	}
+
+	pollInterval := req.MinPollInterval
+
+	if pollInterval <= 0 {
+		pollInterval = DefaultMinPollInterval
+	}
+
+	maxPollInterval := req.MaxPollInterval
+
+	if maxPollInterval <= 0 {
+		maxPollInterval = DefaultMaxPollInterval
+	}
+
+	if req.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, req.Timeout)
+
+		defer cancel()
+	}
+
+	for {
+		exists, existsDiags := req.Exists(ctx)
+
+		diags.Append(existsDiags...)
+
+		if diags.HasError() || !exists {
+			return diags
+		}
+
+		timer := time.NewTimer(pollInterval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				diags.AddError(
+					"Resource Deletion Timeout",
+					"The remote resource still exists after the timeout. "+
+						"The resource deletion may still complete and will be retried during the next Terraform operation. "+
+						"If the deletion consistently takes longer, consider increasing the timeout in the resource configuration.",
+				)
+
+				return diags
+			}
+
+			diags.AddError(
+				"Resource Deletion Canceled",
+				"Waiting for the remote resource deletion was canceled. "+
+					"The resource deletion may still complete and will be retried during the next Terraform operation.",
+			)
+
+			return diags
+		case <-timer.C:
+		}
+
+		pollInterval *= 2
+
+		if pollInterval > maxPollInterval {
+			pollInterval = maxPollInterval
+		}
+	}
+}
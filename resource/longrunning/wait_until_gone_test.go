@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package longrunning_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/longrunning"
+)
+
+func TestWaitUntilGone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx                 func() (context.Context, context.CancelFunc)
+		request             func(calls *int) longrunning.WaitUntilGoneRequest
+		expectedCalls       int
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"gone": {
+			request: func(calls *int) longrunning.WaitUntilGoneRequest {
+				return longrunning.WaitUntilGoneRequest{
+					Exists: func(_ context.Context) (bool, diag.Diagnostics) {
+						*calls++
+
+						return false, nil
+					},
+				}
+			},
+			expectedCalls: 1,
+		},
+		"gone-after-polling": {
+			request: func(calls *int) longrunning.WaitUntilGoneRequest {
+				return longrunning.WaitUntilGoneRequest{
+					MinPollInterval: time.Millisecond,
+					MaxPollInterval: 2 * time.Millisecond,
+					Exists: func(_ context.Context) (bool, diag.Diagnostics) {
+						*calls++
+
+						return *calls < 4, nil
+					},
+				}
+			},
+			expectedCalls: 4,
+		},
+		"exists-error": {
+			request: func(calls *int) longrunning.WaitUntilGoneRequest {
+				return longrunning.WaitUntilGoneRequest{
+					MinPollInterval: time.Millisecond,
+					Exists: func(_ context.Context) (bool, diag.Diagnostics) {
+						*calls++
+
+						return true, diag.Diagnostics{
+							diag.NewErrorDiagnostic("error summary", "error detail"),
+						}
+					},
+				}
+			},
+			expectedCalls: 1,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"timeout": {
+			request: func(calls *int) longrunning.WaitUntilGoneRequest {
+				return longrunning.WaitUntilGoneRequest{
+					MinPollInterval: time.Hour,
+					Timeout:         time.Millisecond,
+					Exists: func(_ context.Context) (bool, diag.Diagnostics) {
+						*calls++
+
+						return true, nil
+					},
+				}
+			},
+			expectedCalls: 1,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Deletion Timeout",
+					"The remote resource still exists after the timeout. "+
+						"The resource deletion may still complete and will be retried during the next Terraform operation. "+
+						"If the deletion consistently takes longer, consider increasing the timeout in the resource configuration.",
+				),
+			},
+		},
+		"canceled": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+
+				cancel()
+
+				return ctx, cancel
+			},
+			request: func(calls *int) longrunning.WaitUntilGoneRequest {
+				return longrunning.WaitUntilGoneRequest{
+					MinPollInterval: time.Hour,
+					Exists: func(_ context.Context) (bool, diag.Diagnostics) {
+						*calls++
+
+						return true, nil
+					},
+				}
+			},
+			expectedCalls: 1,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Deletion Canceled",
+					"Waiting for the remote resource deletion was canceled. "+
+						"The resource deletion may still complete and will be retried during the next Terraform operation.",
+				),
+			},
+		},
+		"missing-exists": {
+			request: func(_ *int) longrunning.WaitUntilGoneRequest {
+				return longrunning.WaitUntilGoneRequest{}
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Resource Exists Function",
+					"An unexpected error occurred while waiting for resource deletion. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"The Exists function must be provided to check whether the resource still exists.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if testCase.ctx != nil {
+				var cancel context.CancelFunc
+
+				ctx, cancel = testCase.ctx()
+
+				defer cancel()
+			}
+
+			var calls int
+
+			diags := longrunning.WaitUntilGone(ctx, testCase.request(&calls))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d Exists calls, got %d", testCase.expectedCalls, calls)
+			}
+		})
+	}
+}
@@ -3,6 +3,10 @@
 
 package resource
 
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/retry"
+)
+
 // MetadataRequest represents a request for the Resource to return metadata,
 // such as its type name. An instance of this request struct is supplied as
 // an argument to the Resource type Metadata method.
@@ -25,6 +29,45 @@ type MetadataResponse struct {
 	// ResourceBehavior is used to control framework-specific logic when
 	// interacting with this resource.
 	ResourceBehavior ResourceBehavior
+
+	// DeprecatedTypeNames are additional, deprecated resource types for this
+	// resource, such as prior type names after renaming the resource. The
+	// framework serves the resource with the same schema and logic under
+	// each deprecated type name, while practitioners receive a deprecation
+	// warning to use TypeName instead, unless the schema already defines a
+	// DeprecationMessage.
+	//
+	// The framework automatically supports moving resource state from a
+	// deprecated type name to TypeName, when the schema versions match. This
+	// requires Terraform 1.8 and later.
+	DeprecatedTypeNames []string
+
+	// Deprecation, if set, marks the resource type as deprecated. The
+	// framework composes a deprecation message from its fields, which is
+	// returned in the schema and shown to practitioners as a warning when
+	// the resource is used in configuration, unless the schema already
+	// defines a DeprecationMessage.
+	Deprecation Deprecation
+}
+
+// Deprecation describes the deprecation of a resource type.
+type Deprecation struct {
+	// Message is additional practitioner-facing information about the
+	// deprecation, such as migration steps.
+	Message string
+
+	// ReplacementTypeName is the resource type that practitioners should
+	// use instead, if any. For example, examplecloud_new_thing.
+	ReplacementTypeName string
+
+	// RemovalVersion is the provider version in which the resource type
+	// will be removed, if known. For example, 2.0.0.
+	RemovalVersion string
+}
+
+// IsDeprecated returns true if any Deprecation field is set.
+func (d Deprecation) IsDeprecated() bool {
+	return d != Deprecation{}
 }
 
 // ResourceBehavior controls framework-specific logic when interacting
@@ -36,6 +79,72 @@ type ResourceBehavior struct {
 	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
 	// to change or break without warning. It is not protected by version compatibility guarantees.
 	ProviderDeferred ProviderDeferredBehavior
+
+	// ModifyPlanBeforeSchema, when enabled, executes the resource-level
+	// ModifyPlan method before all schema-based plan modifiers, rather than
+	// after them. This enables schema-based plan modifiers to depend on
+	// values planned by the resource-level logic.
+	//
+	// Regardless of this setting, attribute and block default values are
+	// applied and computed attributes without configuration values are
+	// marked as unknown before any plan modification.
+	ModifyPlanBeforeSchema bool
+
+	// ExtraneousStateAttributes controls how the framework handles
+	// attributes in the prior resource state which are not defined in the
+	// schema, when the prior state schema version matches the current
+	// schema version. This can happen when a practitioner downgrades the
+	// provider to a version before the attributes were added, without a
+	// schema version change. By default, the attributes are silently
+	// removed from the state.
+	ExtraneousStateAttributes ExtraneousStateAttributesBehavior
+
+	// TransientErrorRetry controls how the framework calls the resource
+	// Create, Read, Update, and Delete methods again, with exponential
+	// backoff, when they return diagnostics where every error is a
+	// retry.TransientErrorDiagnostic. Each attempt receives the same request
+	// and a response reset to its initial values, including private state
+	// data, so values set by a failed attempt are discarded.
+	//
+	// Retries are disabled by default. The zero value, or a MaxAttempts of 1
+	// or less, calls each method once. Methods of resources which enable
+	// retries here should not also use retry.Do for the same logic, since
+	// the number of calls would multiply.
+	TransientErrorRetry retry.Config
+}
+
+// ExtraneousStateAttributesBehavior controls how the framework handles prior
+// resource state attributes which are not defined in the schema.
+type ExtraneousStateAttributesBehavior int32
+
+const (
+	// ExtraneousStateAttributesIgnore silently removes the attributes from
+	// the state. This is the default behavior.
+	ExtraneousStateAttributesIgnore ExtraneousStateAttributesBehavior = 0
+
+	// ExtraneousStateAttributesWarn removes the attributes from the state and
+	// returns a warning diagnostic listing the removed attributes, so
+	// practitioners are aware the data was dropped.
+	ExtraneousStateAttributesWarn ExtraneousStateAttributesBehavior = 1
+
+	// ExtraneousStateAttributesError returns an error diagnostic listing the
+	// attributes, which prevents Terraform from using the state until the
+	// provider is upgraded to a version which defines the attributes.
+	ExtraneousStateAttributesError ExtraneousStateAttributesBehavior = 2
+)
+
+// String returns the name of the behavior, such as "Warn", or "Unknown" for
+// values which are not one of the defined behaviors.
+func (b ExtraneousStateAttributesBehavior) String() string {
+	switch b {
+	case ExtraneousStateAttributesIgnore:
+		return "Ignore"
+	case ExtraneousStateAttributesWarn:
+		return "Warn"
+	case ExtraneousStateAttributesError:
+		return "Error"
+	}
+	return "Unknown"
 }
 
 // ProviderDeferredBehavior enables provider-defined logic to be executed
@@ -55,6 +55,11 @@ type ModifyPlanRequest struct {
 	// ClientCapabilities defines optionally supported protocol features for the
 	// PlanResourceChange RPC, such as forward-compatible Terraform behavior changes.
 	ClientCapabilities ModifyPlanClientCapabilities
+
+	// PlanStore is shared across the ModifyPlan method of all resources in
+	// the provider, which enables deduplicating expensive lookups across
+	// resource instances planned in the same Terraform operation.
+	PlanStore *PlanStore
 }
 
 // ModifyPlanResponse represents a response to a
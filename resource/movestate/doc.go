@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package movestate contains helpers for moving resource state from other
+// resource types, typically of other providers, using attribute mapping
+// tables instead of manually decoding the source state.
+//
+// The main starting point for implementations in this package is the Mapper
+// type, whose StateMover method returns a resource.StateMover for the
+// resource.ResourceWithMoveState interface MoveState method.
+package movestate
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package movestate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// AttributeMapping maps a value of the source resource state onto an
+// attribute of the target resource state.
+type AttributeMapping struct {
+	// Source is the path of the value in the source resource state, such as
+	// tftypes.NewAttributePath().WithAttributeName("name"). If the source
+	// state has no value at the path, the target attribute remains null.
+	Source *tftypes.AttributePath
+
+	// Target is the path of the attribute in the target resource state.
+	Target path.Path
+
+	// Transform is an optional function for converting the source value
+	// before it is set in the target state, such as renaming enumeration
+	// values. The returned value must be of the Terraform type of the target
+	// attribute. If not set, the source value must already be of that type.
+	Transform func(ctx context.Context, value tftypes.Value) (tftypes.Value, diag.Diagnostics)
+}
+
+// Mapper declares how to move the state of a single source resource type and
+// schema version into the target resource state. Target attributes which are
+// not mapped remain null, which is typically fixed by the next refresh.
+type Mapper struct {
+	// SourceProviderAddress is the address of the source provider without
+	// the hostname, such as examplecorp/examplecloud. If empty, source
+	// resources of any provider are matched.
+	SourceProviderAddress string
+
+	// SourceTypeName is the type name of the source resource, such as
+	// examplecloud_thing. This field is required.
+	SourceTypeName string
+
+	// SourceSchemaVersion is the schema version of the source resource.
+	// Source resources with a differing schema version are not matched.
+	SourceSchemaVersion int64
+
+	// SourceType is the Terraform type of the source resource schema, which
+	// only needs to contain the attributes used by Mappings. Other attributes
+	// in the source state are ignored.
+	SourceType tftypes.Object
+
+	// Mappings are the attribute mappings from the source resource state to
+	// the target resource state.
+	Mappings []AttributeMapping
+}
+
+// StateMover returns a resource.StateMover which skips source resources not
+// matching the Mapper, otherwise sets the target state from the Mappings.
+func (m Mapper) StateMover() resource.StateMover {
+	return resource.StateMover{
+		StateMover: m.moveState,
+	}
+}
+
+// Matches returns true if the request source resource matches the Mapper.
+func (m Mapper) Matches(req resource.MoveStateRequest) bool {
+	if req.SourceTypeName != m.SourceTypeName || req.SourceSchemaVersion != m.SourceSchemaVersion {
+		return false
+	}
+
+	if m.SourceProviderAddress == "" {
+		return true
+	}
+
+	return req.SourceProviderAddress == m.SourceProviderAddress || strings.HasSuffix(req.SourceProviderAddress, "/"+m.SourceProviderAddress)
+}
+
+// moveState implements the resource.StateMover StateMover function.
+func (m Mapper) moveState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !m.Matches(req) {
+		return
+	}
+
+	if req.SourceRawState == nil {
+		resp.Diagnostics.AddError(
+			"Missing Source Resource State",
+			"The source resource state was not provided, so the state cannot be moved. "+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	sourceState, err := req.SourceRawState.UnmarshalWithOpts(m.SourceType, tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Source Resource State",
+			fmt.Sprintf("The source %s resource state could not be read using the source type declared by the provider. ", req.SourceTypeName)+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Set a known target state first, so the move is not considered skipped
+	// by the framework when all mapped values are null.
+	targetType, ok := resp.TargetState.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Target Resource Schema",
+			fmt.Sprintf("The target resource schema has a Terraform type of %s, expected an object. ", resp.TargetState.Schema.Type().TerraformType(ctx))+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	targetAttributes := make(map[string]tftypes.Value, len(targetType.AttributeTypes))
+
+	for name, attributeType := range targetType.AttributeTypes {
+		targetAttributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	resp.TargetState.Raw = tftypes.NewValue(targetType, targetAttributes)
+
+	for _, mapping := range m.Mappings {
+		resp.Diagnostics.Append(m.moveAttribute(ctx, sourceState, mapping, resp)...)
+	}
+}
+
+// moveAttribute sets the target attribute of the mapping from the source
+// state.
+func (m Mapper) moveAttribute(ctx context.Context, sourceState tftypes.Value, mapping AttributeMapping, resp *resource.MoveStateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceValueIface, _, err := tftypes.WalkAttributePath(sourceState, mapping.Source)
+
+	if errors.Is(err, tftypes.ErrInvalidStep) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddAttributeError(
+			mapping.Target,
+			"Unable to Read Source Resource Value",
+			fmt.Sprintf("The source value at %s could not be read. ", mapping.Source)+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	sourceValue, ok := sourceValueIface.(tftypes.Value)
+
+	if !ok {
+		diags.AddAttributeError(
+			mapping.Target,
+			"Unable to Read Source Resource Value",
+			fmt.Sprintf("The source path %s does not refer to a value. ", mapping.Source)+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+
+	if mapping.Transform != nil {
+		var transformDiags diag.Diagnostics
+
+		sourceValue, transformDiags = mapping.Transform(ctx, sourceValue)
+
+		diags.Append(transformDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	targetType, typeDiags := resp.TargetState.Schema.TypeAtPath(ctx, mapping.Target)
+
+	diags.Append(typeDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if !sourceValue.Type().UsableAs(targetType.TerraformType(ctx)) {
+		diags.AddAttributeError(
+			mapping.Target,
+			"Invalid Source Resource Value Type",
+			fmt.Sprintf("The source value at %s cannot be used for the target attribute. ", mapping.Source)+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Source Value Type: %s\nTarget Attribute Type: %s", sourceValue.Type(), targetType.TerraformType(ctx)),
+		)
+
+		return diags
+	}
+
+	targetValue, err := targetType.ValueFromTerraform(ctx, sourceValue)
+
+	if err != nil {
+		diags.AddAttributeError(
+			mapping.Target,
+			"Invalid Source Resource Value",
+			fmt.Sprintf("The source value at %s could not be converted for the target attribute. ", mapping.Source)+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	diags.Append(resp.TargetState.SetAttribute(ctx, mapping.Target, targetValue)...)
+
+	return diags
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package movestate_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/movestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestMapperStateMover(t *testing.T) {
+	t.Parallel()
+
+	testTargetSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"size": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testTargetType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+			"size": tftypes.String,
+		},
+	}
+
+	testMapper := movestate.Mapper{
+		SourceProviderAddress: "examplecorp/examplecloud",
+		SourceTypeName:        "examplecloud_thing",
+		SourceSchemaVersion:   1,
+		SourceType: tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"id":         tftypes.String,
+				"thing_name": tftypes.String,
+				"thing_size": tftypes.String,
+			},
+		},
+		Mappings: []movestate.AttributeMapping{
+			{
+				Source: tftypes.NewAttributePath().WithAttributeName("id"),
+				Target: path.Root("id"),
+			},
+			{
+				Source: tftypes.NewAttributePath().WithAttributeName("thing_name"),
+				Target: path.Root("name"),
+			},
+			{
+				Source: tftypes.NewAttributePath().WithAttributeName("thing_size"),
+				Target: path.Root("size"),
+				Transform: func(_ context.Context, value tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+					var size string
+
+					if value.IsNull() {
+						return value, nil
+					}
+
+					_ = value.As(&size)
+
+					return tftypes.NewValue(tftypes.String, strings.ToLower(size)), nil
+				},
+			},
+		},
+	}
+
+	testRequest := func(json string) resource.MoveStateRequest {
+		return resource.MoveStateRequest{
+			SourceProviderAddress: "registry.terraform.io/examplecorp/examplecloud",
+			SourceRawState: &tfprotov6.RawState{
+				JSON: []byte(json),
+			},
+			SourceSchemaVersion: 1,
+			SourceTypeName:      "examplecloud_thing",
+		}
+	}
+
+	testCases := map[string]struct {
+		mapper           movestate.Mapper
+		request          resource.MoveStateRequest
+		expectedDiags    diag.Diagnostics
+		expectedRawState tftypes.Value
+	}{
+		"mapped": {
+			mapper:  testMapper,
+			request: testRequest(`{"id": "test-id", "thing_name": "test-name", "thing_size": "LARGE", "extra": true}`),
+			expectedRawState: tftypes.NewValue(testTargetType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, "test-name"),
+				"size": tftypes.NewValue(tftypes.String, "large"),
+			}),
+		},
+		"mapped-missing-source-value": {
+			mapper:  testMapper,
+			request: testRequest(`{"id": "test-id", "thing_name": "test-name"}`),
+			expectedRawState: tftypes.NewValue(testTargetType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, "test-name"),
+				"size": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"mapped-any-provider": {
+			mapper: movestate.Mapper{
+				SourceTypeName:      "examplecloud_thing",
+				SourceSchemaVersion: 1,
+				SourceType:          testMapper.SourceType,
+				Mappings:            testMapper.Mappings[:1],
+			},
+			request: testRequest(`{"id": "test-id"}`),
+			expectedRawState: tftypes.NewValue(testTargetType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, nil),
+				"size": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"skipped-provider-address": {
+			mapper: testMapper,
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/othercorp/examplecloud",
+				SourceSchemaVersion:   1,
+				SourceTypeName:        "examplecloud_thing",
+			},
+			expectedRawState: tftypes.NewValue(testTargetType, nil),
+		},
+		"skipped-schema-version": {
+			mapper: testMapper,
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/examplecorp/examplecloud",
+				SourceSchemaVersion:   0,
+				SourceTypeName:        "examplecloud_thing",
+			},
+			expectedRawState: tftypes.NewValue(testTargetType, nil),
+		},
+		"skipped-type-name": {
+			mapper: testMapper,
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/examplecorp/examplecloud",
+				SourceSchemaVersion:   1,
+				SourceTypeName:        "examplecloud_other",
+			},
+			expectedRawState: tftypes.NewValue(testTargetType, nil),
+		},
+		"invalid-source-value-type": {
+			mapper: movestate.Mapper{
+				SourceTypeName:      "examplecloud_thing",
+				SourceSchemaVersion: 1,
+				SourceType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id": tftypes.Number,
+					},
+				},
+				Mappings: testMapper.Mappings[:1],
+			},
+			request: testRequest(`{"id": 123}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Invalid Source Resource Value Type",
+					"The source value at AttributeName(\"id\") cannot be used for the target attribute. "+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Source Value Type: tftypes.Number\n"+
+						"Target Attribute Type: tftypes.String",
+				),
+			},
+			expectedRawState: tftypes.NewValue(testTargetType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, nil),
+				"size": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"invalid-source-state": {
+			mapper:  testMapper,
+			request: testRequest(`{"id": [1]}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Read Source Resource State",
+					"The source examplecloud_thing resource state could not be read using the source type declared by the provider. "+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Error: AttributeName(\"id\"): unsupported type json.Delim sent as tftypes.String",
+				),
+			},
+			expectedRawState: tftypes.NewValue(testTargetType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Raw:    tftypes.NewValue(testTargetType, nil),
+					Schema: testTargetSchema,
+				},
+			}
+
+			testCase.mapper.StateMover().StateMover(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.TargetState.Raw, testCase.expectedRawState); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// PlanDependency declares that the plan modifiers of a top level attribute or
+// block are run after the plan modifiers of other top level attributes or
+// blocks. The [Resource] declares these by implementing the
+// [ResourceWithPlanDependencies] interface.
+//
+// For example, when the plan modifiers of an ip_address attribute read the
+// planned value of a subnet_id attribute which has its own plan modifiers:
+//
+//	resource.PlanDependency{
+//		Path:      path.Root("ip_address"),
+//		DependsOn: path.Paths{path.Root("subnet_id")},
+//	}
+type PlanDependency struct {
+	// Path is the top level attribute or block whose plan modifiers depend on
+	// the planned values of the DependsOn attributes and blocks.
+	Path path.Path
+
+	// DependsOn are the top level attributes and blocks whose plan modifiers
+	// are run before the plan modifiers of Path.
+	DependsOn path.Paths
+}
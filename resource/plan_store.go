@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// PlanStore is a concurrency-safe, keyed store of values which is shared
+// across the ModifyPlan method of all resources in the provider. It enables
+// deduplicating expensive lookups, such as quota checks, across many
+// resource instances which are planned in the same Terraform operation, even
+// across different resource types.
+//
+// A provider server can handle many Terraform operations, such as when
+// debugging or when servers are muxed, so the framework replaces the store
+// when the provider is configured, which Terraform does at the start of each
+// operation. Keys are shared across all resource types, so prefer keys which
+// include the resource type name or another unique prefix unless the value
+// is intended to be shared.
+//
+// The zero value is an empty store ready to use. Methods called on a nil
+// PlanStore do not store any values, so resource logic can be unit tested
+// without setting ModifyPlanRequest.PlanStore.
+type PlanStore struct {
+	entries map[string]*planStoreEntry
+	mutex   sync.Mutex
+}
+
+// planStoreEntry is a value in the PlanStore, which may still be computed.
+type planStoreEntry struct {
+	// done is closed once the value and diagnostics are set.
+	done chan struct{}
+
+	diagnostics diag.Diagnostics
+	value       any
+}
+
+// Get returns the value for the key and true, if the key has a value.
+// Values which are still being computed by GetOrCompute are not returned.
+func (s *PlanStore) Get(key string) (any, bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	s.mutex.Lock()
+	entry, ok := s.entries[key]
+	s.mutex.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	select {
+	case <-entry.done:
+		return entry.value, true
+	default:
+		return nil, false
+	}
+}
+
+// Set stores the value for the key, replacing any existing value.
+func (s *PlanStore) Set(key string, value any) {
+	if s == nil {
+		return
+	}
+
+	entry := &planStoreEntry{
+		done:  make(chan struct{}),
+		value: value,
+	}
+
+	close(entry.done)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]*planStoreEntry)
+	}
+
+	s.entries[key] = entry
+}
+
+// GetOrCompute returns the value for the key. If the key has no value, the
+// compute function is called to determine it. Concurrent calls for the same
+// key wait for a single compute function call and receive the same value
+// and diagnostics.
+//
+// If the compute function returns error diagnostics, the value is not stored,
+// so later calls for the key will call their compute function again. If the
+// context is canceled while waiting on another call, an error diagnostic is
+// returned.
+func (s *PlanStore) GetOrCompute(ctx context.Context, key string, compute func(context.Context) (any, diag.Diagnostics)) (any, diag.Diagnostics) {
+	if s == nil {
+		return compute(ctx)
+	}
+
+	s.mutex.Lock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]*planStoreEntry)
+	}
+
+	entry, ok := s.entries[key]
+
+	if ok {
+		s.mutex.Unlock()
+
+		select {
+		case <-entry.done:
+			return entry.value, entry.diagnostics
+		case <-ctx.Done():
+			var diags diag.Diagnostics
+
+			diags.AddError(
+				"Plan Store Error",
+				"The context was canceled while waiting on the value for key "+key+". "+
+					"Error: "+ctx.Err().Error(),
+			)
+
+			return nil, diags
+		}
+	}
+
+	entry = &planStoreEntry{
+		done: make(chan struct{}),
+	}
+
+	s.entries[key] = entry
+	s.mutex.Unlock()
+
+	// Always mark the entry as done, even if the compute function panics, to
+	// prevent other calls from waiting indefinitely.
+	defer func() {
+		if entry.diagnostics.HasError() {
+			s.mutex.Lock()
+
+			if s.entries[key] == entry {
+				delete(s.entries, key)
+			}
+
+			s.mutex.Unlock()
+		}
+
+		close(entry.done)
+	}()
+
+	// Default to an error, which is replaced by the compute function results,
+	// so waiting calls receive an error if the compute function panics.
+	entry.diagnostics.AddError(
+		"Plan Store Error",
+		"The value for key "+key+" was not computed. This is always an issue in the provider and should be reported to the provider developers.",
+	)
+
+	value, diags := compute(ctx)
+
+	entry.value = value
+	entry.diagnostics = diags
+
+	return value, diags
+}
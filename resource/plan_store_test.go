@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestPlanStoreGetSet(t *testing.T) {
+	t.Parallel()
+
+	store := &resource.PlanStore{}
+
+	if _, ok := store.Get("test-key"); ok {
+		t.Fatal("expected no value before Set")
+	}
+
+	store.Set("test-key", "test-value")
+
+	got, ok := store.Get("test-key")
+
+	if !ok {
+		t.Fatal("expected value after Set")
+	}
+
+	if diff := cmp.Diff(got, "test-value"); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestPlanStoreGetOrCompute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		store               *resource.PlanStore
+		computeDiags        diag.Diagnostics
+		expectedValue       any
+		expectedDiags       diag.Diagnostics
+		expectedComputes    int64
+		expectedStoredValue bool
+	}{
+		"stored": {
+			store:               &resource.PlanStore{},
+			expectedValue:       "test-value",
+			expectedComputes:    1,
+			expectedStoredValue: true,
+		},
+		"warning": {
+			store: &resource.PlanStore{},
+			computeDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+			expectedValue: "test-value",
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+			expectedComputes:    1,
+			expectedStoredValue: true,
+		},
+		"error": {
+			store: &resource.PlanStore{},
+			computeDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+			expectedValue: "test-value",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+			expectedComputes: 2,
+		},
+		"nil": {
+			store:            nil,
+			expectedValue:    "test-value",
+			expectedComputes: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var computes int64
+
+			compute := func(context.Context) (any, diag.Diagnostics) {
+				atomic.AddInt64(&computes, 1)
+
+				return "test-value", testCase.computeDiags
+			}
+
+			for i := 0; i < 2; i++ {
+				gotValue, gotDiags := testCase.store.GetOrCompute(context.Background(), "test-key", compute)
+
+				if diff := cmp.Diff(gotValue, testCase.expectedValue); diff != "" {
+					t.Errorf("unexpected value difference: %s", diff)
+				}
+
+				if diff := cmp.Diff(gotDiags, testCase.expectedDiags); diff != "" {
+					t.Errorf("unexpected diagnostics difference: %s", diff)
+				}
+			}
+
+			if computes != testCase.expectedComputes {
+				t.Errorf("expected %d compute calls, got %d", testCase.expectedComputes, computes)
+			}
+
+			if _, ok := testCase.store.Get("test-key"); ok != testCase.expectedStoredValue {
+				t.Errorf("expected stored value %t, got %t", testCase.expectedStoredValue, ok)
+			}
+		})
+	}
+}
+
+func TestPlanStoreGetOrCompute_concurrent(t *testing.T) {
+	t.Parallel()
+
+	store := &resource.PlanStore{}
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var computes int64
+
+	compute := func(context.Context) (any, diag.Diagnostics) {
+		if atomic.AddInt64(&computes, 1) == 1 {
+			close(started)
+		}
+
+		<-release
+
+		return "test-value", nil
+	}
+
+	var wg sync.WaitGroup
+
+	values := make([]any, 10)
+
+	for i := range values {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			values[i], _ = store.GetOrCompute(context.Background(), "test-key", compute)
+		}(i)
+	}
+
+	<-started
+	close(release)
+	wg.Wait()
+
+	if computes != 1 {
+		t.Errorf("expected 1 compute call, got %d", computes)
+	}
+
+	for _, value := range values {
+		if diff := cmp.Diff(value, "test-value"); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	}
+}
+
+func TestPlanStoreGetOrCompute_canceled(t *testing.T) {
+	t.Parallel()
+
+	store := &resource.PlanStore{}
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go store.GetOrCompute(context.Background(), "test-key", func(context.Context) (any, diag.Diagnostics) {
+		close(started)
+		<-release
+
+		return "test-value", nil
+	})
+
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, diags := store.GetOrCompute(ctx, "test-key", func(context.Context) (any, diag.Diagnostics) {
+		t.Error("unexpected compute call")
+
+		return nil, nil
+	})
+
+	close(release)
+
+	if !diags.HasError() {
+		t.Errorf("expected error diagnostic, got: %v", diags)
+	}
+}
@@ -76,4 +76,14 @@ type ReadResponse struct {
 	// NOTE: This functionality is related to deferred action support, which is currently experimental and is subject
 	// to change or break without warning. It is not protected by version compatibility guarantees.
 	Deferred *Deferred
+
+	// Tainted indicates the remote resource is irrecoverably degraded and
+	// must be replaced, similar to the Terraform taint command. The framework
+	// saves this into the resource private state, then the next plan marks
+	// the resource for replacement by setting Computed attributes without a
+	// configuration value to unknown and requiring replacement for them.
+	//
+	// The resource schema must contain at least one top level Computed
+	// attribute or the next plan will return an error diagnostic.
+	Tainted bool
 }
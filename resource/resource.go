@@ -127,6 +127,23 @@ type ResourceWithModifyPlan interface {
 	ModifyPlan(context.Context, ModifyPlanRequest, *ModifyPlanResponse)
 }
 
+// ResourceWithPlanDependencies is an interface type that extends Resource to
+// declare the order in which attribute plan modifiers are run.
+//
+// By default, the plan modifiers of each top level attribute and block are
+// run in name order, attributes before blocks, and each plan modifier
+// receives the plan before any plan modification. With declared dependencies,
+// the plan modifiers of a dependent attribute are run after those of the
+// attributes it depends on, and receive the plan with their modifications.
+type ResourceWithPlanDependencies interface {
+	Resource
+
+	// PlanDependencies returns the ordering between top level attributes
+	// and blocks. Dependencies on unknown paths, nested paths, or cycles
+	// between dependencies return an error diagnostic.
+	PlanDependencies(context.Context) []PlanDependency
+}
+
 // Optional interface on top of [Resource] that enables provider control over
 // the MoveResourceState RPC. This RPC is called by Terraform when there is a
 // `moved` configuration block that changes the resource type and where this
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	// DefaultMinDelay is the Config MinDelay used when unset.
+	DefaultMinDelay = time.Second
+
+	// DefaultMaxDelay is the Config MaxDelay used when unset.
+	DefaultMaxDelay = 30 * time.Second
+)
+
+// Func is provider-defined logic which may be retried. Returning diagnostics
+// where every error is a TransientErrorDiagnostic causes the logic to be
+// called again. The function should not assume any response data from a
+// prior attempt is preserved.
+type Func func(ctx context.Context) diag.Diagnostics
+
+// Config controls the number of attempts and the delays between them.
+type Config struct {
+	// MaxAttempts is the maximum number of calls, including the first call.
+	// Values of 1 or less, including the zero value, call the function once
+	// without retrying, so retries must be enabled by setting this field.
+	MaxAttempts int
+
+	// MinDelay is the duration before the second call, which is doubled
+	// before each following call up to MaxDelay. Defaults to
+	// DefaultMinDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum duration between calls. Defaults to
+	// DefaultMaxDelay.
+	MaxDelay time.Duration
+
+	// Timeout is the maximum duration for all calls, in addition to any
+	// context deadline. A zero value only retries until the context is done,
+	// such as when using the timeouts defined in resource configuration.
+	Timeout time.Duration
+}
+
+// Do calls the function, with exponential backoff between calls, while it
+// returns only transient error diagnostics. The function is always called at
+// least once, and only called again if the Config MaxAttempts is greater
+// than 1.
+//
+// Do not call Do within a resource Create, Read, Update, or Delete method
+// which has retries enabled by the resource.ResourceBehavior type
+// TransientErrorRetry field, since each framework attempt would then make
+// up to MaxAttempts calls of its own. Enable retries in one of the two
+// places instead.
+//
+// The diagnostics of the last call are returned, which contain the transient
+// errors if the attempts are exhausted, the timeout is reached, or the
+// context is canceled, such as when a practitioner interrupts Terraform.
+func Do(ctx context.Context, config Config, f Func) diag.Diagnostics {
+	if f == nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Missing Retry Function",
+				"An unexpected error occurred while retrying resource logic. "+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+					"The retry function must be provided.",
+			),
+		}
+	}
+
+	delay := config.MinDelay
+
+	if delay <= 0 {
+		delay = DefaultMinDelay
+	}
+
+	maxDelay := config.MaxDelay
+
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxDelay
+	}
+
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, config.Timeout)
+
+		defer cancel()
+	}
+
+	for attempt := 1; ; attempt++ {
+		diags := f(ctx)
+
+		if !IsTransient(diags) || attempt >= config.MaxAttempts {
+			return diags
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return diags
+		case <-timer.C:
+		}
+
+		delay *= 2
+
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/retry"
+)
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx                 func() (context.Context, context.CancelFunc)
+		config              retry.Config
+		f                   func(calls *int) retry.Func
+		expectedCalls       int
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"success": {
+			f: func(calls *int) retry.Func {
+				return func(_ context.Context) diag.Diagnostics {
+					*calls++
+
+					return nil
+				}
+			},
+			expectedCalls: 1,
+		},
+		"success-after-transient-errors": {
+			config: retry.Config{
+				MaxAttempts: 5,
+				MinDelay:    time.Millisecond,
+				MaxDelay:    2 * time.Millisecond,
+			},
+			f: func(calls *int) retry.Func {
+				return func(_ context.Context) diag.Diagnostics {
+					*calls++
+
+					if *calls < 3 {
+						return diag.Diagnostics{
+							retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+						}
+					}
+
+					return diag.Diagnostics{
+						diag.NewWarningDiagnostic("warning summary", "warning detail"),
+					}
+				}
+			},
+			expectedCalls: 3,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+			},
+		},
+		"error": {
+			config: retry.Config{
+				MaxAttempts: 5,
+				MinDelay:    time.Millisecond,
+			},
+			f: func(calls *int) retry.Func {
+				return func(_ context.Context) diag.Diagnostics {
+					*calls++
+
+					return diag.Diagnostics{
+						retry.NewTransientErrorDiagnostic("transient summary", "transient detail"),
+						diag.NewErrorDiagnostic("error summary", "error detail"),
+					}
+				}
+			},
+			expectedCalls: 1,
+			expectedDiagnostics: diag.Diagnostics{
+				retry.NewTransientErrorDiagnostic("transient summary", "transient detail"),
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"retries-disabled": {
+			f: func(calls *int) retry.Func {
+				return func(_ context.Context) diag.Diagnostics {
+					*calls++
+
+					return diag.Diagnostics{
+						retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+					}
+				}
+			},
+			expectedCalls: 1,
+			expectedDiagnostics: diag.Diagnostics{
+				retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"max-attempts-one": {
+			config: retry.Config{
+				MaxAttempts: 1,
+				MinDelay:    time.Millisecond,
+			},
+			f: func(calls *int) retry.Func {
+				return func(_ context.Context) diag.Diagnostics {
+					*calls++
+
+					return diag.Diagnostics{
+						retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+					}
+				}
+			},
+			expectedCalls: 1,
+			expectedDiagnostics: diag.Diagnostics{
+				retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"max-attempts": {
+			config: retry.Config{
+				MaxAttempts: 3,
+				MinDelay:    time.Millisecond,
+			},
+			f: func(calls *int) retry.Func {
+				return func(_ context.Context) diag.Diagnostics {
+					*calls++
+
+					return diag.Diagnostics{
+						retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+					}
+				}
+			},
+			expectedCalls: 3,
+			expectedDiagnostics: diag.Diagnostics{
+				retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"timeout": {
+			config: retry.Config{
+				MaxAttempts: 5,
+				MinDelay:    time.Hour,
+				Timeout:     time.Millisecond,
+			},
+			f: func(calls *int) retry.Func {
+				return func(_ context.Context) diag.Diagnostics {
+					*calls++
+
+					return diag.Diagnostics{
+						retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+					}
+				}
+			},
+			expectedCalls: 1,
+			expectedDiagnostics: diag.Diagnostics{
+				retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"canceled": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+
+				cancel()
+
+				return ctx, cancel
+			},
+			config: retry.Config{
+				MaxAttempts: 5,
+				MinDelay:    time.Hour,
+			},
+			f: func(calls *int) retry.Func {
+				return func(_ context.Context) diag.Diagnostics {
+					*calls++
+
+					return diag.Diagnostics{
+						retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+					}
+				}
+			},
+			expectedCalls: 1,
+			expectedDiagnostics: diag.Diagnostics{
+				retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+			},
+		},
+		"missing-func": {
+			f: func(_ *int) retry.Func {
+				return nil
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Retry Function",
+					"An unexpected error occurred while retrying resource logic. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"The retry function must be provided.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if testCase.ctx != nil {
+				var cancel context.CancelFunc
+
+				ctx, cancel = testCase.ctx()
+
+				defer cancel()
+			}
+
+			var calls int
+
+			diags := retry.Do(ctx, testCase.config, testCase.f(&calls))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.expectedCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package retry contains helpers for retrying managed resource logic which
+// can fail with transient errors, such as remote API rate limiting or
+// eventual consistency.
+//
+// Transient errors are signaled with the NewTransientErrorDiagnostic
+// function. The Do function calls provider-defined logic, with exponential
+// backoff between calls, while it returns only transient error diagnostics.
+//
+// Resource Create, Read, Update, and Delete methods can also return transient
+// error diagnostics in their response, in which case the framework calls the
+// method again, if enabled by the resource.ResourceBehavior type
+// TransientErrorRetry field. Both Do and the framework only retry when the
+// Config MaxAttempts is greater than 1. Use only one of the two for the same
+// logic, since nesting them multiplies the number of calls.
+package retry
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var _ diag.Diagnostic = TransientErrorDiagnostic{}
+
+// TransientErrorDiagnostic is an error severity diagnostic which signals that
+// the failed logic can be retried, such as after a remote API rate limiting
+// or temporary unavailability response. If retries are exhausted, the
+// diagnostic is returned to Terraform like any other error diagnostic.
+type TransientErrorDiagnostic struct {
+	detail  string
+	summary string
+}
+
+// Detail returns the diagnostic detail.
+func (d TransientErrorDiagnostic) Detail() string {
+	return d.detail
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d TransientErrorDiagnostic) Equal(other diag.Diagnostic) bool {
+	td, ok := other.(TransientErrorDiagnostic)
+
+	if !ok {
+		return false
+	}
+
+	return td.Summary() == d.Summary() && td.Detail() == d.Detail()
+}
+
+// Severity returns the diagnostic severity.
+func (d TransientErrorDiagnostic) Severity() diag.Severity {
+	return diag.SeverityError
+}
+
+// Summary returns the diagnostic summary.
+func (d TransientErrorDiagnostic) Summary() string {
+	return d.summary
+}
+
+// NewTransientErrorDiagnostic returns a new transient error diagnostic with
+// the given summary and detail.
+func NewTransientErrorDiagnostic(summary string, detail string) TransientErrorDiagnostic {
+	return TransientErrorDiagnostic{
+		detail:  detail,
+		summary: summary,
+	}
+}
+
+// IsTransient returns true if the diagnostics contain at least one error and
+// every error is a TransientErrorDiagnostic.
+func IsTransient(diags diag.Diagnostics) bool {
+	if !diags.HasError() {
+		return false
+	}
+
+	for _, d := range diags.Errors() {
+		if _, ok := d.(TransientErrorDiagnostic); !ok {
+			return false
+		}
+	}
+
+	return true
+}
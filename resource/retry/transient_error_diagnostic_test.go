@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/retry"
+)
+
+func TestIsTransient(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected bool
+	}{
+		"nil": {
+			diags:    nil,
+			expected: false,
+		},
+		"warning": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+			},
+			expected: false,
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+			expected: false,
+		},
+		"transient": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+				retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+			},
+			expected: true,
+		},
+		"transient-and-error": {
+			diags: diag.Diagnostics{
+				retry.NewTransientErrorDiagnostic("error summary", "error detail"),
+				diag.NewErrorDiagnostic("error summary", "error detail"),
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := retry.IsTransient(testCase.diags)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -530,6 +530,25 @@ func TestBoolAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"required-with-computed": {
+			attribute: schema.BoolAttribute{
+				Computed: true,
+				Required: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Required Attribute With Optional Or Computed",
+						"Attribute \"test\" must not be optional or computed when required. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"default-without-computed": {
 			attribute: schema.BoolAttribute{
 				Default: booldefault.StaticBool(true),
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package booldefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultBool(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Bool{
+		DefaultBoolMethod: func(_ context.Context, _ defaults.BoolRequest, resp *defaults.BoolResponse) {
+			resp.PlanValue = types.BoolNull()
+		},
+	}
+
+	errorDefault := testdefaults.Bool{
+		DefaultBoolMethod: func(_ context.Context, _ defaults.BoolRequest, resp *defaults.BoolResponse) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.BoolNull()
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Bool
+		expected      *defaults.BoolResponse
+	}{
+		"first": {
+			defaultValues: []defaults.Bool{
+				booldefault.StaticBool(true),
+				nullDefault,
+			},
+			expected: &defaults.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Bool{
+				nullDefault,
+				booldefault.StaticBool(true),
+			},
+			expected: &defaults.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Bool{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.BoolResponse{
+				PlanValue: types.BoolNull(),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Bool{
+				errorDefault,
+				booldefault.StaticBool(true),
+			},
+			expected: &defaults.BoolResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.BoolNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.BoolResponse{}
+
+			booldefault.FirstNonNull(testCase.defaultValues...).DefaultBool(context.Background(), defaults.BoolRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package booldefault
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+// FromSibling returns a default value handler which uses the value of the
+// named sibling attribute at the same nesting level. For example, a
+// display_name attribute could default to the name attribute value.
+//
+// The sibling value is its configuration value or, if null, its default
+// value. Default value reference cycles are returned as error diagnostics.
+// The sibling attribute must be a boolean attribute.
+//
+// FromSibling is equivalent to calling FromPath with
+// path.MatchRelative().AtParent().AtName(attributeName).
+func FromSibling(attributeName string) defaults.Bool {
+	return FromPath(path.MatchRelative().AtParent().AtName(attributeName))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNullIfDisabledModifierPlanModifyBool(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testPlan := func(enabled tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"name":    tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"enabled": enabled,
+					"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		expression path.Expression
+		request    planmodifier.BoolRequest
+		expected   *planmodifier.BoolResponse
+	}{
+		"known-plan": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"unknown-config": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolUnknown(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.BoolUnknown(),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"enabled-true": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, true)),
+				PlanValue:   types.BoolUnknown(),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"enabled-false": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.BoolUnknown(),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.BoolResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			boolplanmodifier.NullIfDisabled(testCase.expression).PlanModifyBool(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,7 +6,9 @@ package defaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -23,6 +25,21 @@ type Float64Request struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
+
+	// ReferenceValue is the value of the attribute referenced by the default
+	// value handler, if it implements the Reference interface. This is the
+	// configuration value of the referenced attribute or, if null, its
+	// default value. Otherwise, this is nil.
+	ReferenceValue attr.Value
+
+	// ReferenceValues are the values of the attributes referenced by the
+	// default value handler, if it implements the References interface, in
+	// the same order as its ReferencePaths. Otherwise, this is nil.
+	ReferenceValues []attr.Value
+
+	// Functions calls the pure provider-defined functions of the provider,
+	// such as to reuse value normalization logic in default values.
+	Functions function.Caller
 }
 
 type Float64Response struct {
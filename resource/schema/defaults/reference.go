@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Reference is an optional interface for default value handlers whose value
+// is derived from another attribute value in the same configuration, such as
+// a sibling attribute at the same nesting level. The framework resolves the
+// referenced value, including the default value of the referenced attribute
+// if its configuration value is null, and sets the request ReferenceValue
+// field before calling the default value handler.
+//
+// Default value reference cycles, such as two attributes whose default values
+// reference each other, are returned as error diagnostics.
+type Reference interface {
+	// ReferencePath should return the path expression of the referenced
+	// attribute. Relative expressions are merged with the path of the
+	// attribute with the default value, such as
+	// path.MatchRelative().AtParent().AtName("name") for a sibling attribute.
+	// The expression must match exactly one attribute.
+	ReferencePath(context.Context) path.Expression
+}
+
+// References is an optional interface for default value handlers which
+// combine other default value handlers, such as the FirstNonNull handlers,
+// where the combined handlers may reference other attributes. The framework
+// resolves the value of each referenced attribute in the same way as for the
+// Reference interface and sets the request ReferenceValues field, in the same
+// order as the path expressions, before calling the default value handler.
+type References interface {
+	// ReferencePaths should return the path expressions of the referenced
+	// attributes, such as the ReferencePath of each combined handler which
+	// implements the Reference interface. Each expression must match exactly
+	// one attribute.
+	ReferencePaths(context.Context) path.Expressions
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultDynamic(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Dynamic{
+		DefaultDynamicMethod: func(_ context.Context, _ defaults.DynamicRequest, resp *defaults.DynamicResponse) {
+			resp.PlanValue = types.DynamicNull()
+		},
+	}
+
+	errorDefault := testdefaults.Dynamic{
+		DefaultDynamicMethod: func(_ context.Context, _ defaults.DynamicRequest, resp *defaults.DynamicResponse) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.DynamicNull()
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Dynamic
+		expected      *defaults.DynamicResponse
+	}{
+		"first": {
+			defaultValues: []defaults.Dynamic{
+				dynamicdefault.StaticValue(types.DynamicValue(types.StringValue("test"))),
+				nullDefault,
+			},
+			expected: &defaults.DynamicResponse{
+				PlanValue: types.DynamicValue(types.StringValue("test")),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Dynamic{
+				nullDefault,
+				dynamicdefault.StaticValue(types.DynamicValue(types.StringValue("test"))),
+			},
+			expected: &defaults.DynamicResponse{
+				PlanValue: types.DynamicValue(types.StringValue("test")),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Dynamic{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.DynamicResponse{
+				PlanValue: types.DynamicNull(),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Dynamic{
+				errorDefault,
+				dynamicdefault.StaticValue(types.DynamicValue(types.StringValue("test"))),
+			},
+			expected: &defaults.DynamicResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.DynamicNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.DynamicResponse{}
+
+			dynamicdefault.FirstNonNull(testCase.defaultValues...).DefaultDynamic(context.Background(), defaults.DynamicRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNullIfDisabledModifierPlanModifyDynamic(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testPlan := func(enabled tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"name":    tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"enabled": enabled,
+					"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		expression path.Expression
+		request    planmodifier.DynamicRequest
+		expected   *planmodifier.DynamicResponse
+	}{
+		"known-plan": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.DynamicRequest{
+				ConfigValue: types.DynamicNull(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.DynamicValue(types.StringValue("test")),
+			},
+			expected: &planmodifier.DynamicResponse{
+				PlanValue: types.DynamicValue(types.StringValue("test")),
+			},
+		},
+		"unknown-config": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.DynamicRequest{
+				ConfigValue: types.DynamicUnknown(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.DynamicUnknown(),
+			},
+			expected: &planmodifier.DynamicResponse{
+				PlanValue: types.DynamicUnknown(),
+			},
+		},
+		"enabled-true": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.DynamicRequest{
+				ConfigValue: types.DynamicNull(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, true)),
+				PlanValue:   types.DynamicUnknown(),
+			},
+			expected: &planmodifier.DynamicResponse{
+				PlanValue: types.DynamicUnknown(),
+			},
+		},
+		"enabled-false": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.DynamicRequest{
+				ConfigValue: types.DynamicNull(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.DynamicUnknown(),
+			},
+			expected: &planmodifier.DynamicResponse{
+				PlanValue: types.DynamicNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.DynamicResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			dynamicplanmodifier.NullIfDisabled(testCase.expression).PlanModifyDynamic(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
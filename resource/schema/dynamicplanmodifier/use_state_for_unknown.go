@@ -56,3 +56,9 @@ func (m useStateForUnknownModifier) PlanModifyDynamic(ctx context.Context, req p
 
 	resp.PlanValue = req.StateValue
 }
+
+// UsesStateForUnknown returns true as the plan modifier copies the prior
+// state value into an unknown planned value.
+func (m useStateForUnknownModifier) UsesStateForUnknown() bool {
+	return true
+}
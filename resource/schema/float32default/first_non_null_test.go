@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32default_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultFloat32(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Float32{
+		DefaultFloat32Method: func(_ context.Context, _ defaults.Float32Request, resp *defaults.Float32Response) {
+			resp.PlanValue = types.Float32Null()
+		},
+	}
+
+	errorDefault := testdefaults.Float32{
+		DefaultFloat32Method: func(_ context.Context, _ defaults.Float32Request, resp *defaults.Float32Response) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.Float32Null()
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Float32
+		expected      *defaults.Float32Response
+	}{
+		"first": {
+			defaultValues: []defaults.Float32{
+				float32default.StaticFloat32(1.2),
+				nullDefault,
+			},
+			expected: &defaults.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Float32{
+				nullDefault,
+				float32default.StaticFloat32(1.2),
+			},
+			expected: &defaults.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Float32{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.Float32Response{
+				PlanValue: types.Float32Null(),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Float32{
+				errorDefault,
+				float32default.StaticFloat32(1.2),
+			},
+			expected: &defaults.Float32Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.Float32Null(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.Float32Response{}
+
+			float32default.FirstNonNull(testCase.defaultValues...).DefaultFloat32(context.Background(), defaults.Float32Request{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
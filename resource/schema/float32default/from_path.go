@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32default
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// FromPath returns a default value handler which uses the value of the
+// attribute matching the path expression. Relative expressions are merged
+// with the path of the attribute with the default value. For example, a
+// display_name attribute could default to the value of path.MatchRoot("name").
+//
+// The referenced value is its configuration value or, if null, its default
+// value. Default value reference cycles are returned as error diagnostics.
+// The expression must match exactly one float32 attribute.
+func FromPath(expression path.Expression) defaults.Float32 {
+	return fromPathDefault{
+		expression: expression,
+	}
+}
+
+var _ defaults.Reference = fromPathDefault{}
+
+// fromPathDefault is a default value handler that sets the value of the
+// attribute matching a path expression on a float32 attribute.
+type fromPathDefault struct {
+	expression path.Expression
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d fromPathDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to the %s attribute value", d.expression)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d fromPathDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to the `%s` attribute value", d.expression)
+}
+
+// ReferencePath returns the path expression of the referenced attribute.
+func (d fromPathDefault) ReferencePath(_ context.Context) path.Expression {
+	return d.expression
+}
+
+// DefaultFloat32 implements the path default value logic.
+func (d fromPathDefault) DefaultFloat32(ctx context.Context, req defaults.Float32Request, resp *defaults.Float32Response) {
+	defaultFromReference(ctx, req, resp)
+}
+
+// defaultFromReference sets the plan value to the request ReferenceValue,
+// which the framework resolves for default value handlers implementing
+// defaults.Reference.
+func defaultFromReference(ctx context.Context, req defaults.Float32Request, resp *defaults.Float32Response) {
+	if req.ReferenceValue == nil {
+		return
+	}
+
+	valuable, ok := req.ReferenceValue.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Default Value Reference",
+			"The attribute default value references an attribute with an unexpected value type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Expected: float32 value\n"+
+				fmt.Sprintf("Got: %T", req.ReferenceValue),
+		)
+
+		return
+	}
+
+	value, diags := valuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = value
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// RequiresReplaceIfKnownValueChanges returns a plan modifier that conditionally
+// requires resource replacement if:
+//
+//   - The resource is planned for update.
+//   - The plan and state values are not equal.
+//   - The state value is known and not null.
+//   - The plan value is known and not null.
+//
+// Use this to ignore first-time population of a value, such as a state value
+// that was null before a new provider version began setting it, or an unknown
+// plan value which is only known after apply. Use RequiresReplace if the
+// resource replacement should occur on any value change.
+func RequiresReplaceIfKnownValueChanges() planmodifier.Float32 {
+	return RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.Float32Request, resp *RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+				return
+			}
+
+			if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+				return
+			}
+
+			resp.RequiresReplace = true
+		},
+		"If a known value of this attribute changes to a different known value, Terraform will destroy and recreate the resource.",
+		"If a known value of this attribute changes to a different known value, Terraform will destroy and recreate the resource.",
+	)
+}
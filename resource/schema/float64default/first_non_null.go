@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64default
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdefault"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FirstNonNull returns a default value handler which calls each of the given
+// default value handlers in order, using the first non-null value.
+//
+// Use FirstNonNull to declare a default resolution order, such as an
+// environment variable followed by a static value, rather than encoding that
+// order in a custom default value handler. Any error diagnostics from a
+// handler stop the resolution.
+//
+// Handlers which reference other attributes, such as FromPath and FromSibling
+// handlers, receive the referenced values as they would outside FirstNonNull.
+// Every referenced attribute is resolved before calling the handlers, so each
+// reference must be valid even if an earlier handler returns a value.
+//
+// If all handlers return a null value, the planned value is null. Unlike an
+// attribute without a default value, the value is not marked as unknown, so
+// the resource logic cannot set it during apply. End the handlers with one
+// which always returns a non-null value, such as a static value, unless a
+// null value is intended.
+func FirstNonNull(defaultValues ...defaults.Float64) defaults.Float64 {
+	return firstNonNullDefault{
+		defaultValues: defaultValues,
+	}
+}
+
+var _ defaults.References = firstNonNullDefault{}
+
+// firstNonNullDefault is a default value handler that sets the first non-null
+// value from other default value handlers on a float64 attribute.
+type firstNonNullDefault struct {
+	defaultValues []defaults.Float64
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d firstNonNullDefault) Description(ctx context.Context) string {
+	return fwdefault.FirstNonNullDescription(ctx, d.defaultValues)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d firstNonNullDefault) MarkdownDescription(ctx context.Context) string {
+	return fwdefault.FirstNonNullMarkdownDescription(ctx, d.defaultValues)
+}
+
+// ReferencePaths returns the path expressions referenced by the default value
+// handlers, such as FromPath and FromSibling handlers.
+func (d firstNonNullDefault) ReferencePaths(ctx context.Context) path.Expressions {
+	return fwdefault.ReferencePaths(ctx, d.defaultValues)
+}
+
+// DefaultFloat64 implements the first non-null default value logic.
+func (d firstNonNullDefault) DefaultFloat64(ctx context.Context, req defaults.Float64Request, resp *defaults.Float64Response) {
+	references := fwdefault.SplitReferenceValues(ctx, d.defaultValues, req.ReferenceValues)
+
+	planValue, diags := fwdefault.FirstNonNull(d.defaultValues, func(i int, defaultValue defaults.Float64) (types.Float64, diag.Diagnostics) {
+		defaultReq := req
+		defaultReq.ReferenceValue = references[i].Value
+		defaultReq.ReferenceValues = references[i].Values
+		defaultResp := defaults.Float64Response{}
+
+		defaultValue.DefaultFloat64(ctx, defaultReq, &defaultResp)
+
+		return defaultResp.PlanValue, defaultResp.Diagnostics
+	})
+
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = planValue
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64default_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultFloat64(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Float64{
+		DefaultFloat64Method: func(_ context.Context, _ defaults.Float64Request, resp *defaults.Float64Response) {
+			resp.PlanValue = types.Float64Null()
+		},
+	}
+
+	errorDefault := testdefaults.Float64{
+		DefaultFloat64Method: func(_ context.Context, _ defaults.Float64Request, resp *defaults.Float64Response) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.Float64Null()
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Float64
+		expected      *defaults.Float64Response
+	}{
+		"first": {
+			defaultValues: []defaults.Float64{
+				float64default.StaticFloat64(1.2),
+				nullDefault,
+			},
+			expected: &defaults.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Float64{
+				nullDefault,
+				float64default.StaticFloat64(1.2),
+			},
+			expected: &defaults.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Float64{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.Float64Response{
+				PlanValue: types.Float64Null(),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Float64{
+				errorDefault,
+				float64default.StaticFloat64(1.2),
+			},
+			expected: &defaults.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.Float64Null(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.Float64Response{}
+
+			float64default.FirstNonNull(testCase.defaultValues...).DefaultFloat64(context.Background(), defaults.Float64Request{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
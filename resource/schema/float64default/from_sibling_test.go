@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64default_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromSiblingDefaultFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		referenceValue    attr.Value
+		expectedPlanValue attr.Value
+		expectedDiags     diag.Diagnostics
+	}{
+		"reference-value": {
+			referenceValue:    types.Float64Value(1.2),
+			expectedPlanValue: types.Float64Value(1.2),
+		},
+		"reference-value-invalid-type": {
+			referenceValue: types.StringValue("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Default Value Reference",
+					"The attribute default value references an attribute with an unexpected value type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Expected: float64 value\n"+
+						"Got: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := defaults.Float64Request{
+				Path:           path.Root("test"),
+				ReferenceValue: testCase.referenceValue,
+			}
+			resp := &defaults.Float64Response{}
+
+			float64default.FromSibling("other").DefaultFloat64(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testCase.expectedPlanValue == nil {
+				return
+			}
+
+			if diff := cmp.Diff(resp.PlanValue, testCase.expectedPlanValue); diff != "" {
+				t.Errorf("unexpected plan value difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNullIfDisabledModifierPlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testPlan := func(enabled tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"name":    tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"enabled": enabled,
+					"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		expression path.Expression
+		request    planmodifier.Float64Request
+		expected   *planmodifier.Float64Response
+	}{
+		"known-plan": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Null(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"unknown-config": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Unknown(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.Float64Unknown(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"enabled-true": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Null(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, true)),
+				PlanValue:   types.Float64Unknown(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"enabled-false": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Null(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.Float64Unknown(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Null(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float64planmodifier.NullIfDisabled(testCase.expression).PlanModifyFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
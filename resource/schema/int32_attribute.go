@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,7 +22,9 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = Int32Attribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = Int32Attribute{}
 	_ fwschema.AttributeWithValidateImplementation = Int32Attribute{}
+	_ fwschema.AttributeWithReferences             = Int32Attribute{}
 	_ fwschema.AttributeWithInt32DefaultValue      = Int32Attribute{}
 	_ fwxschema.AttributeWithInt32PlanModifiers    = Int32Attribute{}
 	_ fwxschema.AttributeWithInt32Validators       = Int32Attribute{}
@@ -119,6 +122,15 @@ type Int32Attribute struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationReplacement defines a path expression, relative to this
+	// attribute, to the attribute which replaces this attribute. When
+	// DeprecationMessage is set and practitioner configurations use this
+	// attribute, the "Attribute Deprecated" warning diagnostic includes the
+	// resolved replacement path and is a diag.AttributeDeprecationDiagnostic,
+	// so tooling can suggest configuration migrations. This field has no
+	// effect if DeprecationMessage is not set.
+	DeprecationReplacement path.Expression
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -155,6 +167,17 @@ type Int32Attribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Int32
+
+	// References defines a path expression, relative to this attribute, to
+	// another attribute whose value this attribute must match. The framework
+	// validates that the expression matches an attribute in the schema and,
+	// after planning, returns an error diagnostic if the planned value of
+	// this attribute differs from the planned value of any matched attribute.
+	// Null and unknown planned values are not compared.
+	//
+	// This is typically used for computed attributes which are derived from
+	// another attribute, such as an identifier echoed by the remote system.
+	References path.Expression
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -178,6 +201,11 @@ func (a Int32Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement returns the DeprecationReplacement field value.
+func (a Int32Attribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
 // GetDescription returns the Description field value.
 func (a Int32Attribute) GetDescription() string {
 	return a.Description
@@ -188,6 +216,11 @@ func (a Int32Attribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetReferences returns the References field value.
+func (a Int32Attribute) GetReferences() path.Expression {
+	return a.References
+}
+
 // GetType returns types.Int32Type or the CustomType field value if defined.
 func (a Int32Attribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -237,6 +270,10 @@ func (a Int32Attribute) IsSensitive() bool {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a Int32Attribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsRequired() && (a.IsOptional() || a.IsComputed()) {
+		resp.Diagnostics.Append(requiredAttributeWithOptionalOrComputedDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.Int32DefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
@@ -530,6 +530,25 @@ func TestInt32AttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"required-with-computed": {
+			attribute: schema.Int32Attribute{
+				Computed: true,
+				Required: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Required Attribute With Optional Or Computed",
+						"Attribute \"test\" must not be optional or computed when required. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"default-without-computed": {
 			attribute: schema.Int32Attribute{
 				Default: int32default.StaticInt32(123),
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32default_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultInt32(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Int32{
+		DefaultInt32Method: func(_ context.Context, _ defaults.Int32Request, resp *defaults.Int32Response) {
+			resp.PlanValue = types.Int32Null()
+		},
+	}
+
+	errorDefault := testdefaults.Int32{
+		DefaultInt32Method: func(_ context.Context, _ defaults.Int32Request, resp *defaults.Int32Response) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.Int32Null()
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Int32
+		expected      *defaults.Int32Response
+	}{
+		"first": {
+			defaultValues: []defaults.Int32{
+				int32default.StaticInt32(123),
+				nullDefault,
+			},
+			expected: &defaults.Int32Response{
+				PlanValue: types.Int32Value(123),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Int32{
+				nullDefault,
+				int32default.StaticInt32(123),
+			},
+			expected: &defaults.Int32Response{
+				PlanValue: types.Int32Value(123),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Int32{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.Int32Response{
+				PlanValue: types.Int32Null(),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Int32{
+				errorDefault,
+				int32default.StaticInt32(123),
+			},
+			expected: &defaults.Int32Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.Int32Null(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.Int32Response{}
+
+			int32default.FirstNonNull(testCase.defaultValues...).DefaultInt32(context.Background(), defaults.Int32Request{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
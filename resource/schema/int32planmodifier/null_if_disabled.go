@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32planmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NullIfDisabled returns a plan modifier that plans a null value, rather than
+// an unknown value, for an attribute which is only computed by the provider
+// when a controlling bool attribute, such as an enabled flag for a feature,
+// is true. This prevents the attribute from showing as "(known after apply)"
+// in the plan when the feature is disabled.
+//
+// The expression must match bool attributes. The plan is modified if every
+// matched attribute is planned as false or null. The plan is not modified if
+// the planned value is known, or if any matched attribute is true or unknown.
+// Place this plan modifier before UseStateForUnknown, if both are used, so a
+// prior state value is only kept while the feature remains enabled.
+func NullIfDisabled(expression path.Expression) planmodifier.Int32 {
+	return nullIfDisabledModifier{
+		expression: expression,
+	}
+}
+
+// nullIfDisabledModifier implements the plan modifier.
+type nullIfDisabledModifier struct {
+	expression path.Expression
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m nullIfDisabledModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("If %s is false or not configured, the value of this attribute is null.", m.expression)
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m nullIfDisabledModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("If `%s` is false or not configured, the value of this attribute is null.", m.expression)
+}
+
+// PlanModifyInt32 implements the plan modification logic.
+func (m nullIfDisabledModifier) PlanModifyInt32(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+	// Do nothing if there is a known planned value.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	disabled, diags := fwplanmodifier.Disabled(ctx, req.Path, req.PathExpression.Merge(m.expression), req.Plan)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Do nothing if any matched attribute is enabled.
+	if resp.Diagnostics.HasError() || !disabled {
+		return
+	}
+
+	resp.PlanValue = types.Int32Null()
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNullIfDisabledModifierPlanModifyInt32(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testPlan := func(enabled tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"name":    tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"enabled": enabled,
+					"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		expression path.Expression
+		request    planmodifier.Int32Request
+		expected   *planmodifier.Int32Response
+	}{
+		"known-plan": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(1),
+			},
+		},
+		"unknown-config": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Unknown(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.Int32Unknown(),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Unknown(),
+			},
+		},
+		"enabled-true": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, true)),
+				PlanValue:   types.Int32Unknown(),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Unknown(),
+			},
+		},
+		"enabled-false": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.Int32Unknown(),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Null(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			int32planmodifier.NullIfDisabled(testCase.expression).PlanModifyInt32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
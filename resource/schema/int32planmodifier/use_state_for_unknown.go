@@ -53,3 +53,9 @@ func (m useStateForUnknownModifier) PlanModifyInt32(_ context.Context, req planm
 
 	resp.PlanValue = req.StateValue
 }
+
+// UsesStateForUnknown returns true as the plan modifier copies the prior
+// state value into an unknown planned value.
+func (m useStateForUnknownModifier) UsesStateForUnknown() bool {
+	return true
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64default_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultInt64(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Int64{
+		DefaultInt64Method: func(_ context.Context, _ defaults.Int64Request, resp *defaults.Int64Response) {
+			resp.PlanValue = types.Int64Null()
+		},
+	}
+
+	errorDefault := testdefaults.Int64{
+		DefaultInt64Method: func(_ context.Context, _ defaults.Int64Request, resp *defaults.Int64Response) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.Int64Null()
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Int64
+		expected      *defaults.Int64Response
+	}{
+		"first": {
+			defaultValues: []defaults.Int64{
+				int64default.StaticInt64(123),
+				nullDefault,
+			},
+			expected: &defaults.Int64Response{
+				PlanValue: types.Int64Value(123),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Int64{
+				nullDefault,
+				int64default.StaticInt64(123),
+			},
+			expected: &defaults.Int64Response{
+				PlanValue: types.Int64Value(123),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Int64{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.Int64Response{
+				PlanValue: types.Int64Null(),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Int64{
+				errorDefault,
+				int64default.StaticInt64(123),
+			},
+			expected: &defaults.Int64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.Int64Null(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.Int64Response{}
+
+			int64default.FirstNonNull(testCase.defaultValues...).DefaultInt64(context.Background(), defaults.Int64Request{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
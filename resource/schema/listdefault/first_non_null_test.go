@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultList(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.List{
+		DefaultListMethod: func(_ context.Context, _ defaults.ListRequest, resp *defaults.ListResponse) {
+			resp.PlanValue = types.ListNull(types.StringType)
+		},
+	}
+
+	errorDefault := testdefaults.List{
+		DefaultListMethod: func(_ context.Context, _ defaults.ListRequest, resp *defaults.ListResponse) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.ListNull(types.StringType)
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.List
+		expected      *defaults.ListResponse
+	}{
+		"first": {
+			defaultValues: []defaults.List{
+				listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")})),
+				nullDefault,
+			},
+			expected: &defaults.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.List{
+				nullDefault,
+				listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")})),
+			},
+			expected: &defaults.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.List{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.ListResponse{
+				PlanValue: types.ListNull(types.StringType),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.List{
+				errorDefault,
+				listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")})),
+			},
+			expected: &defaults.ListResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.ListNull(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.ListResponse{}
+
+			listdefault.FirstNonNull(testCase.defaultValues...).DefaultList(context.Background(), defaults.ListRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// UseStateForUnknownElements returns a plan modifier that copies known prior
+// state values into unknown planned values, including unknown values of
+// computed attributes nested within the elements of the list. Use this
+// when it is known that the unconfigured values will remain the same after
+// a resource update.
+//
+// UseStateForUnknown only copies the prior state value when the entire list
+// is unknown. This plan modifier also handles lists with known elements,
+// such as nested attributes with configured and computed attributes, where
+// the framework marks the unconfigured and Computed nested attributes as
+// unknown on update.
+//
+// Elements are matched to prior state elements by index.
+func UseStateForUnknownElements() planmodifier.List {
+	return useStateForUnknownElementsModifier{}
+}
+
+// useStateForUnknownElementsModifier implements the plan modifier.
+type useStateForUnknownElementsModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m useStateForUnknownElementsModifier) Description(_ context.Context) string {
+	return "Once set, the unconfigured values of this attribute and its elements in state will not change."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useStateForUnknownElementsModifier) MarkdownDescription(_ context.Context) string {
+	return "Once set, the unconfigured values of this attribute and its elements in state will not change."
+}
+
+// PlanModifyList implements the plan modification logic.
+func (m useStateForUnknownElementsModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	// Do nothing if there is no state value.
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a null planned value.
+	if req.PlanValue.IsNull() {
+		return
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value, err := fwplanmodifier.UseStateForUnknownElementsValue(ctx, req.PlanValue, req.StateValue, req.ConfigValue)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Plan Modifier Value Error",
+			"An unexpected error occurred while copying prior state values into the planned value. "+
+				"This is always an issue with terraform-plugin-framework and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	listValue, ok := value.(basetypes.ListValue)
+
+	// Do nothing if the planned value is unchanged.
+	if !ok {
+		return
+	}
+
+	resp.PlanValue = listValue
+}
+
+// UsesStateForUnknown returns true as the plan modifier copies the prior
+// state value into an unknown planned value.
+func (m useStateForUnknownElementsModifier) UsesStateForUnknown() bool {
+	return true
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseStateForUnknownElementsModifierPlanModifyList(t *testing.T) {
+	t.Parallel()
+
+	testElementType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   types.StringType,
+			"name": types.StringType,
+		},
+	}
+
+	testElement := func(id types.String, name types.String) attr.Value {
+		return types.ObjectValueMust(
+			testElementType.AttrTypes,
+			map[string]attr.Value{
+				"id":   id,
+				"name": name,
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.ListRequest
+		expected *planmodifier.ListResponse
+	}{
+		"null-state": {
+			request: planmodifier.ListRequest{
+				StateValue:  types.ListNull(testElementType),
+				PlanValue:   types.ListUnknown(testElementType),
+				ConfigValue: types.ListNull(testElementType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(testElementType),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.ListRequest{
+				StateValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue:   types.ListUnknown(testElementType),
+				ConfigValue: types.ListNull(testElementType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.ListRequest{
+				StateValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue:   types.ListUnknown(testElementType),
+				ConfigValue: types.ListUnknown(testElementType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(testElementType),
+			},
+		},
+		"unknown-element-attributes": {
+			request: planmodifier.ListRequest{
+				StateValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+						testElement(types.StringValue("id-two"), types.StringValue("two")),
+					},
+				),
+				PlanValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+						testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+				ConfigValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringNull(), types.StringValue("one")),
+						testElement(types.StringNull(), types.StringValue("two")),
+					},
+				),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+						testElement(types.StringValue("id-two"), types.StringValue("two")),
+					},
+				),
+			},
+		},
+		"unknown-element-attributes-new-element": {
+			request: planmodifier.ListRequest{
+				StateValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+						testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+				ConfigValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringNull(), types.StringValue("one")),
+						testElement(types.StringNull(), types.StringValue("two")),
+					},
+				),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+						testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+			},
+		},
+		"unknown-element-attributes-unknown-config-attribute": {
+			request: planmodifier.ListRequest{
+				StateValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+				ConfigValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ListResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			listplanmodifier.UseStateForUnknownElements().PlanModifyList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
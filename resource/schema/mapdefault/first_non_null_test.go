@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultMap(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Map{
+		DefaultMapMethod: func(_ context.Context, _ defaults.MapRequest, resp *defaults.MapResponse) {
+			resp.PlanValue = types.MapNull(types.StringType)
+		},
+	}
+
+	errorDefault := testdefaults.Map{
+		DefaultMapMethod: func(_ context.Context, _ defaults.MapRequest, resp *defaults.MapResponse) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.MapNull(types.StringType)
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Map
+		expected      *defaults.MapResponse
+	}{
+		"first": {
+			defaultValues: []defaults.Map{
+				mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")})),
+				nullDefault,
+			},
+			expected: &defaults.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Map{
+				nullDefault,
+				mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")})),
+			},
+			expected: &defaults.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Map{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.MapResponse{
+				PlanValue: types.MapNull(types.StringType),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Map{
+				errorDefault,
+				mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")})),
+			},
+			expected: &defaults.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.MapNull(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.MapResponse{}
+
+			mapdefault.FirstNonNull(testCase.defaultValues...).DefaultMap(context.Background(), defaults.MapRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
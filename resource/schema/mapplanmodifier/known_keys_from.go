@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// KnownKeysFrom returns a plan modifier that plans a map with known keys and
+// unknown element values, rather than an entirely unknown map, when the keys
+// are determined by another attribute. Terraform can then use the map keys
+// before apply, such as in for_each expressions. For example, a computed ids
+// map could be keyed by the values of a configured names set.
+//
+// The expression must match a single attribute, which is either a map, whose
+// keys are used, or a list or set of strings, whose element values are used.
+// The plan is not modified if the planned value is known, or if the keys are
+// null or unknown. Place this plan modifier after UseStateForUnknown, if both
+// are used, so the prior state value is kept when it is known.
+func KnownKeysFrom(expression path.Expression) planmodifier.Map {
+	return knownKeysFromModifier{
+		expression: expression,
+	}
+}
+
+// knownKeysFromModifier implements the plan modifier.
+type knownKeysFromModifier struct {
+	expression path.Expression
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m knownKeysFromModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("If not known, the keys of this attribute are planned from %s.", m.expression)
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m knownKeysFromModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("If not known, the keys of this attribute are planned from `%s`.", m.expression)
+}
+
+// PlanModifyMap implements the plan modification logic.
+func (m knownKeysFromModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	// Do nothing if there is a known planned value.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	expression := req.PathExpression.Merge(m.expression)
+
+	matchedPaths, diags := req.Plan.PathMatches(ctx, expression)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(matchedPaths) != 1 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Plan Modifier Path Expression",
+			"The attribute plan modifier path expression must match a single attribute. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Path Expression: %s\n", expression)+
+				fmt.Sprintf("Matched Paths: %s", matchedPaths),
+		)
+
+		return
+	}
+
+	var value attr.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, matchedPaths[0], &value)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, ok, err := mapKeysFromValue(ctx, value)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Plan Modifier Path Expression",
+			"The attribute plan modifier path expression must match a map or a list or set of strings. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Path: %s\n", matchedPaths[0])+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !ok {
+		return
+	}
+
+	planValue, diags := basetypes.NewMapValueWithUnknownElements(ctx, req.PlanValue.ElementType(ctx), keys)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = planValue
+}
+
+// mapKeysFromValue returns the keys of a map value or the element values of a
+// list or set of strings value. It returns false if the keys cannot be
+// determined because the value or any element is null or unknown.
+func mapKeysFromValue(ctx context.Context, value attr.Value) ([]string, bool, error) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil, false, nil
+	}
+
+	var elements []attr.Value
+
+	switch v := value.(type) {
+	case basetypes.MapValuable:
+		mapValue, diags := v.ToMapValue(ctx)
+
+		if diags.HasError() {
+			return nil, false, fmt.Errorf("unable to convert %T to map value", value)
+		}
+
+		keys := make([]string, 0, len(mapValue.Elements()))
+
+		for key := range mapValue.Elements() {
+			keys = append(keys, key)
+		}
+
+		return keys, true, nil
+	case basetypes.ListValuable:
+		listValue, diags := v.ToListValue(ctx)
+
+		if diags.HasError() {
+			return nil, false, fmt.Errorf("unable to convert %T to list value", value)
+		}
+
+		elements = listValue.Elements()
+	case basetypes.SetValuable:
+		setValue, diags := v.ToSetValue(ctx)
+
+		if diags.HasError() {
+			return nil, false, fmt.Errorf("unable to convert %T to set value", value)
+		}
+
+		elements = setValue.Elements()
+	default:
+		return nil, false, fmt.Errorf("expected map, list, or set value, got: %T", value)
+	}
+
+	keys := make([]string, 0, len(elements))
+
+	for _, element := range elements {
+		stringValuable, ok := element.(basetypes.StringValuable)
+
+		if !ok {
+			return nil, false, fmt.Errorf("expected string element value, got: %T", element)
+		}
+
+		if element.IsNull() || element.IsUnknown() {
+			return nil, false, nil
+		}
+
+		stringValue, diags := stringValuable.ToStringValue(ctx)
+
+		if diags.HasError() {
+			return nil, false, fmt.Errorf("unable to convert %T to string value", element)
+		}
+
+		keys = append(keys, stringValue.ValueString())
+	}
+
+	return keys, true, nil
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestKnownKeysFromModifierPlanModifyMap(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"ids":   tftypes.Map{ElementType: tftypes.String},
+			"name":  tftypes.String,
+			"names": tftypes.Set{ElementType: tftypes.String},
+			"tags":  tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+
+	testPlan := func(names tftypes.Value, tags tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"ids":   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"name":  tftypes.NewValue(tftypes.String, "test-name"),
+				"names": names,
+				"tags":  tags,
+			}),
+		}
+	}
+
+	testNames := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "name1"),
+		tftypes.NewValue(tftypes.String, "name2"),
+	})
+
+	testTags := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"tag1": tftypes.NewValue(tftypes.String, "value1"),
+	})
+
+	testNullNames := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)
+	testNullTags := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+
+	testCases := map[string]struct {
+		expression path.Expression
+		request    planmodifier.MapRequest
+		expected   *planmodifier.MapResponse
+	}{
+		"known-plan": {
+			expression: path.MatchRoot("names"),
+			request: planmodifier.MapRequest{
+				ConfigValue: types.MapNull(types.StringType),
+				Path:        path.Root("ids"),
+				Plan:        testPlan(testNames, testNullTags),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"name1": types.StringValue("id1")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"name1": types.StringValue("id1")}),
+			},
+		},
+		"unknown-config": {
+			expression: path.MatchRoot("names"),
+			request: planmodifier.MapRequest{
+				ConfigValue: types.MapUnknown(types.StringType),
+				Path:        path.Root("ids"),
+				Plan:        testPlan(testNames, testNullTags),
+				PlanValue:   types.MapUnknown(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"keys-from-set": {
+			expression: path.MatchRoot("names"),
+			request: planmodifier.MapRequest{
+				ConfigValue: types.MapNull(types.StringType),
+				Path:        path.Root("ids"),
+				Plan:        testPlan(testNames, testNullTags),
+				PlanValue:   types.MapUnknown(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"name1": types.StringUnknown(),
+					"name2": types.StringUnknown(),
+				}),
+			},
+		},
+		"keys-from-map": {
+			expression: path.MatchRoot("tags"),
+			request: planmodifier.MapRequest{
+				ConfigValue: types.MapNull(types.StringType),
+				Path:        path.Root("ids"),
+				Plan:        testPlan(testNullNames, testTags),
+				PlanValue:   types.MapUnknown(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"tag1": types.StringUnknown(),
+				}),
+			},
+		},
+		"keys-from-relative-expression": {
+			expression: path.MatchRelative().AtParent().AtName("names"),
+			request: planmodifier.MapRequest{
+				ConfigValue:    types.MapNull(types.StringType),
+				Path:           path.Root("ids"),
+				PathExpression: path.MatchRoot("ids"),
+				Plan:           testPlan(testNames, testNullTags),
+				PlanValue:      types.MapUnknown(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"name1": types.StringUnknown(),
+					"name2": types.StringUnknown(),
+				}),
+			},
+		},
+		"keys-null": {
+			expression: path.MatchRoot("names"),
+			request: planmodifier.MapRequest{
+				ConfigValue: types.MapNull(types.StringType),
+				Path:        path.Root("ids"),
+				Plan:        testPlan(testNullNames, testNullTags),
+				PlanValue:   types.MapUnknown(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"keys-unknown-element": {
+			expression: path.MatchRoot("names"),
+			request: planmodifier.MapRequest{
+				ConfigValue: types.MapNull(types.StringType),
+				Path:        path.Root("ids"),
+				Plan: testPlan(
+					tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "name1"),
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					testNullTags,
+				),
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"invalid-value-type": {
+			expression: path.MatchRoot("name"),
+			request: planmodifier.MapRequest{
+				ConfigValue: types.MapNull(types.StringType),
+				Path:        path.Root("ids"),
+				Plan:        testPlan(testNullNames, testNullTags),
+				PlanValue:   types.MapUnknown(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("ids"),
+						"Invalid Plan Modifier Path Expression",
+						"The attribute plan modifier path expression must match a map or a list or set of strings. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Path: name\n"+
+							"Error: expected map, list, or set value, got: basetypes.StringValue",
+					),
+				},
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.MapResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			mapplanmodifier.KnownKeysFrom(testCase.expression).PlanModifyMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NormalizeKeys returns a plan modifier that canonicalizes map keys by
+// lowercasing them and trimming surrounding whitespace. Use this for tags-like
+// Computed attributes where the remote system does not preserve key case,
+// which would otherwise cause perpetual differences.
+//
+// The plan modifier only changes the planned value if there is no
+// configuration value, since Terraform requires planned values to match
+// configured values. In that case, if the canonicalized plan and state values
+// are equal, the prior state value is used as the planned value, so key case
+// only changes are not shown as differences. Otherwise, the planned value keys
+// are canonicalized.
+//
+// Configured values are never changed. To ignore key case differences between
+// configured and prior state values, use a custom map type which implements
+// semantic equality instead.
+//
+// Use NormalizeKeysFunc for other key canonicalization logic.
+func NormalizeKeys() planmodifier.Map {
+	return NormalizeKeysFunc(
+		func(key string) string {
+			return strings.ToLower(strings.TrimSpace(key))
+		},
+		"Map keys are compared without case or surrounding whitespace.",
+		"Map keys are compared without case or surrounding whitespace.",
+	)
+}
+
+// NormalizeKeysFunc returns a plan modifier that canonicalizes map keys with
+// the given function. Refer to NormalizeKeys for the plan modification
+// behavior.
+func NormalizeKeysFunc(f func(string) string, description, markdownDescription string) planmodifier.Map {
+	return normalizeKeysModifier{
+		normalizeFunc:       f,
+		description:         description,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// normalizeKeysModifier is a plan modifier that canonicalizes map keys.
+type normalizeKeysModifier struct {
+	normalizeFunc       func(string) string
+	description         string
+	markdownDescription string
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m normalizeKeysModifier) Description(_ context.Context) string {
+	return m.description
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m normalizeKeysModifier) MarkdownDescription(_ context.Context) string {
+	return m.markdownDescription
+}
+
+// PlanModifyMap implements the plan modification logic.
+func (m normalizeKeysModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	// Do nothing if there is no known planned value.
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Terraform requires planned values to match configured values.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	planValue, diags := m.normalize(ctx, req.Path, req.PlanValue)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.StateValue.IsNull() && !req.StateValue.IsUnknown() {
+		stateValue, diags := m.normalize(ctx, req.Path, req.StateValue)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if planValue.Equal(stateValue) {
+			resp.PlanValue = req.StateValue
+
+			return
+		}
+	}
+
+	resp.PlanValue = planValue
+}
+
+// normalize returns the given map value with canonicalized keys.
+func (m normalizeKeysModifier) normalize(ctx context.Context, p path.Path, value types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make(map[string]attr.Value, len(value.Elements()))
+
+	for key, element := range value.Elements() {
+		normalizedKey := m.normalizeFunc(key)
+
+		if _, ok := elements[normalizedKey]; ok {
+			diags.AddAttributeError(
+				p,
+				"Duplicate Normalized Map Key",
+				fmt.Sprintf("Multiple map keys normalize to the same key %q. Map keys must be unique after normalization.", normalizedKey),
+			)
+
+			return value, diags
+		}
+
+		elements[normalizedKey] = element
+	}
+
+	normalizedValue, normalizedValueDiags := types.MapValue(value.ElementType(ctx), elements)
+
+	diags.Append(normalizedValueDiags...)
+
+	return normalizedValue, diags
+}
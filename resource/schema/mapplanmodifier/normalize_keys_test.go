@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeKeysModifierPlanModifyMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.MapRequest
+		expected *planmodifier.MapResponse
+	}{
+		"null-plan": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapNull(types.StringType),
+				PlanValue:   types.MapNull(types.StringType),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapNull(types.StringType),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"Key": types.StringValue("test")}),
+				PlanValue:   types.MapUnknown(types.StringType),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(types.StringType),
+			},
+		},
+		"configured-key-case-change": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{" Key": types.StringValue("test")}),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{" Key": types.StringValue("test")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{" Key": types.StringValue("test")}),
+			},
+		},
+		"unconfigured-key-case-change": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{" Key": types.StringValue("test")}),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+		},
+		"configured-value-change": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"Key": types.StringValue("other")}),
+				ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{"Key": types.StringValue("other")}),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"Key": types.StringValue("other")}),
+			},
+		},
+		"unconfigured-value-change": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+				PlanValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"Key": types.StringValue("other")}),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("other")}),
+			},
+		},
+		"duplicate-normalized-key": {
+			request: planmodifier.MapRequest{
+				Path:       path.Root("test"),
+				StateValue: types.MapNull(types.StringType),
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("test"),
+					"KEY": types.StringValue("test"),
+				}),
+				ConfigValue: types.MapNull(types.StringType),
+			},
+			expected: &planmodifier.MapResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Duplicate Normalized Map Key",
+						`Multiple map keys normalize to the same key "key". Map keys must be unique after normalization.`,
+					),
+				},
+				PlanValue: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("test"),
+					"KEY": types.StringValue("test"),
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.MapResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			mapplanmodifier.NormalizeKeys().PlanModifyMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
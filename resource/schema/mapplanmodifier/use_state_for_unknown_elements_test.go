@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseStateForUnknownElementsModifierPlanModifyMap(t *testing.T) {
+	t.Parallel()
+
+	testElementType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   types.StringType,
+			"name": types.StringType,
+		},
+	}
+
+	testElement := func(id types.String, name types.String) attr.Value {
+		return types.ObjectValueMust(
+			testElementType.AttrTypes,
+			map[string]attr.Value{
+				"id":   id,
+				"name": name,
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.MapRequest
+		expected *planmodifier.MapResponse
+	}{
+		"null-state": {
+			request: planmodifier.MapRequest{
+				StateValue:  types.MapNull(testElementType),
+				PlanValue:   types.MapUnknown(testElementType),
+				ConfigValue: types.MapNull(testElementType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(testElementType),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.MapRequest{
+				StateValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue:   types.MapUnknown(testElementType),
+				ConfigValue: types.MapNull(testElementType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.MapRequest{
+				StateValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue:   types.MapUnknown(testElementType),
+				ConfigValue: types.MapUnknown(testElementType),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapUnknown(testElementType),
+			},
+		},
+		"unknown-element-attributes": {
+			request: planmodifier.MapRequest{
+				StateValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringValue("id-one"), types.StringValue("one")),
+						"two": testElement(types.StringValue("id-two"), types.StringValue("two")),
+					},
+				),
+				PlanValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringUnknown(), types.StringValue("one")),
+						"two": testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+				ConfigValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringNull(), types.StringValue("one")),
+						"two": testElement(types.StringNull(), types.StringValue("two")),
+					},
+				),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringValue("id-one"), types.StringValue("one")),
+						"two": testElement(types.StringValue("id-two"), types.StringValue("two")),
+					},
+				),
+			},
+		},
+		"unknown-element-attributes-new-element": {
+			request: planmodifier.MapRequest{
+				StateValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringUnknown(), types.StringValue("one")),
+						"two": testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+				ConfigValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringNull(), types.StringValue("one")),
+						"two": testElement(types.StringNull(), types.StringValue("two")),
+					},
+				),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringValue("id-one"), types.StringValue("one")),
+						"two": testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+			},
+		},
+		"unknown-element-attributes-unknown-config-attribute": {
+			request: planmodifier.MapRequest{
+				StateValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+				ConfigValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+			},
+			expected: &planmodifier.MapResponse{
+				PlanValue: types.MapValueMust(
+					testElementType,
+					map[string]attr.Value{
+						"one": testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.MapResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			mapplanmodifier.UseStateForUnknownElements().PlanModifyMap(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numberdefault_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultNumber(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Number{
+		DefaultNumberMethod: func(_ context.Context, _ defaults.NumberRequest, resp *defaults.NumberResponse) {
+			resp.PlanValue = types.NumberNull()
+		},
+	}
+
+	errorDefault := testdefaults.Number{
+		DefaultNumberMethod: func(_ context.Context, _ defaults.NumberRequest, resp *defaults.NumberResponse) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.NumberNull()
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Number
+		expected      *defaults.NumberResponse
+	}{
+		"first": {
+			defaultValues: []defaults.Number{
+				numberdefault.StaticBigFloat(big.NewFloat(1.2)),
+				nullDefault,
+			},
+			expected: &defaults.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Number{
+				nullDefault,
+				numberdefault.StaticBigFloat(big.NewFloat(1.2)),
+			},
+			expected: &defaults.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Number{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.NumberResponse{
+				PlanValue: types.NumberNull(),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Number{
+				errorDefault,
+				numberdefault.StaticBigFloat(big.NewFloat(1.2)),
+			},
+			expected: &defaults.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.NumberNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.NumberResponse{}
+
+			numberdefault.FirstNonNull(testCase.defaultValues...).DefaultNumber(context.Background(), defaults.NumberRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
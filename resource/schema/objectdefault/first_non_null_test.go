@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package objectdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultObject(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Object{
+		DefaultObjectMethod: func(_ context.Context, _ defaults.ObjectRequest, resp *defaults.ObjectResponse) {
+			resp.PlanValue = types.ObjectNull(map[string]attr.Type{"key": types.StringType})
+		},
+	}
+
+	errorDefault := testdefaults.Object{
+		DefaultObjectMethod: func(_ context.Context, _ defaults.ObjectRequest, resp *defaults.ObjectResponse) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.ObjectNull(map[string]attr.Type{"key": types.StringType})
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Object
+		expected      *defaults.ObjectResponse
+	}{
+		"first": {
+			defaultValues: []defaults.Object{
+				objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{"key": types.StringType}, map[string]attr.Value{"key": types.StringValue("test")})),
+				nullDefault,
+			},
+			expected: &defaults.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"key": types.StringType}, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Object{
+				nullDefault,
+				objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{"key": types.StringType}, map[string]attr.Value{"key": types.StringValue("test")})),
+			},
+			expected: &defaults.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"key": types.StringType}, map[string]attr.Value{"key": types.StringValue("test")}),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Object{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.ObjectResponse{
+				PlanValue: types.ObjectNull(map[string]attr.Type{"key": types.StringType}),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Object{
+				errorDefault,
+				objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{"key": types.StringType}, map[string]attr.Value{"key": types.StringValue("test")})),
+			},
+			expected: &defaults.ObjectResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.ObjectNull(map[string]attr.Type{"key": types.StringType}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.ObjectResponse{}
+
+			objectdefault.FirstNonNull(testCase.defaultValues...).DefaultObject(context.Background(), defaults.ObjectRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package objectplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNullIfDisabledModifierPlanModifyObject(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testPlan := func(enabled tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"name":    tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"enabled": enabled,
+					"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				},
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		expression path.Expression
+		request    planmodifier.ObjectRequest
+		expected   *planmodifier.ObjectResponse
+	}{
+		"known-plan": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"attr": types.StringType}),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+			},
+		},
+		"unknown-config": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+			},
+		},
+		"enabled-true": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"attr": types.StringType}),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, true)),
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+			},
+		},
+		"enabled-false": {
+			expression: path.MatchRoot("enabled"),
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"attr": types.StringType}),
+				Path:        path.Root("test"),
+				Plan:        testPlan(tftypes.NewValue(tftypes.Bool, false)),
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"attr": types.StringType}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectNull(map[string]attr.Type{"attr": types.StringType}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ObjectResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			objectplanmodifier.NullIfDisabled(testCase.expression).PlanModifyObject(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package objectplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// RequiresReplaceIfPathChanged returns a plan modifier that requires resource
+// replacement if:
+//
+//   - The resource is planned for update.
+//   - The plan and state values of any attribute matched by the expression,
+//     which is relative to this attribute, are not equal.
+//
+// Use this when changes to a different attribute, such as a sibling or a
+// parent attribute, require replacement. The replacement is reported on the
+// changed attribute paths, as Terraform only replaces the resource when a
+// path requiring replacement has changed. Use RequiresReplaceIfPathChangedIf
+// if the resource replacement should check provider-defined conditional
+// logic.
+func RequiresReplaceIfPathChanged(expression path.Expression) planmodifier.Object {
+	return RequiresReplaceIfPathChangedIf(
+		expression,
+		func(_ context.Context, _ planmodifier.ObjectRequest, resp *RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = true
+		},
+		fmt.Sprintf("If the value of %s changes, Terraform will destroy and recreate the resource.", expression),
+		fmt.Sprintf("If the value of `%s` changes, Terraform will destroy and recreate the resource.", expression),
+	)
+}
+
+// RequiresReplaceIfPathChangedIf returns a plan modifier that conditionally
+// requires resource replacement if:
+//
+//   - The resource is planned for update.
+//   - The plan and state values of any attribute matched by the expression,
+//     which is relative to this attribute, are not equal.
+//   - The given function returns true. The function receives the full
+//     resource configuration, plan, and prior state in the request.
+//
+// The replacement is reported on the changed attribute paths, as Terraform
+// only replaces the resource when a path requiring replacement has changed.
+func RequiresReplaceIfPathChangedIf(expression path.Expression, f RequiresReplaceIfFunc, description, markdownDescription string) planmodifier.Object {
+	return requiresReplaceIfPathChangedModifier{
+		expression:          expression,
+		ifFunc:              f,
+		description:         description,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// requiresReplaceIfPathChangedModifier is a plan modifier that sets
+// RequiresReplacePaths to the changed paths matching an expression if a given
+// function is true.
+type requiresReplaceIfPathChangedModifier struct {
+	expression          path.Expression
+	ifFunc              RequiresReplaceIfFunc
+	description         string
+	markdownDescription string
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m requiresReplaceIfPathChangedModifier) Description(_ context.Context) string {
+	return m.description
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m requiresReplaceIfPathChangedModifier) MarkdownDescription(_ context.Context) string {
+	return m.markdownDescription
+}
+
+// PlanModifyObject implements the plan modification logic.
+func (m requiresReplaceIfPathChangedModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	// Do not replace on resource creation.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Do not replace on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	changedPaths, diags := fwplanmodifier.ChangedPaths(ctx, req.Path, req.PathExpression.Merge(m.expression), req.Plan, req.State)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Do not replace if no matched attribute has changed.
+	if resp.Diagnostics.HasError() || len(changedPaths) == 0 {
+		return
+	}
+
+	ifFuncResp := &RequiresReplaceIfFuncResponse{}
+
+	m.ifFunc(ctx, req, ifFuncResp)
+
+	resp.Diagnostics.Append(ifFuncResp.Diagnostics...)
+
+	if ifFuncResp.RequiresReplace {
+		resp.RequiresReplacePaths.Append(changedPaths...)
+	}
+}
@@ -53,3 +53,9 @@ func (m useStateForUnknownModifier) PlanModifyObject(_ context.Context, req plan
 
 	resp.PlanValue = req.StateValue
 }
+
+// UsesStateForUnknown returns true as the plan modifier copies the prior
+// state value into an unknown planned value.
+func (m useStateForUnknownModifier) UsesStateForUnknown() bool {
+	return true
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// OptionalComputedAttribute is the set of attribute types supported by the
+// OptionalComputed function.
+type OptionalComputedAttribute interface {
+	BoolAttribute | DynamicAttribute | Float32Attribute | Float64Attribute |
+		Int32Attribute | Int64Attribute | ListAttribute | ListNestedAttribute |
+		MapAttribute | MapNestedAttribute | NumberAttribute | ObjectAttribute |
+		SetAttribute | SetNestedAttribute | SingleNestedAttribute |
+		StringAttribute
+}
+
+// OptionalComputed returns the attribute configured for the common pattern of
+// a value which practitioners may configure, otherwise the value is the
+// Default, if set, or is determined by the provider and does not change after
+// creation. It sets Optional and Computed to true and adds the type-specific
+// UseStateForUnknown plan modifier after any existing plan modifiers, unless
+// it is already present. All other fields, such as Default and CustomType,
+// are preserved.
+//
+// The attribute must not set Required, otherwise schema validation returns an
+// error diagnostic. This function does not add semantic equality, so use a
+// CustomType which implements it, if practitioner configured values may
+// differ from the values returned by the provider, such as differently
+// formatted JSON.
+//
+//	"name": schema.OptionalComputed(schema.StringAttribute{
+//		Default: stringdefault.StaticString("example"),
+//	}),
+func OptionalComputed[A OptionalComputedAttribute](attribute A) A {
+	var result any
+
+	switch a := any(attribute).(type) {
+	case BoolAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, boolplanmodifier.UseStateForUnknown())
+		result = a
+	case DynamicAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, dynamicplanmodifier.UseStateForUnknown())
+		result = a
+	case Float32Attribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, float32planmodifier.UseStateForUnknown())
+		result = a
+	case Float64Attribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, float64planmodifier.UseStateForUnknown())
+		result = a
+	case Int32Attribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, int32planmodifier.UseStateForUnknown())
+		result = a
+	case Int64Attribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, int64planmodifier.UseStateForUnknown())
+		result = a
+	case ListAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, listplanmodifier.UseStateForUnknown())
+		result = a
+	case ListNestedAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, listplanmodifier.UseStateForUnknown())
+		result = a
+	case MapAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, mapplanmodifier.UseStateForUnknown())
+		result = a
+	case MapNestedAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, mapplanmodifier.UseStateForUnknown())
+		result = a
+	case NumberAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, numberplanmodifier.UseStateForUnknown())
+		result = a
+	case ObjectAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, objectplanmodifier.UseStateForUnknown())
+		result = a
+	case SetAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, setplanmodifier.UseStateForUnknown())
+		result = a
+	case SetNestedAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, setplanmodifier.UseStateForUnknown())
+		result = a
+	case SingleNestedAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, objectplanmodifier.UseStateForUnknown())
+		result = a
+	case StringAttribute:
+		a.Optional, a.Computed = true, true
+		a.PlanModifiers = withPlanModifier(a.PlanModifiers, stringplanmodifier.UseStateForUnknown())
+		result = a
+	}
+
+	//nolint:forcetypeassert // Type assertion is guaranteed by the above type switch
+	return result.(A)
+}
+
+// withPlanModifier returns a new slice of the plan modifiers with the given
+// plan modifier appended, unless an equal plan modifier is already present.
+// Plan modifiers are only equal if both their type and configuration are
+// equal, so differently configured plan modifiers of the same type are kept.
+func withPlanModifier[M any](planModifiers []M, planModifier M) []M {
+	for _, existing := range planModifiers {
+		if reflect.DeepEqual(existing, planModifier) {
+			return planModifiers
+		}
+	}
+
+	result := make([]M, 0, len(planModifiers)+1)
+	result = append(result, planModifiers...)
+
+	return append(result, planModifier)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOptionalComputed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute func() any
+		expected  any
+	}{
+		"BoolAttribute": {
+			attribute: func() any {
+				return schema.OptionalComputed(schema.BoolAttribute{})
+			},
+			expected: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		"ListNestedAttribute": {
+			attribute: func() any {
+				return schema.OptionalComputed(schema.ListNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"testattr": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+				})
+			},
+			expected: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"testattr": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		"StringAttribute": {
+			attribute: func() any {
+				return schema.OptionalComputed(schema.StringAttribute{})
+			},
+			expected: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		"StringAttribute-existing-fields": {
+			attribute: func() any {
+				return schema.OptionalComputed(schema.StringAttribute{
+					CustomType:  types.StringType,
+					Default:     testdefaults.String{},
+					Description: "test description",
+					PlanModifiers: []planmodifier.String{
+						testplanmodifier.String{},
+					},
+					Sensitive: true,
+				})
+			},
+			expected: schema.StringAttribute{
+				CustomType:  types.StringType,
+				Default:     testdefaults.String{},
+				Description: "test description",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{},
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Sensitive: true,
+			},
+		},
+		"StringAttribute-existing-UseStateForUnknown": {
+			attribute: func() any {
+				return schema.OptionalComputed(schema.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+						testplanmodifier.String{},
+					},
+				})
+			},
+			expected: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					testplanmodifier.String{},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOptionalComputed_Required(t *testing.T) {
+	t.Parallel()
+
+	attribute := schema.OptionalComputed(schema.StringAttribute{
+		Required: true,
+	})
+
+	expected := &fwschema.ValidateImplementationResponse{
+		Diagnostics: diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Schema Using Required Attribute With Optional Or Computed",
+				"Attribute \"test\" must not be optional or computed when required. "+
+					"This is an issue with the provider and should be reported to the provider developers.",
+			),
+		},
+	}
+
+	got := &fwschema.ValidateImplementationResponse{}
+	req := fwschema.ValidateImplementationRequest{
+		Name: "test",
+		Path: path.Root("test"),
+	}
+
+	attribute.ValidateImplementation(context.Background(), req, got)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -72,6 +72,13 @@ type Int32Response struct {
 	// requires replacement of the whole resource.
 	RequiresReplace bool
 
+	// RequiresReplacePaths are paths of other attributes whose changes
+	// require replacement of the whole resource. Terraform only replaces
+	// the resource when the value at one of the paths has changed, so use
+	// this instead of RequiresReplace when replacement depends on changes
+	// to a different attribute.
+	RequiresReplacePaths path.Paths
+
 	// Private is the private state resource data following the PlanModifyInt32 operation.
 	// This field is pre-populated from Int32Request.Private and
 	// can be modified during the resource's PlanModifyInt32 operation.
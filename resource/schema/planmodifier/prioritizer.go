@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifier
+
+// Prioritizer is an optional interface on plan modifiers of any type which
+// declares the execution order of the plan modifier within the plan modifiers
+// of an attribute, block, or nested object.
+//
+// Plan modifiers with lower priorities are executed first. Plan modifiers
+// which do not implement this interface have a priority of 0, including all
+// framework-defined plan modifiers. Plan modifiers with equal priorities are
+// executed in the order they are defined in the schema.
+//
+// All schema-based plan modifiers are executed before the resource-level
+// ModifyPlan method, unless the resource.ResourceBehavior type
+// ModifyPlanBeforeSchema field is enabled.
+type Prioritizer interface {
+	// Priority should return the execution priority of the plan modifier.
+	// Lower priorities are executed first.
+	Priority() int
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -172,6 +173,8 @@ func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 		diags.Append(fwschema.ValidateBlockImplementation(ctx, block, req)...)
 	}
 
+	diags.Append(fwschemadata.ValidateReferences(ctx, s)...)
+
 	return diags
 }
 
@@ -199,6 +202,39 @@ func schemaBlocks(blocks map[string]Block) map[string]fwschema.Block {
 
 // nonComputedAttributeWithDefaultDiag returns a diagnostic for use when a non-computed
 // attribute is using a default value.
+func nonComputedAttributeWithPlanKnownObjectDiag(path path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Schema Using Attribute PlanKnownObject For Non-Computed Attribute",
+		fmt.Sprintf("Attribute %q must be computed when using PlanKnownObject. ", path.String())+
+			"This is an issue with the provider and should be reported to the provider developers.",
+	)
+}
+
+func invalidStateTransformAttributeDiag(path path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Schema Using State Transform For Invalid Attribute",
+		fmt.Sprintf("Attribute %q must be sensitive and computed-only (not required or optional) when using state transform. ", path.String())+
+			"This is an issue with the provider and should be reported to the provider developers.",
+	)
+}
+
+func requiredAttributeWithOptionalOrComputedDiag(path path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Schema Using Required Attribute With Optional Or Computed",
+		fmt.Sprintf("Attribute %q must not be optional or computed when required. ", path.String())+
+			"This is an issue with the provider and should be reported to the provider developers.",
+	)
+}
+
 func nonComputedAttributeWithDefaultDiag(path path.Path) diag.Diagnostic {
 	// The diagnostic path is intentionally omitted as it is invalid in this
 	// context. Diagnostic paths are intended to be mapped to actual data,
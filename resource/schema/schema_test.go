@@ -1348,6 +1348,83 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-references-valid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:   true,
+						References: path.MatchRoot("name"),
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+		"attribute-references-invalid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:   true,
+						References: path.MatchRoot("missing"),
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"id\" has a References field expression of \"missing\", which does not match any attribute in the schema. "+
+						"The References field must match an attribute in the schema.",
+				),
+			},
+		},
+		"nested-attribute-references-valid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list_nested": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed:   true,
+									References: path.MatchRelative().AtParent().AtName("name"),
+								},
+								"name": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+						Required: true,
+					},
+				},
+			},
+		},
+		"nested-block-attribute-references-invalid": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"list_nested_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed:   true,
+									References: path.MatchRelative().AtParent().AtName("missing"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"list_nested_block[*].id\" has a References field expression of \"<.missing\", which does not match any attribute in the schema. "+
+						"The References field must match an attribute in the schema.",
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
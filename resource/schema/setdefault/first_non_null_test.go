@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultSet(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.Set{
+		DefaultSetMethod: func(_ context.Context, _ defaults.SetRequest, resp *defaults.SetResponse) {
+			resp.PlanValue = types.SetNull(types.StringType)
+		},
+	}
+
+	errorDefault := testdefaults.Set{
+		DefaultSetMethod: func(_ context.Context, _ defaults.SetRequest, resp *defaults.SetResponse) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.SetNull(types.StringType)
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues []defaults.Set
+		expected      *defaults.SetResponse
+	}{
+		"first": {
+			defaultValues: []defaults.Set{
+				setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")})),
+				nullDefault,
+			},
+			expected: &defaults.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.Set{
+				nullDefault,
+				setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")})),
+			},
+			expected: &defaults.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.Set{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.SetResponse{
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.Set{
+				errorDefault,
+				setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")})),
+			},
+			expected: &defaults.SetResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.SetResponse{}
+
+			setdefault.FirstNonNull(testCase.defaultValues...).DefaultSet(context.Background(), defaults.SetRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
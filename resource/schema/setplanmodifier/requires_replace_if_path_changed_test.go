@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceIfPathChangedModifierPlanModifySet(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"other": schema.StringAttribute{
+				Optional: true,
+			},
+			"testattr": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	testValue := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")})
+
+	testData := func(other interface{}) tftypes.Value {
+		tfValue, err := testValue.ToTerraformValue(context.Background())
+
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+
+		return tftypes.NewValue(
+			testSchema.Type().TerraformType(context.Background()),
+			map[string]tftypes.Value{
+				"other":    tftypes.NewValue(tftypes.String, other),
+				"testattr": tfValue,
+			},
+		)
+	}
+
+	nullData := tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), nil)
+
+	testExpression := path.MatchRelative().AtParent().AtName("other")
+
+	testCases := map[string]struct {
+		modifier planmodifier.Set
+		request  planmodifier.SetRequest
+		expected *planmodifier.SetResponse
+	}{
+		"state-null": {
+			// resource creation
+			modifier: setplanmodifier.RequiresReplaceIfPathChanged(testExpression),
+			request: planmodifier.SetRequest{
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           tfsdk.Plan{Schema: testSchema, Raw: testData("new")},
+				PlanValue:      testValue,
+				State:          tfsdk.State{Schema: testSchema, Raw: nullData},
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: testValue,
+			},
+		},
+		"plan-null": {
+			// resource destroy
+			modifier: setplanmodifier.RequiresReplaceIfPathChanged(testExpression),
+			request: planmodifier.SetRequest{
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           tfsdk.Plan{Schema: testSchema, Raw: nullData},
+				PlanValue:      testValue,
+				State:          tfsdk.State{Schema: testSchema, Raw: testData("old")},
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: testValue,
+			},
+		},
+		"path-unchanged": {
+			modifier: setplanmodifier.RequiresReplaceIfPathChanged(testExpression),
+			request: planmodifier.SetRequest{
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           tfsdk.Plan{Schema: testSchema, Raw: testData("old")},
+				PlanValue:      testValue,
+				State:          tfsdk.State{Schema: testSchema, Raw: testData("old")},
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: testValue,
+			},
+		},
+		"path-changed": {
+			modifier: setplanmodifier.RequiresReplaceIfPathChanged(testExpression),
+			request: planmodifier.SetRequest{
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           tfsdk.Plan{Schema: testSchema, Raw: testData("new")},
+				PlanValue:      testValue,
+				State:          tfsdk.State{Schema: testSchema, Raw: testData("old")},
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue:            testValue,
+				RequiresReplacePaths: path.Paths{path.Root("other")},
+			},
+		},
+		"path-changed-if-false": {
+			modifier: setplanmodifier.RequiresReplaceIfPathChangedIf(
+				testExpression,
+				func(_ context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+					var other types.String
+
+					resp.Diagnostics.Append(req.Config.GetAttribute(context.Background(), path.Root("other"), &other)...)
+
+					resp.RequiresReplace = other.ValueString() != "new"
+				},
+				"test",
+				"test",
+			),
+			request: planmodifier.SetRequest{
+				Config:         tfsdk.Config{Schema: testSchema, Raw: testData("new")},
+				Path:           path.Root("testattr"),
+				PathExpression: path.MatchRoot("testattr"),
+				Plan:           tfsdk.Plan{Schema: testSchema, Raw: testData("new")},
+				PlanValue:      testValue,
+				State:          tfsdk.State{Schema: testSchema, Raw: testData("old")},
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: testValue,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.SetResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifySet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
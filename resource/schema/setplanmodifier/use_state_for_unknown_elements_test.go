@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseStateForUnknownElementsModifierPlanModifySet(t *testing.T) {
+	t.Parallel()
+
+	testElementType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   types.StringType,
+			"name": types.StringType,
+		},
+	}
+
+	testElement := func(id types.String, name types.String) attr.Value {
+		return types.ObjectValueMust(
+			testElementType.AttrTypes,
+			map[string]attr.Value{
+				"id":   id,
+				"name": name,
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.SetRequest
+		expected *planmodifier.SetResponse
+	}{
+		"null-state": {
+			request: planmodifier.SetRequest{
+				StateValue:  types.SetNull(testElementType),
+				PlanValue:   types.SetUnknown(testElementType),
+				ConfigValue: types.SetNull(testElementType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(testElementType),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.SetRequest{
+				StateValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue:   types.SetUnknown(testElementType),
+				ConfigValue: types.SetNull(testElementType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+			},
+		},
+		"unknown-config": {
+			request: planmodifier.SetRequest{
+				StateValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue:   types.SetUnknown(testElementType),
+				ConfigValue: types.SetUnknown(testElementType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(testElementType),
+			},
+		},
+		"unknown-element-attributes": {
+			request: planmodifier.SetRequest{
+				StateValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+						testElement(types.StringValue("id-two"), types.StringValue("two")),
+					},
+				),
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+						testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+				ConfigValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringNull(), types.StringValue("one")),
+						testElement(types.StringNull(), types.StringValue("two")),
+					},
+				),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+						testElement(types.StringValue("id-two"), types.StringValue("two")),
+					},
+				),
+			},
+		},
+		"unknown-element-attributes-new-element": {
+			request: planmodifier.SetRequest{
+				StateValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+						testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+				ConfigValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringNull(), types.StringValue("one")),
+						testElement(types.StringNull(), types.StringValue("two")),
+					},
+				),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+						testElement(types.StringUnknown(), types.StringValue("two")),
+					},
+				),
+			},
+		},
+		"unknown-element-attributes-ambiguous": {
+			request: planmodifier.SetRequest{
+				StateValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+						testElement(types.StringValue("id-two"), types.StringValue("one")),
+					},
+				),
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+				ConfigValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringNull(), types.StringValue("one")),
+					},
+				),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+			},
+		},
+		"unknown-element-attributes-unknown-config-element": {
+			request: planmodifier.SetRequest{
+				StateValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringValue("id-one"), types.StringValue("one")),
+					},
+				),
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+				ConfigValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringNull(), types.StringUnknown()),
+					},
+				),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(
+					testElementType,
+					[]attr.Value{
+						testElement(types.StringUnknown(), types.StringValue("one")),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.SetResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			setplanmodifier.UseStateForUnknownElements().PlanModifySet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -22,8 +23,10 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ NestedAttribute                              = SingleNestedAttribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = SingleNestedAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = SingleNestedAttribute{}
 	_ fwschema.AttributeWithObjectDefaultValue     = SingleNestedAttribute{}
+	_ fwschema.NestedAttributeWithPlanKnownObject  = SingleNestedAttribute{}
 	_ fwxschema.AttributeWithObjectPlanModifiers   = SingleNestedAttribute{}
 	_ fwxschema.AttributeWithObjectValidators      = SingleNestedAttribute{}
 )
@@ -131,6 +134,15 @@ type SingleNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationReplacement defines a path expression, relative to this
+	// attribute, to the attribute which replaces this attribute. When
+	// DeprecationMessage is set and practitioner configurations use this
+	// attribute, the "Attribute Deprecated" warning diagnostic includes the
+	// resolved replacement path and is a diag.AttributeDeprecationDiagnostic,
+	// so tooling can suggest configuration migrations. This field has no
+	// effect if DeprecationMessage is not set.
+	DeprecationReplacement path.Expression
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -167,6 +179,19 @@ type SingleNestedAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Object
+
+	// PlanKnownObject, when true, plans a known object instead of an
+	// unknown object when the attribute is computed and has no configuration
+	// value. Each nested attribute of the planned object is set to its
+	// Default, if defined, an unknown value if computed, or a null value
+	// otherwise. Nested attribute plan modifiers are then called, such as
+	// stringplanmodifier.UseStateForUnknown to preserve individual prior
+	// state values. Computed must be true. Default takes precedence, if set.
+	//
+	// When false, which is the default, the entire object is planned as
+	// unknown and nested attribute plan modifiers are not called, since an
+	// unknown object has no nested values.
+	PlanKnownObject bool
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -209,6 +234,11 @@ func (a SingleNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement returns the DeprecationReplacement field value.
+func (a SingleNestedAttribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
 // GetDescription returns the Description field value.
 func (a SingleNestedAttribute) GetDescription() string {
 	return a.Description
@@ -271,6 +301,11 @@ func (a SingleNestedAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsPlanKnownObject returns the PlanKnownObject field value.
+func (a SingleNestedAttribute) IsPlanKnownObject() bool {
+	return a.PlanKnownObject
+}
+
 // ObjectDefaultValue returns the Default field value.
 func (a SingleNestedAttribute) ObjectDefaultValue() defaults.Object {
 	return a.Default
@@ -291,6 +326,14 @@ func (a SingleNestedAttribute) ObjectValidators() []validator.Object {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a SingleNestedAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsRequired() && (a.IsOptional() || a.IsComputed()) {
+		resp.Diagnostics.Append(requiredAttributeWithOptionalOrComputedDiag(req.Path))
+	}
+
+	if !a.IsComputed() && a.IsPlanKnownObject() {
+		resp.Diagnostics.Append(nonComputedAttributeWithPlanKnownObjectDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.ObjectDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
@@ -538,6 +538,44 @@ func TestSingleNestedAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestSingleNestedAttributeIsPlanKnownObject(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.SingleNestedAttribute
+		expected  bool
+	}{
+		"not-plan-known-object": {
+			attribute: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{},
+				},
+			},
+			expected: false,
+		},
+		"plan-known-object": {
+			attribute: schema.SingleNestedAttribute{
+				PlanKnownObject: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsPlanKnownObject()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSingleNestedAttributeObjectDefaultValue(t *testing.T) {
 	t.Parallel()
 
@@ -702,6 +740,30 @@ func TestSingleNestedAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"required-with-computed": {
+			attribute: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Computed: true,
+				Required: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Required Attribute With Optional Or Computed",
+						"Attribute \"test\" must not be optional or computed when required. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"default-without-computed": {
 			attribute: schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -734,6 +796,46 @@ func TestSingleNestedAttributeValidateImplementation(t *testing.T) {
 				},
 			},
 		},
+		"plan-known-object-without-computed": {
+			attribute: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Optional:        true,
+				PlanKnownObject: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Attribute PlanKnownObject For Non-Computed Attribute",
+						"Attribute \"test\" must be computed when using PlanKnownObject. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+		"plan-known-object-with-computed": {
+			attribute: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Computed:        true,
+				PlanKnownObject: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
 		"default-with-computed": {
 			attribute: schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,7 +22,11 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = StringAttribute{}
+	_ fwschema.AttributeWithDeprecationReplacement = StringAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = StringAttribute{}
+	_ fwschema.AttributeWithReferences             = StringAttribute{}
+	_ fwschema.AttributeWithNullEmptyEquivalent    = StringAttribute{}
+	_ fwschema.AttributeWithStateTransform         = StringAttribute{}
 	_ fwschema.AttributeWithStringDefaultValue     = StringAttribute{}
 	_ fwxschema.AttributeWithStringPlanModifiers   = StringAttribute{}
 	_ fwxschema.AttributeWithStringValidators      = StringAttribute{}
@@ -116,6 +121,15 @@ type StringAttribute struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationReplacement defines a path expression, relative to this
+	// attribute, to the attribute which replaces this attribute. When
+	// DeprecationMessage is set and practitioner configurations use this
+	// attribute, the "Attribute Deprecated" warning diagnostic includes the
+	// resolved replacement path and is a diag.AttributeDeprecationDiagnostic,
+	// so tooling can suggest configuration migrations. This field has no
+	// effect if DeprecationMessage is not set.
+	DeprecationReplacement path.Expression
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -152,6 +166,47 @@ type StringAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.String
+
+	// NullEmptyEquivalent, when true, declares that null and empty string
+	// values are equivalent for this attribute. This is typically used when
+	// the remote system does not differentiate between an unset value and an
+	// empty string.
+	//
+	// After the Create, Read, and Update methods, the framework keeps the
+	// prior value (the planned value for Create and Update, otherwise the
+	// prior state value) when the new state value only differs by being null
+	// instead of an empty string, or vice versa. This prevents Terraform
+	// errors about inconsistent results after apply and prevents differences
+	// from being reported after refresh.
+	//
+	// Terraform requires planned values to match configured values, so a
+	// configuration change between null and an empty string is still planned
+	// as a difference.
+	NullEmptyEquivalent bool
+
+	// References defines a path expression, relative to this attribute, to
+	// another attribute whose value this attribute must match. The framework
+	// validates that the expression matches an attribute in the schema and,
+	// after planning, returns an error diagnostic if the planned value of
+	// this attribute differs from the planned value of any matched attribute.
+	// Null and unknown planned values are not compared.
+	//
+	// This is typically used for computed attributes which are derived from
+	// another attribute, such as an identifier echoed by the remote system.
+	References path.Expression
+
+	// StateTransform indicates that the provider-defined state transformer,
+	// registered via the provider.ProviderWithStateTransformer interface,
+	// transforms the value before it is saved in state, such as by
+	// encrypting or tokenizing it, and reverses the transformation when it
+	// is read from state. Resource logic only receives the decoded value.
+	//
+	// Terraform requires planned values to match configured values, so the
+	// attribute must be Sensitive and Computed, but not Required or
+	// Optional. Values are not transformed by the UpgradeState and
+	// MoveState resource methods or if the provider does not implement
+	// provider.ProviderWithStateTransformer.
+	StateTransform bool
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -175,6 +230,11 @@ func (a StringAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
 }
 
+// GetDeprecationReplacement returns the DeprecationReplacement field value.
+func (a StringAttribute) GetDeprecationReplacement() path.Expression {
+	return a.DeprecationReplacement
+}
+
 // GetDescription returns the Description field value.
 func (a StringAttribute) GetDescription() string {
 	return a.Description
@@ -185,6 +245,11 @@ func (a StringAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetReferences returns the References field value.
+func (a StringAttribute) GetReferences() path.Expression {
+	return a.References
+}
+
 // GetType returns types.StringType or the CustomType field value if defined.
 func (a StringAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -194,11 +259,21 @@ func (a StringAttribute) GetType() attr.Type {
 	return types.StringType
 }
 
+// HasStateTransform returns the StateTransform field value.
+func (a StringAttribute) HasStateTransform() bool {
+	return a.StateTransform
+}
+
 // IsComputed returns the Computed field value.
 func (a StringAttribute) IsComputed() bool {
 	return a.Computed
 }
 
+// IsNullEmptyEquivalent returns the NullEmptyEquivalent field value.
+func (a StringAttribute) IsNullEmptyEquivalent() bool {
+	return a.NullEmptyEquivalent
+}
+
 // IsOptional returns the Optional field value.
 func (a StringAttribute) IsOptional() bool {
 	return a.Optional
@@ -234,7 +309,15 @@ func (a StringAttribute) StringValidators() []validator.String {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a StringAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsRequired() && (a.IsOptional() || a.IsComputed()) {
+		resp.Diagnostics.Append(requiredAttributeWithOptionalOrComputedDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.StringDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
+
+	if a.HasStateTransform() && (!a.IsSensitive() || !a.IsComputed() || a.IsOptional() || a.IsRequired()) {
+		resp.Diagnostics.Append(invalidStateTransformAttributeDiag(req.Path))
+	}
 }
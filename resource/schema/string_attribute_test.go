@@ -261,6 +261,40 @@ func TestStringAttributeGetType(t *testing.T) {
 	}
 }
 
+func TestStringAttributeHasStateTransform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.StringAttribute
+		expected  bool
+	}{
+		"not-statetransform": {
+			attribute: schema.StringAttribute{},
+			expected:  false,
+		},
+		"statetransform": {
+			attribute: schema.StringAttribute{
+				StateTransform: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.HasStateTransform()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestStringAttributeIsComputed(t *testing.T) {
 	t.Parallel()
 
@@ -295,6 +329,40 @@ func TestStringAttributeIsComputed(t *testing.T) {
 	}
 }
 
+func TestStringAttributeIsNullEmptyEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.StringAttribute
+		expected  bool
+	}{
+		"not-null-empty-equivalent": {
+			attribute: schema.StringAttribute{},
+			expected:  false,
+		},
+		"null-empty-equivalent": {
+			attribute: schema.StringAttribute{
+				NullEmptyEquivalent: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsNullEmptyEquivalent()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestStringAttributeIsOptional(t *testing.T) {
 	t.Parallel()
 
@@ -530,6 +598,25 @@ func TestStringAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"required-with-computed": {
+			attribute: schema.StringAttribute{
+				Computed: true,
+				Required: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Required Attribute With Optional Or Computed",
+						"Attribute \"test\" must not be optional or computed when required. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"default-without-computed": {
 			attribute: schema.StringAttribute{
 				Default: stringdefault.StaticString("test"),
@@ -559,6 +646,58 @@ func TestStringAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"statetransform-computed-sensitive": {
+			attribute: schema.StringAttribute{
+				Computed:       true,
+				Sensitive:      true,
+				StateTransform: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"statetransform-optional-computed": {
+			attribute: schema.StringAttribute{
+				Computed:       true,
+				Optional:       true,
+				Sensitive:      true,
+				StateTransform: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using State Transform For Invalid Attribute",
+						"Attribute \"test\" must be sensitive and computed-only (not required or optional) when using state transform. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+		"statetransform-without-sensitive": {
+			attribute: schema.StringAttribute{
+				Computed:       true,
+				StateTransform: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using State Transform For Invalid Attribute",
+						"Attribute \"test\" must be sensitive and computed-only (not required or optional) when using state transform. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
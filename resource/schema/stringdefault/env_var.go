@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringdefault
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EnvVar returns an environment variable value default handler.
+//
+// Use EnvVar if the default value for a string should be read from an
+// environment variable of the provider process. If the environment variable
+// is unset or empty, the value remains null. Combine with FirstNonNull to
+// declare a fallback value.
+func EnvVar(name string) defaults.String {
+	return envVarDefault{
+		name: name,
+	}
+}
+
+// envVarDefault is an environment variable value default handler that sets a
+// value on a string attribute.
+type envVarDefault struct {
+	name string
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d envVarDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to the %s environment variable", d.name)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d envVarDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to the `%s` environment variable", d.name)
+}
+
+// DefaultString implements the environment variable default value logic.
+func (d envVarDefault) DefaultString(_ context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
+	value := os.Getenv(d.name)
+
+	if value == "" {
+		resp.PlanValue = types.StringNull()
+
+		return
+	}
+
+	resp.PlanValue = types.StringValue(value)
+}
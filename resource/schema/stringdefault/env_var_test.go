@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+//nolint:paralleltest // t.Setenv is not compatible with t.Parallel
+func TestEnvVarDefaultString(t *testing.T) {
+	testCases := map[string]struct {
+		envValue *string
+		expected *defaults.StringResponse
+	}{
+		"set": {
+			envValue: pointer("test-value"),
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test-value"),
+			},
+		},
+		"empty": {
+			envValue: pointer(""),
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"unset": {
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if testCase.envValue != nil {
+				t.Setenv("TF_TEST_STRINGDEFAULT_ENVVAR", *testCase.envValue)
+			}
+
+			resp := &defaults.StringResponse{}
+
+			stringdefault.EnvVar("TF_TEST_STRINGDEFAULT_ENVVAR").DefaultString(context.Background(), defaults.StringRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func pointer[T any](value T) *T {
+	return &value
+}
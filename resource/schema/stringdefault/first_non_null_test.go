@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirstNonNullDefaultString(t *testing.T) {
+	t.Parallel()
+
+	nullDefault := testdefaults.String{
+		DefaultStringMethod: func(_ context.Context, _ defaults.StringRequest, resp *defaults.StringResponse) {
+			resp.PlanValue = types.StringNull()
+		},
+	}
+
+	errorDefault := testdefaults.String{
+		DefaultStringMethod: func(_ context.Context, _ defaults.StringRequest, resp *defaults.StringResponse) {
+			resp.Diagnostics.AddError("test error summary", "test error detail")
+			resp.PlanValue = types.StringNull()
+		},
+	}
+
+	testCases := map[string]struct {
+		defaultValues   []defaults.String
+		referenceValues []attr.Value
+		expected        *defaults.StringResponse
+	}{
+		"first": {
+			defaultValues: []defaults.String{
+				stringdefault.StaticString("test"),
+				nullDefault,
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"fallback": {
+			defaultValues: []defaults.String{
+				nullDefault,
+				stringdefault.StaticString("test"),
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"all-null": {
+			defaultValues: []defaults.String{
+				nullDefault,
+				nullDefault,
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"reference-value": {
+			defaultValues: []defaults.String{
+				stringdefault.FromSibling("name"),
+				stringdefault.StaticString("test"),
+			},
+			referenceValues: []attr.Value{
+				types.StringValue("test-name"),
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test-name"),
+			},
+		},
+		"reference-value-null": {
+			defaultValues: []defaults.String{
+				stringdefault.FromSibling("name"),
+				stringdefault.StaticString("test"),
+			},
+			referenceValues: []attr.Value{
+				types.StringNull(),
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"reference-values-nested": {
+			defaultValues: []defaults.String{
+				stringdefault.FromSibling("first"),
+				stringdefault.FirstNonNull(
+					stringdefault.FromSibling("second"),
+					stringdefault.FromSibling("third"),
+				),
+			},
+			referenceValues: []attr.Value{
+				types.StringNull(),
+				types.StringNull(),
+				types.StringValue("test-third"),
+			},
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test-third"),
+			},
+		},
+		"error": {
+			defaultValues: []defaults.String{
+				errorDefault,
+				stringdefault.StaticString("test"),
+			},
+			expected: &defaults.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error detail"),
+				},
+				PlanValue: types.StringNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := defaults.StringRequest{
+				ReferenceValues: testCase.referenceValues,
+			}
+			resp := &defaults.StringResponse{}
+
+			stringdefault.FirstNonNull(testCase.defaultValues...).DefaultString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFirstNonNullDefaultStringReferencePaths(t *testing.T) {
+	t.Parallel()
+
+	got := stringdefault.FirstNonNull(
+		stringdefault.FromSibling("first"),
+		stringdefault.EnvVar("TF_TEST_STRINGDEFAULT_UNUSED"),
+		stringdefault.FirstNonNull(
+			stringdefault.FromPath(path.MatchRoot("second")),
+		),
+		stringdefault.StaticString("test"),
+	).(defaults.References).ReferencePaths(context.Background())
+
+	expected := path.Expressions{
+		path.MatchRelative().AtParent().AtName("first"),
+		path.MatchRoot("second"),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// CollapseTrailingNewlines returns a plan modifier that compares values with
+// any trailing newlines collapsed into a single newline. Refer to
+// NormalizeFunc for the plan modification behavior.
+func CollapseTrailingNewlines() planmodifier.String {
+	return NormalizeFunc(
+		func(value string) string {
+			trimmed := strings.TrimRight(value, "\n")
+
+			if trimmed == value {
+				return value
+			}
+
+			return trimmed + "\n"
+		},
+		"Multiple trailing newlines are ignored.",
+		"Multiple trailing newlines are ignored.",
+	)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NormalizeFunc returns a plan modifier that compares values after applying
+// the given normalization function, such as removing formatting differences
+// between the remote system and values determined by the provider.
+//
+// The plan modifier only changes the planned value if there is no
+// configuration value, such as Computed values, since Terraform requires
+// planned values to match configured values. In that case, if the normalized
+// plan and state values are equal, the prior state value is used as the
+// planned value, so formatting only changes are not shown as differences.
+// Otherwise, the planned value is normalized. The framework converts the
+// planned value into the attribute value type, including custom types.
+//
+// Configured values are never changed. To ignore formatting differences
+// between configured and prior state values, use a custom string type which
+// implements semantic equality instead.
+//
+// Use TrimSpace, CollapseTrailingNewlines, or NormalizeLineEndings for common
+// normalizations.
+func NormalizeFunc(f func(string) string, description, markdownDescription string) planmodifier.String {
+	return normalizeModifier{
+		normalizeFunc:       f,
+		description:         description,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// normalizeModifier is a plan modifier that normalizes string values.
+type normalizeModifier struct {
+	normalizeFunc       func(string) string
+	description         string
+	markdownDescription string
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m normalizeModifier) Description(_ context.Context) string {
+	return m.description
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m normalizeModifier) MarkdownDescription(_ context.Context) string {
+	return m.markdownDescription
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m normalizeModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if there is no known planned value.
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Terraform requires planned values to match configured values.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	planValue := m.normalizeFunc(req.PlanValue.ValueString())
+
+	if !req.StateValue.IsNull() && !req.StateValue.IsUnknown() && m.normalizeFunc(req.StateValue.ValueString()) == planValue {
+		resp.PlanValue = req.StateValue
+
+		return
+	}
+
+	// Keep the planned value if it is already normalized.
+	if planValue == req.PlanValue.ValueString() {
+		return
+	}
+
+	resp.PlanValue = types.StringValue(planValue)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeFuncModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"null-plan": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringNull(),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"configured-normalized-equal": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringValue("TEST"),
+				ConfigValue: types.StringValue("TEST"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("TEST"),
+			},
+		},
+		"unconfigured-normalized-equal": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringValue("TEST"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"configured-normalized-not-equal": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringValue("OTHER"),
+				ConfigValue: types.StringValue("OTHER"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("OTHER"),
+			},
+		},
+		"unconfigured-normalized-not-equal": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringValue("test"),
+				PlanValue:   types.StringValue("OTHER"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("other"),
+			},
+		},
+		"unconfigured-null-state": {
+			request: planmodifier.StringRequest{
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringValue("OTHER"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("other"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.NormalizeFunc(strings.ToLower, "test", "test").PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
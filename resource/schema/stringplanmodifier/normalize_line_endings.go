@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// NormalizeLineEndings returns a plan modifier that compares values with
+// Windows (CRLF) and classic Mac (CR) line endings converted to Unix (LF) line
+// endings. Refer to NormalizeFunc for the plan modification behavior.
+func NormalizeLineEndings() planmodifier.String {
+	return NormalizeFunc(
+		func(value string) string {
+			value = strings.ReplaceAll(value, "\r\n", "\n")
+
+			return strings.ReplaceAll(value, "\r", "\n")
+		},
+		"Line ending differences are ignored.",
+		"Line ending differences are ignored.",
+	)
+}
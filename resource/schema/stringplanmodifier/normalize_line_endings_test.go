@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeLineEndingsModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stateValue types.String
+		planValue  types.String
+		expected   types.String
+	}{
+		"state-equal": {
+			stateValue: types.StringValue("line1\nline2"),
+			planValue:  types.StringValue("line1\r\nline2"),
+			expected:   types.StringValue("line1\nline2"),
+		},
+		"crlf": {
+			stateValue: types.StringNull(),
+			planValue:  types.StringValue("line1\r\nline2\r\n"),
+			expected:   types.StringValue("line1\nline2\n"),
+		},
+		"cr": {
+			stateValue: types.StringNull(),
+			planValue:  types.StringValue("line1\rline2"),
+			expected:   types.StringValue("line1\nline2"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				StateValue:  testCase.stateValue,
+				PlanValue:   testCase.planValue,
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: req.PlanValue,
+			}
+
+			stringplanmodifier.NormalizeLineEndings().PlanModifyString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.PlanValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// TrimSpace returns a plan modifier that compares values without leading and
+// trailing whitespace. Refer to NormalizeFunc for the plan modification
+// behavior.
+func TrimSpace() planmodifier.String {
+	return NormalizeFunc(
+		strings.TrimSpace,
+		"Leading and trailing whitespace is ignored.",
+		"Leading and trailing whitespace is ignored.",
+	)
+}
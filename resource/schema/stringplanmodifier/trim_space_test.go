@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTrimSpaceModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stateValue types.String
+		planValue  types.String
+		expected   types.String
+	}{
+		"state-equal": {
+			stateValue: types.StringValue("test"),
+			planValue:  types.StringValue(" test\n"),
+			expected:   types.StringValue("test"),
+		},
+		"state-not-equal": {
+			stateValue: types.StringValue("test"),
+			planValue:  types.StringValue(" other\n"),
+			expected:   types.StringValue("other"),
+		},
+		"null-state": {
+			stateValue: types.StringNull(),
+			planValue:  types.StringValue("\ttest "),
+			expected:   types.StringValue("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				StateValue:  testCase.stateValue,
+				PlanValue:   testCase.planValue,
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: req.PlanValue,
+			}
+
+			stringplanmodifier.TrimSpace().PlanModifyString(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.PlanValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -4,8 +4,12 @@
 package resource
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -39,6 +43,31 @@ type UpdateRequest struct {
 	Private *privatestate.ProviderData
 }
 
+// ChangedPaths returns the paths of attribute and block values which differ
+// between State and Plan, such as for building a request which only includes
+// changed values. Values which are semantically equal, based on the value
+// type semantic equality logic, are not considered changed.
+//
+// When a nested attribute value changes, both the parent path and the
+// changed nested attribute path are returned. Changed map elements are
+// returned similarly, while list and set element changes are only returned
+// as the path of the entire list or set. Planned values which are unknown,
+// such as computed values the provider will set, are not returned.
+func (r UpdateRequest) ChangedPaths(ctx context.Context) (path.Paths, diag.Diagnostics) {
+	planData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionPlan,
+		Schema:         r.Plan.Schema,
+		TerraformValue: r.Plan.Raw,
+	}
+	stateData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         r.State.Schema,
+		TerraformValue: r.State.Raw,
+	}
+
+	return planData.ChangedPaths(ctx, stateData)
+}
+
 // UpdateResponse represents a response to an UpdateRequest. An
 // instance of this response struct is supplied as
 // an argument to the resource's Update function, in which the provider
@@ -58,4 +87,14 @@ type UpdateResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Tainted indicates the remote resource is irrecoverably degraded and
+	// must be replaced, similar to the Terraform taint command. The framework
+	// saves this into the resource private state, then the next plan marks
+	// the resource for replacement by setting Computed attributes without a
+	// configuration value to unknown and requiring replacement for them.
+	//
+	// The resource schema must contain at least one top level Computed
+	// attribute or the next plan will return an error diagnostic.
+	Tainted bool
 }
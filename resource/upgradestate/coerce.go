@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package upgradestate
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// coerceJSON returns the JSON value as the given type, applying the Mapper
+// type coercion rules if the value is not already of the type. Strings are
+// already decoded as numbers by terraform-plugin-go, if they can be parsed.
+func coerceJSON(data json.RawMessage, typ tftypes.Type) (tftypes.Value, error) {
+	value, err := tftypes.ValueFromJSONWithOpts(data, typ, tftypes.ValueFromJSONOpts{
+		IgnoreUndefinedAttributes: true,
+	})
+
+	if err == nil {
+		return value, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var decoded any
+
+	if decodeErr := decoder.Decode(&decoded); decodeErr != nil {
+		return value, err
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		switch v := decoded.(type) {
+		case json.Number:
+			return tftypes.NewValue(tftypes.String, v.String()), nil
+		case bool:
+			return tftypes.NewValue(tftypes.String, strconv.FormatBool(v)), nil
+		}
+	case typ.Is(tftypes.Bool):
+		if v, ok := decoded.(string); ok {
+			if b, parseErr := strconv.ParseBool(v); parseErr == nil {
+				return tftypes.NewValue(tftypes.Bool, b), nil
+			}
+		}
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		if _, ok := decoded.([]any); ok {
+			break
+		}
+
+		var elementType tftypes.Type
+
+		switch collectionType := typ.(type) {
+		case tftypes.List:
+			elementType = collectionType.ElementType
+		case tftypes.Set:
+			elementType = collectionType.ElementType
+		}
+
+		element, elementErr := coerceJSON(data, elementType)
+
+		if elementErr == nil {
+			return tftypes.NewValue(typ, []tftypes.Value{element}), nil
+		}
+	}
+
+	return value, err
+}
+
+// sortedKeys returns the map keys in sorted order, so diagnostics are
+// returned in a consistent order.
+func sortedKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
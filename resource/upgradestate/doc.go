@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package upgradestate contains helpers for upgrading resource state from
+// prior schema versions using declarative attribute renames and type
+// coercion rules, instead of manually decoding the prior state.
+//
+// The main starting point for implementations in this package is the Mapper
+// type, whose StateUpgrader method returns a resource.StateUpgrader for the
+// resource.ResourceWithUpgradeState interface UpgradeState method.
+package upgradestate
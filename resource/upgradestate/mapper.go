@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package upgradestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Mapper declares how to upgrade the state of a single prior schema version
+// to the current schema. Each prior state attribute is set in the current
+// state attribute with the same name, unless it is renamed. Prior attributes
+// which do not exist in the current schema are removed, and current
+// attributes without a prior value remain null, which is typically fixed by
+// the next refresh.
+//
+// Each prior value is converted to the type of the current attribute. In
+// addition to the conversions allowed by Terraform, such as between lists and
+// sets, these type coercion rules apply to the attribute value as a whole:
+//
+//   - A number or bool becomes a string, such as 1 becoming "1".
+//   - A string becomes a number or bool, if it can be parsed as one.
+//   - A single value becomes a list or set containing the value, if the
+//     value can be converted to the element type.
+type Mapper struct {
+	// Renames maps prior state attribute names to the path of the
+	// attribute in the current schema, such as path.Root("new_name").
+	Renames map[string]path.Path
+}
+
+// StateUpgrader returns a resource.StateUpgrader which sets the current state
+// from the prior state according to the Mapper.
+func (m Mapper) StateUpgrader() resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: m.upgradeState,
+	}
+}
+
+// upgradeState implements the resource.StateUpgrader StateUpgrader function.
+func (m Mapper) upgradeState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError(
+			"Missing Prior Resource State",
+			"The prior resource state was not provided in JSON format, so the state cannot be upgraded. "+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	var priorAttributes map[string]json.RawMessage
+
+	if err := json.Unmarshal(req.RawState.JSON, &priorAttributes); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Prior Resource State",
+			"The prior resource state could not be read. "+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	currentType, ok := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Resource Schema",
+			fmt.Sprintf("The resource schema has a Terraform type of %s, expected an object. ", resp.State.Schema.Type().TerraformType(ctx))+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	currentAttributes := make(map[string]tftypes.Value, len(currentType.AttributeTypes))
+
+	for name, attributeType := range currentType.AttributeTypes {
+		currentAttributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	resp.State.Raw = tftypes.NewValue(currentType, currentAttributes)
+
+	for _, name := range sortedKeys(priorAttributes) {
+		target, ok := m.Renames[name]
+
+		if !ok {
+			if _, ok := currentType.AttributeTypes[name]; !ok {
+				continue
+			}
+
+			target = path.Root(name)
+		}
+
+		resp.Diagnostics.Append(upgradeAttribute(ctx, name, priorAttributes[name], target, resp)...)
+	}
+}
+
+// upgradeAttribute sets the current state attribute from the prior state
+// attribute value.
+func upgradeAttribute(ctx context.Context, name string, priorValue json.RawMessage, target path.Path, resp *resource.UpgradeStateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	targetType, typeDiags := resp.State.Schema.TypeAtPath(ctx, target)
+
+	diags.Append(typeDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	tfValue, err := coerceJSON(priorValue, targetType.TerraformType(ctx))
+
+	if err != nil {
+		diags.AddAttributeError(
+			target,
+			"Unable to Upgrade Resource State Value",
+			fmt.Sprintf("The prior state value of the %q attribute could not be converted for the current attribute. ", name)+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	targetValue, err := targetType.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		diags.AddAttributeError(
+			target,
+			"Unable to Upgrade Resource State Value",
+			fmt.Sprintf("The prior state value of the %q attribute could not be converted for the current attribute. ", name)+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	diags.Append(resp.State.SetAttribute(ctx, target, targetValue)...)
+
+	return diags
+}
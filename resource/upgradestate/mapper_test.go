@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package upgradestate_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/upgradestate"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapperStateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+			},
+			"tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"zones": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"id":      tftypes.String,
+			"name":    tftypes.String,
+			"port":    tftypes.Number,
+			"tags":    tftypes.Set{ElementType: tftypes.String},
+			"zones":   tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testMapper := upgradestate.Mapper{
+		Renames: map[string]path.Path{
+			"thing_name": path.Root("name"),
+			"zone":       path.Root("zones"),
+		},
+	}
+
+	testRequest := func(json string) resource.UpgradeStateRequest {
+		return resource.UpgradeStateRequest{
+			RawState: &tfprotov6.RawState{
+				JSON: []byte(json),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		mapper           upgradestate.Mapper
+		request          resource.UpgradeStateRequest
+		expectedDiags    diag.Diagnostics
+		expectedRawState tftypes.Value
+	}{
+		"unchanged": {
+			mapper:  upgradestate.Mapper{},
+			request: testRequest(`{"enabled": true, "id": "test-id", "name": "test-name", "port": 443, "tags": ["a"], "zones": ["b"]}`),
+			expectedRawState: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"id":      tftypes.NewValue(tftypes.String, "test-id"),
+				"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				"port":    tftypes.NewValue(tftypes.Number, big.NewFloat(443)),
+				"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+				}),
+				"zones": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+			}),
+		},
+		"renamed-and-removed": {
+			mapper:  testMapper,
+			request: testRequest(`{"id": "test-id", "thing_name": "test-name", "removed": true}`),
+			expectedRawState: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, nil),
+				"id":      tftypes.NewValue(tftypes.String, "test-id"),
+				"name":    tftypes.NewValue(tftypes.String, "test-name"),
+				"port":    tftypes.NewValue(tftypes.Number, nil),
+				"tags":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"zones":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
+		"coerced": {
+			mapper:  testMapper,
+			request: testRequest(`{"enabled": "true", "id": 123, "thing_name": false, "port": "443", "tags": ["a", "b"], "zone": "c"}`),
+			expectedRawState: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"id":      tftypes.NewValue(tftypes.String, "123"),
+				"name":    tftypes.NewValue(tftypes.String, "false"),
+				"port":    tftypes.NewValue(tftypes.Number, big.NewFloat(443)),
+				"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+				"zones": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "c"),
+				}),
+			}),
+		},
+		"invalid-value": {
+			mapper:  testMapper,
+			request: testRequest(`{"id": "test-id", "port": "https"}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("port"),
+					"Unable to Upgrade Resource State Value",
+					"The prior state value of the \"port\" attribute could not be converted for the current attribute. "+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Error: error parsing number: number has no digits",
+				),
+			},
+			expectedRawState: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, nil),
+				"id":      tftypes.NewValue(tftypes.String, "test-id"),
+				"name":    tftypes.NewValue(tftypes.String, nil),
+				"port":    tftypes.NewValue(tftypes.Number, nil),
+				"tags":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				"zones":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
+		"missing-raw-state": {
+			mapper:  testMapper,
+			request: resource.UpgradeStateRequest{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Prior Resource State",
+					"The prior resource state was not provided in JSON format, so the state cannot be upgraded. "+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+				),
+			},
+			expectedRawState: tftypes.Value{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: testSchema,
+				},
+			}
+
+			testCase.mapper.StateUpgrader().StateUpgrader(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expectedRawState); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+var _ defaults.String = enumDefault[string]{}
+
+// Default returns a resource string attribute default value handler for the
+// string value of the Go value. An error diagnostic is raised if the Go value
+// is not part of the Enum.
+func (e Enum[T]) Default(value T) defaults.String {
+	return enumDefault[T]{
+		enum:  e,
+		value: value,
+	}
+}
+
+// enumDefault is a string default value handler for an Enum.
+type enumDefault[T comparable] struct {
+	enum  Enum[T]
+	value T
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d enumDefault[T]) Description(_ context.Context) string {
+	s, _ := d.enum.String(d.value)
+
+	return fmt.Sprintf("value defaults to %s", s)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d enumDefault[T]) MarkdownDescription(_ context.Context) string {
+	s, _ := d.enum.String(d.value)
+
+	return fmt.Sprintf("value defaults to `%s`", s)
+}
+
+// DefaultString implements the default value logic.
+func (d enumDefault[T]) DefaultString(ctx context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
+	value, diags := d.enum.StringValue(ctx, d.value, req.Path)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	resp.PlanValue = value
+}
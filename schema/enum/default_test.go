@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enum_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEnumDefault(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    testSize
+		expected *defaults.StringResponse
+	}{
+		"mapped": {
+			value: testSizeSmall,
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("small"),
+			},
+		},
+		"unmapped": {
+			value: testSizeUnknown,
+			expected: &defaults.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Enumeration Value",
+						"An unexpected error was encountered converting an enumeration value. "+
+							"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							`Value 0 is not mapped to one of: ["large" "small"]`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &defaults.StringResponse{}
+
+			testSizeEnum.Default(testCase.value).DefaultString(context.Background(), defaults.StringRequest{Path: path.Root("test")}, resp)
+
+			if diff := cmp.Diff(resp, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package enum contains a mapping between Go enumeration values and string
+// attribute values, which generates the schema validator, resource default,
+// and value conversions from a single declaration so they cannot drift apart.
+package enum
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enum
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Enum is a mapping between Go enumeration values and string attribute
+// values. Use New or Must to create an Enum.
+type Enum[T comparable] struct {
+	// strings is the sorted list of string values.
+	strings []string
+
+	// toString is the mapping of Go values to string values.
+	toString map[T]string
+
+	// toValue is the mapping of string values to Go values.
+	toValue map[string]T
+}
+
+// New returns an Enum from a mapping of Go values to string attribute values.
+// An error is returned if the mapping is empty or if multiple Go values map to
+// the same string value.
+func New[T comparable](values map[T]string) (Enum[T], error) {
+	e := Enum[T]{
+		strings:  make([]string, 0, len(values)),
+		toString: make(map[T]string, len(values)),
+		toValue:  make(map[string]T, len(values)),
+	}
+
+	if len(values) == 0 {
+		return e, fmt.Errorf("enum must contain at least one value")
+	}
+
+	for value, s := range values {
+		if _, ok := e.toValue[s]; ok {
+			return e, fmt.Errorf("enum contains duplicate string value %q", s)
+		}
+
+		e.strings = append(e.strings, s)
+		e.toString[value] = s
+		e.toValue[s] = value
+	}
+
+	sort.Strings(e.strings)
+
+	return e, nil
+}
+
+// Must returns an Enum from a mapping of Go values to string attribute values.
+// It panics if the mapping is invalid, which is intended for package level
+// variable declarations.
+func Must[T comparable](values map[T]string) Enum[T] {
+	e, err := New(values)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
+// Strings returns the sorted string attribute values.
+func (e Enum[T]) Strings() []string {
+	result := make([]string, len(e.strings))
+
+	copy(result, e.strings)
+
+	return result
+}
+
+// String returns the string attribute value for the Go value and whether it
+// is part of the Enum.
+func (e Enum[T]) String(value T) (string, bool) {
+	s, ok := e.toString[value]
+
+	return s, ok
+}
+
+// Value returns the Go value for the string attribute value and whether it is
+// part of the Enum.
+func (e Enum[T]) Value(s string) (T, bool) {
+	value, ok := e.toValue[s]
+
+	return value, ok
+}
+
+// StringValue returns the types.String for the Go value, which is intended
+// for setting data. An error diagnostic is returned if the Go value is not
+// part of the Enum.
+func (e Enum[T]) StringValue(_ context.Context, value T, p path.Path) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s, ok := e.toString[value]
+
+	if !ok {
+		diags.AddAttributeError(
+			p,
+			"Invalid Enumeration Value",
+			"An unexpected error was encountered converting an enumeration value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Value %v is not mapped to one of: %s", value, e.quotedStrings()),
+		)
+
+		return types.StringNull(), diags
+	}
+
+	return types.StringValue(s), diags
+}
+
+// ValueFromString returns the Go value for the types.String, which is
+// intended for reading data. The zero value is returned for null or unknown
+// values, so check for those beforehand if they need different handling. An
+// error diagnostic is returned if the value is not part of the Enum.
+func (e Enum[T]) ValueFromString(_ context.Context, value types.String, p path.Path) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var zero T
+
+	if value.IsNull() || value.IsUnknown() {
+		return zero, diags
+	}
+
+	result, ok := e.toValue[value.ValueString()]
+
+	if !ok {
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s value must be one of: %s, got: %q", p, e.quotedStrings(), value.ValueString()),
+		)
+
+		return zero, diags
+	}
+
+	return result, diags
+}
+
+// Description returns a plaintext description of the valid values, which is
+// intended for attribute descriptions.
+func (e Enum[T]) Description() string {
+	return "Valid values are " + e.joinedStrings("%q") + "."
+}
+
+// MarkdownDescription returns a Markdown description of the valid values,
+// which is intended for attribute descriptions.
+func (e Enum[T]) MarkdownDescription() string {
+	return "Valid values are " + e.joinedStrings("`%s`") + "."
+}
+
+// joinedStrings returns an English joining of the formatted strings.
+func (e Enum[T]) joinedStrings(format string) string {
+	formatted := make([]string, len(e.strings))
+
+	for i, s := range e.strings {
+		formatted[i] = fmt.Sprintf(format, s)
+	}
+
+	switch len(formatted) {
+	case 1:
+		return formatted[0]
+	case 2:
+		return formatted[0] + " and " + formatted[1]
+	default:
+		return strings.Join(formatted[:len(formatted)-1], ", ") + ", and " + formatted[len(formatted)-1]
+	}
+}
+
+// quotedStrings returns the string values in a bracketed, quoted list.
+func (e Enum[T]) quotedStrings() string {
+	quoted := make([]string, len(e.strings))
+
+	for i, s := range e.strings {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+
+	return "[" + strings.Join(quoted, " ") + "]"
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enum_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/enum"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testSize int
+
+const (
+	testSizeUnknown testSize = iota
+	testSizeSmall
+	testSizeLarge
+)
+
+var testSizeEnum = enum.Must(map[testSize]string{
+	testSizeSmall: "small",
+	testSizeLarge: "large",
+})
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values        map[string]string
+		expected      []string
+		expectedError string
+	}{
+		"valid": {
+			values: map[string]string{
+				"B": "b",
+				"A": "a",
+				"C": "c",
+			},
+			expected: []string{"a", "b", "c"},
+		},
+		"empty": {
+			values:        map[string]string{},
+			expectedError: "enum must contain at least one value",
+		},
+		"duplicate": {
+			values: map[string]string{
+				"A": "a",
+				"B": "a",
+			},
+			expectedError: `enum contains duplicate string value "a"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := enum.New(testCase.values)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got.Strings(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEnumStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         testSize
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"mapped": {
+			value:    testSizeLarge,
+			expected: types.StringValue("large"),
+		},
+		"unmapped": {
+			value:    testSizeUnknown,
+			expected: types.StringNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Enumeration Value",
+					"An unexpected error was encountered converting an enumeration value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Value 0 is not mapped to one of: ["large" "small"]`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testSizeEnum.StringValue(context.Background(), testCase.value, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEnumValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         types.String
+		expected      testSize
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    types.StringNull(),
+			expected: testSizeUnknown,
+		},
+		"unknown": {
+			value:    types.StringUnknown(),
+			expected: testSizeUnknown,
+		},
+		"mapped": {
+			value:    types.StringValue("small"),
+			expected: testSizeSmall,
+		},
+		"unmapped": {
+			value:    types.StringValue("medium"),
+			expected: testSizeUnknown,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be one of: ["large" "small"], got: "medium"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testSizeEnum.ValueFromString(context.Background(), testCase.value, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEnumDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		enum             enum.Enum[string]
+		expected         string
+		expectedMarkdown string
+	}{
+		"one": {
+			enum:             enum.Must(map[string]string{"A": "a"}),
+			expected:         `Valid values are "a".`,
+			expectedMarkdown: "Valid values are `a`.",
+		},
+		"two": {
+			enum:             enum.Must(map[string]string{"A": "a", "B": "b"}),
+			expected:         `Valid values are "a" and "b".`,
+			expectedMarkdown: "Valid values are `a` and `b`.",
+		},
+		"three": {
+			enum:             enum.Must(map[string]string{"A": "a", "B": "b", "C": "c"}),
+			expected:         `Valid values are "a", "b", and "c".`,
+			expectedMarkdown: "Valid values are `a`, `b`, and `c`.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.enum.Description(), testCase.expected); diff != "" {
+				t.Errorf("unexpected description difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.enum.MarkdownDescription(), testCase.expectedMarkdown); diff != "" {
+				t.Errorf("unexpected markdown description difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/exampleconfig"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ validator.String                        = enumValidator[string]{}
+	_ validator.ValidatorWithValidValues      = enumValidator[string]{}
+	_ exampleconfig.ValidatorWithExampleValue = enumValidator[string]{}
+)
+
+// Validator returns a string schema validator which raises an error
+// diagnostic if a known configuration value is not part of the Enum.
+func (e Enum[T]) Validator() validator.String {
+	return enumValidator[T]{
+		enum: e,
+	}
+}
+
+// enumValidator is a string validator for an Enum.
+type enumValidator[T comparable] struct {
+	enum Enum[T]
+}
+
+// Description returns a human-readable description of the validator.
+func (v enumValidator[T]) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", v.enum.quotedStrings())
+}
+
+// ExampleValue returns the first sorted string attribute value, which is
+// used in example configurations.
+func (v enumValidator[T]) ExampleValue(_ context.Context) attr.Value {
+	values := v.enum.Strings()
+
+	if len(values) == 0 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(values[0])
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v enumValidator[T]) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidValues returns the sorted string attribute values, which are used in
+// MarkdownDescription templates.
+func (v enumValidator[T]) ValidValues(_ context.Context) []string {
+	return v.enum.Strings()
+}
+
+// ValidateString implements the validation logic.
+func (v enumValidator[T]) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, diags := v.enum.ValueFromString(ctx, req.ConfigValue, req.Path)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enum_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/exampleconfig"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEnumValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  validator.StringRequest
+		expected *validator.StringResponse
+	}{
+		"null": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"valid": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("small"),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"invalid": {
+			request: validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("medium"),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Value",
+						`Attribute test value must be one of: ["large" "small"], got: "medium"`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &validator.StringResponse{}
+
+			testSizeEnum.Validator().ValidateString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(resp, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEnumValidatorExampleValue(t *testing.T) {
+	t.Parallel()
+
+	v, ok := testSizeEnum.Validator().(exampleconfig.ValidatorWithExampleValue)
+
+	if !ok {
+		t.Fatal("expected validator to implement exampleconfig.ValidatorWithExampleValue")
+	}
+
+	got := v.ExampleValue(context.Background())
+
+	if diff := cmp.Diff(got, types.StringValue("large")); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestEnumValidatorValidValues(t *testing.T) {
+	t.Parallel()
+
+	v, ok := testSizeEnum.Validator().(validator.ValidatorWithValidValues)
+
+	if !ok {
+		t.Fatal("expected validator to implement validator.ValidatorWithValidValues")
+	}
+
+	got := v.ValidValues(context.Background())
+
+	if diff := cmp.Diff(got, []string{"large", "small"}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
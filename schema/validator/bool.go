@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -35,6 +36,10 @@ type BoolRequest struct {
 
 	// ConfigValue contains the value of the attribute for validation from the configuration.
 	ConfigValue types.Bool
+
+	// Functions calls the pure provider-defined functions of the provider,
+	// such as to reuse value normalization logic in validation.
+	Functions function.Caller
 }
 
 // BoolResponse is a response to a BoolRequest.
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validator
+
+import (
+	"context"
+)
+
+// ValidatorWithValidValues is an optional interface on schema validators
+// which only allow a fixed set of values, such as enumerations. The framework
+// uses the valid values when rendering MarkdownDescription templates, so
+// attribute documentation does not drift from the validation behavior.
+type ValidatorWithValidValues interface {
+	// ValidValues should return the values allowed by the validator, as
+	// they would be written in configuration without quoting.
+	ValidValues(context.Context) []string
+}
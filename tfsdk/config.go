@@ -24,6 +24,13 @@ func (c Config) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 	return c.data().Get(ctx, target)
 }
 
+// GetWithOpts populates the struct passed as `target` with the entire
+// config, using the given options for null and unknown values which the
+// `target` types cannot represent.
+func (c Config) GetWithOpts(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return c.data().GetWithOptions(ctx, target, opts.reflectOptions())
+}
+
 // GetAttribute retrieves the attribute or block found at `path` and populates
 // the `target` with the value. This method is intended for top level schema
 // attributes or blocks. Use `types` package methods or custom types to step
@@ -35,6 +42,19 @@ func (c Config) GetAttribute(ctx context.Context, path path.Path, target interfa
 	return c.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributeOrNull retrieves the attribute or block found at `path` and
+// populates the `target` with the value, returning true, if the value and all
+// of its parents are known and not null. Otherwise, such as when a parent
+// object is null or a list element does not exist, the `target` is set to its
+// zero value and false is returned without diagnostics. Framework types, such
+// as types.String, have a null zero value.
+//
+// Diagnostics are still returned if the path is not valid for the schema or
+// the value cannot be populated into the `target`.
+func (c Config) GetAttributeOrNull(ctx context.Context, path path.Path, target interface{}) (bool, diag.Diagnostics) {
+	return c.data().GetAtPathOrNull(ctx, path, target)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -44,6 +64,26 @@ func (c Config) PathMatches(ctx context.Context, pathExpr path.Expression) (path
 	return c.data().PathMatches(ctx, pathExpr)
 }
 
+// Walk calls the given function for each attribute, block, and collection
+// element value in the config, parents before their nested values. This is
+// intended for generic logic which applies across a whole schema, such as
+// finding all values of a certain type.
+//
+// Values nested under null or unknown values are not visited, nor are values
+// nested under dynamic values. The walk ends early if the function returns
+// WalkStop or error diagnostics.
+func (c Config) Walk(ctx context.Context, walkFunc WalkFunc) diag.Diagnostics {
+	return c.data().Walk(ctx, fwschemadataWalkFunc(walkFunc))
+}
+
+// WalkWithSchema is the same as Walk, except the function also receives the
+// schema attribute or block of each value. This is intended for generic logic
+// which depends on the schema definition, such as redacting the values of
+// Sensitive attributes.
+func (c Config) WalkWithSchema(ctx context.Context, walkFunc WalkWithSchemaFunc) diag.Diagnostics {
+	return c.data().WalkWithSchema(ctx, fwschemadataWalkWithSchemaFunc(walkFunc))
+}
+
 func (c Config) data() fwschemadata.Data {
 	return fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionConfiguration,
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -62,6 +63,47 @@ func TestConfigGet(t *testing.T) {
 				String: types.StringValue("test"),
 			},
 		},
+		"snake-case-field-names": {
+			config: tfsdk.Config{
+				Raw: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"api_token": tftypes.String,
+							"string":    tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"api_token": tftypes.NewValue(tftypes.String, "token"),
+						"string":    tftypes.NewValue(tftypes.String, "test"),
+					},
+				),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"api_token": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+						"string": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+			target: new(struct {
+				tfsdk.SnakeCaseFieldNames
+				APIToken types.String
+				Other    types.String `tfsdk:"string"`
+			}),
+			expected: &struct {
+				tfsdk.SnakeCaseFieldNames
+				APIToken types.String
+				Other    types.String `tfsdk:"string"`
+			}{
+				APIToken: types.StringValue("token"),
+				Other:    types.StringValue("test"),
+			},
+		},
 		"diagnostic": {
 			config: tfsdk.Config{
 				Raw: tftypes.NewValue(
@@ -123,6 +165,91 @@ func TestConfigGet(t *testing.T) {
 	}
 }
 
+func TestConfigGetWithOpts(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		ID   string `tfsdk:"id"`
+		Name string `tfsdk:"name"`
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testRaw := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testCases := map[string]struct {
+		opts          tfsdk.GetOptions
+		expected      testModel
+		expectedDiags diag.Diagnostics
+	}{
+		"options": {
+			opts: tfsdk.GetOptions{
+				UnhandledNullAsEmpty:    true,
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: testModel{
+				ID: "",
+			},
+		},
+		"no-options": {
+			opts: tfsdk.GetOptions{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values."+"\n\n"+
+						"Path: id\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Raw:    testRaw,
+				Schema: testSchema,
+			}
+
+			var got testModel
+
+			diags := config.GetWithOpts(context.Background(), &got, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestConfigGetAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -289,3 +416,141 @@ func TestConfigPathMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigWalk(t *testing.T) {
+	t.Parallel()
+
+	type visit struct {
+		Path  path.Path
+		Value attr.Value
+	}
+
+	type testCase struct {
+		config         tfsdk.Config
+		control        tfsdk.WalkControl
+		expectedVisits []visit
+	}
+
+	testConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list": tftypes.List{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "element"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testschema.Attribute{
+					Type:     types.ListType{ElemType: types.StringType},
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	testListValue := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("element")})
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataWalk for more exhaustive unit testing.
+		// These test cases are to ensure Config schema and data values are
+		// passed appropriately to the shared implementation.
+		"WalkContinue": {
+			config:  testConfig,
+			control: tfsdk.WalkContinue,
+			expectedVisits: []visit{
+				{Path: path.Root("list"), Value: testListValue},
+				{Path: path.Root("list").AtListIndex(0), Value: types.StringValue("element")},
+			},
+		},
+		"WalkSkipChildren": {
+			config:  testConfig,
+			control: tfsdk.WalkSkipChildren,
+			expectedVisits: []visit{
+				{Path: path.Root("list"), Value: testListValue},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []visit
+
+			diags := tc.config.Walk(context.Background(), func(p path.Path, v attr.Value) (tfsdk.WalkControl, diag.Diagnostics) {
+				got = append(got, visit{Path: p, Value: v})
+
+				return tc.control, nil
+			})
+
+			if len(diags) > 0 {
+				t.Errorf("unexpected diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, tc.expectedVisits); diff != "" {
+				t.Errorf("unexpected visits (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigWalkWithSchema(t *testing.T) {
+	t.Parallel()
+
+	testAttribute := testschema.Attribute{
+		Type:     types.ListType{ElemType: types.StringType},
+		Optional: true,
+	}
+
+	testConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list": tftypes.List{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "element"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testAttribute,
+			},
+		},
+	}
+
+	// Refer to fwschemadata.TestDataWalkWithSchema for more exhaustive unit
+	// testing. This test is to ensure Config schema and data values are
+	// passed appropriately to the shared implementation.
+	expected := []tfsdk.WalkStep{
+		{
+			Path:      path.Root("list"),
+			Value:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("element")}),
+			Attribute: testAttribute,
+		},
+		{
+			Path:  path.Root("list").AtListIndex(0),
+			Value: types.StringValue("element"),
+		},
+	}
+
+	var got []tfsdk.WalkStep
+
+	diags := testConfig.WalkWithSchema(context.Background(), func(step tfsdk.WalkStep) (tfsdk.WalkControl, diag.Diagnostics) {
+		got = append(got, step)
+
+		return tfsdk.WalkContinue, nil
+	})
+
+	if len(diags) > 0 {
+		t.Errorf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected steps (+wanted, -got): %s", diff)
+	}
+}
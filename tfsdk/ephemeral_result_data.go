@@ -85,6 +85,27 @@ func (s *EphemeralResultData) SetAttribute(ctx context.Context, path path.Path,
 	return diags
 }
 
+// SetAttributes sets the attribute at each path using the supplied values,
+// such as when assembling data from many independent API fields.
+//
+// Each path and value must be valid with the current schema, following the
+// same rules as SetAttribute, and each path must only be given once. Every
+// value is validated before any value is set and the diagnostics for all
+// paths are returned together. If there are any error diagnostics, no values
+// are set.
+func (s *EphemeralResultData) SetAttributes(ctx context.Context, values []PathValue) diag.Diagnostics {
+	data := s.data()
+	diags := data.SetAtPaths(ctx, values)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
 func (s EphemeralResultData) data() *fwschemadata.Data {
 	return &fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionEphemeralResultData,
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// SnakeCaseFieldNames can be embedded in a data model struct to opt into
+// automatic mapping of struct field names to snake case attribute names,
+// removing the need for a "tfsdk" tag on every field. Consecutive uppercase
+// letters are treated as a single word, such as the APIToken field mapping to
+// the api_token attribute. Fields with a "tfsdk" tag, including "-", use the
+// tag instead. The mapping also applies to the fields of embedded structs, but
+// not to the fields of nested object structs, which must embed
+// SnakeCaseFieldNames themselves.
+//
+//	type ThingModel struct {
+//		tfsdk.SnakeCaseFieldNames
+//
+//		APIToken types.String // api_token
+//		Name     types.String // name
+//		ID       types.String `tfsdk:"thing_id"`
+//	}
+type SnakeCaseFieldNames = reflect.SnakeCaseFieldNames
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// attributeData is the constraint for data which supports GetAttribute and
+// GetAs.
+type attributeData interface {
+	Config | Plan | State
+
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}
+
+// GetAttribute returns the attribute or block value found at `path` in the
+// Config, Plan, or State as the framework value type T, such as types.String,
+// without declaring a target variable:
+//
+//	name, diags := tfsdk.GetAttribute[types.String](ctx, req.Config, path.Root("name"))
+//
+// The zero value of T is returned with error diagnostics if the path is not
+// valid for the schema or the value cannot be converted into T, such as when
+// T is types.Int64 for a string attribute.
+func GetAttribute[T attr.Value, D attributeData](ctx context.Context, data D, path path.Path) (T, diag.Diagnostics) {
+	return GetAs[T](ctx, data, path)
+}
+
+// GetAs returns the attribute or block value found at `path` in the Config,
+// Plan, or State as the Go type T, such as string, *int64, or a model struct
+// for a nested attribute or block, using the same reflection rules as the
+// GetAttribute methods:
+//
+//	tags, diags := tfsdk.GetAs[map[string]string](ctx, req.Plan, path.Root("tags"))
+//
+// The zero value of T is returned with error diagnostics if the path is not
+// valid for the schema or the value cannot be converted into T, such as when
+// T is string and the value is null.
+func GetAs[T any, D attributeData](ctx context.Context, data D, path path.Path) (T, diag.Diagnostics) {
+	var target T
+
+	diags := data.GetAttribute(ctx, path, &target)
+
+	if diags.HasError() {
+		var zero T
+
+		return zero, diags
+	}
+
+	return target, diags
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	testGetAttributeSchema = testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testGetAttributeRaw = tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "namevalue"),
+	})
+
+	testGetAttributeNullRaw = tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, nil),
+	})
+)
+
+func TestGetAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	config := tfsdk.Config{Raw: testGetAttributeRaw, Schema: testGetAttributeSchema}
+	plan := tfsdk.Plan{Raw: testGetAttributeRaw, Schema: testGetAttributeSchema}
+	state := tfsdk.State{Raw: testGetAttributeNullRaw, Schema: testGetAttributeSchema}
+
+	testCases := map[string]struct {
+		get           func() (types.String, diag.Diagnostics)
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"config": {
+			get: func() (types.String, diag.Diagnostics) {
+				return tfsdk.GetAttribute[types.String](ctx, config, path.Root("name"))
+			},
+			expected: types.StringValue("namevalue"),
+		},
+		"plan": {
+			get: func() (types.String, diag.Diagnostics) {
+				return tfsdk.GetAttribute[types.String](ctx, plan, path.Root("name"))
+			},
+			expected: types.StringValue("namevalue"),
+		},
+		"state-null": {
+			get: func() (types.String, diag.Diagnostics) {
+				return tfsdk.GetAttribute[types.String](ctx, state, path.Root("name"))
+			},
+			expected: types.StringNull(),
+		},
+		"invalid-path": {
+			get: func() (types.String, diag.Diagnostics) {
+				return tfsdk.GetAttribute[types.String](ctx, config, path.Root("other"))
+			},
+			expected: types.String{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"Configuration Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.get()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	config := tfsdk.Config{Raw: testGetAttributeRaw, Schema: testGetAttributeSchema}
+	state := tfsdk.State{Raw: testGetAttributeNullRaw, Schema: testGetAttributeSchema}
+
+	testCases := map[string]struct {
+		get           func() (any, diag.Diagnostics)
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"string": {
+			get: func() (any, diag.Diagnostics) {
+				return tfsdk.GetAs[string](ctx, config, path.Root("name"))
+			},
+			expected: "namevalue",
+		},
+		"pointer-null": {
+			get: func() (any, diag.Diagnostics) {
+				return tfsdk.GetAs[*string](ctx, state, path.Root("name"))
+			},
+			expected: (*string)(nil),
+		},
+		"conversion-error": {
+			get: func() (any, diag.Diagnostics) {
+				return tfsdk.GetAs[string](ctx, state, path.Root("name"))
+			},
+			expected: "",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: name\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.get()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
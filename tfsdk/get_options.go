@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// GetOptions configures the GetWithOpts methods of Config, Plan, and State.
+// By default, null and unknown values can only be read into types which can
+// represent them, such as pointers and framework types like types.String.
+// The options allow reading them into other Go types, such as string or
+// int64, when provider logic does not need to distinguish them from empty
+// values.
+type GetOptions struct {
+	// UnhandledNullAsEmpty converts null values into the zero value of the
+	// target type, such as "" for a string, if the target type cannot
+	// represent null values.
+	UnhandledNullAsEmpty bool
+
+	// UnhandledUnknownAsEmpty converts unknown values into the zero value of
+	// the target type, such as "" for a string, if the target type cannot
+	// represent unknown values. This is useful for models which include
+	// computed attributes, whose values are unknown in the plan.
+	UnhandledUnknownAsEmpty bool
+}
+
+// reflectOptions returns the internal reflection options equivalent of the
+// GetOptions.
+func (o GetOptions) reflectOptions() reflect.Options {
+	return reflect.Options{
+		UnhandledNullAsEmpty:    o.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: o.UnhandledUnknownAsEmpty,
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+)
+
+// PathValue is an attribute path and the value to set at that path, for use
+// with the SetAttributes methods.
+//
+//	diags := resp.State.SetAttributes(ctx, []tfsdk.PathValue{
+//		{Path: path.Root("name"), Value: types.StringValue(thing.Name)},
+//		{Path: path.Root("network").AtName("endpoint"), Value: types.StringValue(thing.Endpoint)},
+//	})
+type PathValue = fwschemadata.PathValue
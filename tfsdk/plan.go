@@ -24,6 +24,13 @@ func (p Plan) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 	return p.data().Get(ctx, target)
 }
 
+// GetWithOpts populates the struct passed as `target` with the entire
+// plan, using the given options for null and unknown values which the
+// `target` types cannot represent.
+func (p Plan) GetWithOpts(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return p.data().GetWithOptions(ctx, target, opts.reflectOptions())
+}
+
 // GetAttribute retrieves the attribute or block found at `path` and populates
 // the `target` with the value. This method is intended for top level schema
 // attributes or blocks. Use `types` package methods or custom types to step
@@ -35,6 +42,19 @@ func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface
 	return p.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributeOrNull retrieves the attribute or block found at `path` and
+// populates the `target` with the value, returning true, if the value and all
+// of its parents are known and not null. Otherwise, such as when a parent
+// object is null or a list element does not exist, the `target` is set to its
+// zero value and false is returned without diagnostics. Framework types, such
+// as types.String, have a null zero value.
+//
+// Diagnostics are still returned if the path is not valid for the schema or
+// the value cannot be populated into the `target`.
+func (p Plan) GetAttributeOrNull(ctx context.Context, path path.Path, target interface{}) (bool, diag.Diagnostics) {
+	return p.data().GetAtPathOrNull(ctx, path, target)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -44,6 +64,26 @@ func (p Plan) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 	return p.data().PathMatches(ctx, pathExpr)
 }
 
+// Walk calls the given function for each attribute, block, and collection
+// element value in the plan, parents before their nested values. This is
+// intended for generic logic which applies across a whole schema, such as
+// finding all values of a certain type.
+//
+// Values nested under null or unknown values are not visited, nor are values
+// nested under dynamic values. The walk ends early if the function returns
+// WalkStop or error diagnostics.
+func (p Plan) Walk(ctx context.Context, walkFunc WalkFunc) diag.Diagnostics {
+	return p.data().Walk(ctx, fwschemadataWalkFunc(walkFunc))
+}
+
+// WalkWithSchema is the same as Walk, except the function also receives the
+// schema attribute or block of each value. This is intended for generic logic
+// which depends on the schema definition, such as redacting the values of
+// Sensitive attributes.
+func (p Plan) WalkWithSchema(ctx context.Context, walkFunc WalkWithSchemaFunc) diag.Diagnostics {
+	return p.data().WalkWithSchema(ctx, fwschemadataWalkWithSchemaFunc(walkFunc))
+}
+
 // Set populates the entire plan using the supplied Go value. The value `val`
 // should be a struct whose values have one of the attr.Value types. Each field
 // must be tagged with the corresponding schema field.
@@ -85,6 +125,67 @@ func (p *Plan) SetAttribute(ctx context.Context, path path.Path, val interface{}
 	return diags
 }
 
+// SetAttributes sets the attribute at each path using the supplied values,
+// such as when assembling data from many independent API fields.
+//
+// Each path and value must be valid with the current schema, following the
+// same rules as SetAttribute, and each path must only be given once. Every
+// value is validated before any value is set and the diagnostics for all
+// paths are returned together. If there are any error diagnostics, no values
+// are set.
+func (p *Plan) SetAttributes(ctx context.Context, values []PathValue) diag.Diagnostics {
+	data := p.data()
+	diags := data.SetAtPaths(ctx, values)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Raw = data.TerraformValue
+
+	return diags
+}
+
+// SetNull sets the attribute at `path` to a null value, without needing to
+// know the value type at that path.
+//
+// The attribute path must be valid with the current schema. If the attribute
+// path does not have a value, it will be added, including any parent
+// attribute paths as necessary.
+func (p *Plan) SetNull(ctx context.Context, path path.Path) diag.Diagnostics {
+	data := p.data()
+	diags := data.SetNullAtPath(ctx, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Raw = data.TerraformValue
+
+	return diags
+}
+
+// SetUnknown sets the attribute at `path` to an unknown value, without
+// needing to know the value type at that path. This is typically used in
+// resource plan modification to mark a computed attribute as "known after
+// apply".
+//
+// The attribute path must be valid with the current schema. If the attribute
+// path does not have a value, it will be added, including any parent
+// attribute paths as necessary.
+func (p *Plan) SetUnknown(ctx context.Context, path path.Path) diag.Diagnostics {
+	data := p.data()
+	diags := data.SetUnknownAtPath(ctx, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Raw = data.TerraformValue
+
+	return diags
+}
+
 func (p Plan) data() *fwschemadata.Data {
 	return &fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionPlan,
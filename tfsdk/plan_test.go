@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -123,6 +124,91 @@ func TestPlanGet(t *testing.T) {
 	}
 }
 
+func TestPlanGetWithOpts(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		ID   string `tfsdk:"id"`
+		Name string `tfsdk:"name"`
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testRaw := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testCases := map[string]struct {
+		opts          tfsdk.GetOptions
+		expected      testModel
+		expectedDiags diag.Diagnostics
+	}{
+		"options": {
+			opts: tfsdk.GetOptions{
+				UnhandledNullAsEmpty:    true,
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: testModel{
+				ID: "",
+			},
+		},
+		"no-options": {
+			opts: tfsdk.GetOptions{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values."+"\n\n"+
+						"Path: id\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tfsdk.Plan{
+				Raw:    testRaw,
+				Schema: testSchema,
+			}
+
+			var got testModel
+
+			diags := plan.GetWithOpts(context.Background(), &got, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestPlanGetAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -485,3 +571,355 @@ func TestPlanSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanSetNull(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		plan          tfsdk.Plan
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataSetNullAtPath for more exhaustive unit
+		// testing. These test cases are to ensure Plan schema and data values
+		// are passed appropriately to the shared implementation.
+		"valid": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":  tftypes.String,
+						"other": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test":  tftypes.NewValue(tftypes.String, "originalvalue"),
+					"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test"),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":  tftypes.String,
+					"other": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test":  tftypes.NewValue(tftypes.String, nil),
+				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+			}),
+		},
+		"invalid-path": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+					},
+				},
+			},
+			path: path.Root("other"),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"Plan Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.plan.SetNull(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				for _, diagnostic := range diags {
+					t.Log(diagnostic)
+				}
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.plan.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanSetUnknown(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		plan          tfsdk.Plan
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataSetUnknownAtPath for more exhaustive unit
+		// testing. These test cases are to ensure Plan schema and data values
+		// are passed appropriately to the shared implementation.
+		"valid": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":  tftypes.String,
+						"other": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test":  tftypes.NewValue(tftypes.String, "originalvalue"),
+					"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test"),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":  tftypes.String,
+					"other": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+			}),
+		},
+		"invalid-path": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+					},
+				},
+			},
+			path: path.Root("other"),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"Plan Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.plan.SetUnknown(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				for _, diagnostic := range diags {
+					t.Log(diagnostic)
+				}
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.plan.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanWalk(t *testing.T) {
+	t.Parallel()
+
+	type visit struct {
+		Path  path.Path
+		Value attr.Value
+	}
+
+	type testCase struct {
+		plan           tfsdk.Plan
+		control        tfsdk.WalkControl
+		expectedVisits []visit
+	}
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list": tftypes.List{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "element"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testschema.Attribute{
+					Type:     types.ListType{ElemType: types.StringType},
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	testListValue := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("element")})
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataWalk for more exhaustive unit testing.
+		// These test cases are to ensure Plan schema and data values are
+		// passed appropriately to the shared implementation.
+		"WalkContinue": {
+			plan:    testPlan,
+			control: tfsdk.WalkContinue,
+			expectedVisits: []visit{
+				{Path: path.Root("list"), Value: testListValue},
+				{Path: path.Root("list").AtListIndex(0), Value: types.StringValue("element")},
+			},
+		},
+		"WalkSkipChildren": {
+			plan:    testPlan,
+			control: tfsdk.WalkSkipChildren,
+			expectedVisits: []visit{
+				{Path: path.Root("list"), Value: testListValue},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []visit
+
+			diags := tc.plan.Walk(context.Background(), func(p path.Path, v attr.Value) (tfsdk.WalkControl, diag.Diagnostics) {
+				got = append(got, visit{Path: p, Value: v})
+
+				return tc.control, nil
+			})
+
+			if len(diags) > 0 {
+				t.Errorf("unexpected diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, tc.expectedVisits); diff != "" {
+				t.Errorf("unexpected visits (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanWalkWithSchema(t *testing.T) {
+	t.Parallel()
+
+	testAttribute := testschema.Attribute{
+		Type:     types.ListType{ElemType: types.StringType},
+		Optional: true,
+	}
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list": tftypes.List{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "element"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testAttribute,
+			},
+		},
+	}
+
+	// Refer to fwschemadata.TestDataWalkWithSchema for more exhaustive unit
+	// testing. This test is to ensure Plan schema and data values are
+	// passed appropriately to the shared implementation.
+	expected := []tfsdk.WalkStep{
+		{
+			Path:      path.Root("list"),
+			Value:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("element")}),
+			Attribute: testAttribute,
+		},
+		{
+			Path:  path.Root("list").AtListIndex(0),
+			Value: types.StringValue("element"),
+		},
+	}
+
+	var got []tfsdk.WalkStep
+
+	diags := testPlan.WalkWithSchema(context.Background(), func(step tfsdk.WalkStep) (tfsdk.WalkControl, diag.Diagnostics) {
+		got = append(got, step)
+
+		return tfsdk.WalkContinue, nil
+	})
+
+	if len(diags) > 0 {
+		t.Errorf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected steps (+wanted, -got): %s", diff)
+	}
+}
@@ -26,6 +26,13 @@ func (s State) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 	return s.data().Get(ctx, target)
 }
 
+// GetWithOpts populates the struct passed as `target` with the entire
+// state, using the given options for null and unknown values which the
+// `target` types cannot represent.
+func (s State) GetWithOpts(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return s.data().GetWithOptions(ctx, target, opts.reflectOptions())
+}
+
 // GetAttribute retrieves the attribute or block found at `path` and populates
 // the `target` with the value. This method is intended for top level schema
 // attributes or blocks. Use `types` package methods or custom types to step
@@ -37,6 +44,19 @@ func (s State) GetAttribute(ctx context.Context, path path.Path, target interfac
 	return s.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributeOrNull retrieves the attribute or block found at `path` and
+// populates the `target` with the value, returning true, if the value and all
+// of its parents are known and not null. Otherwise, such as when a parent
+// object is null or a list element does not exist, the `target` is set to its
+// zero value and false is returned without diagnostics. Framework types, such
+// as types.String, have a null zero value.
+//
+// Diagnostics are still returned if the path is not valid for the schema or
+// the value cannot be populated into the `target`.
+func (s State) GetAttributeOrNull(ctx context.Context, path path.Path, target interface{}) (bool, diag.Diagnostics) {
+	return s.data().GetAtPathOrNull(ctx, path, target)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -46,6 +66,26 @@ func (s State) PathMatches(ctx context.Context, pathExpr path.Expression) (path.
 	return s.data().PathMatches(ctx, pathExpr)
 }
 
+// Walk calls the given function for each attribute, block, and collection
+// element value in the state, parents before their nested values. This is
+// intended for generic logic which applies across a whole schema, such as
+// finding all values of a certain type.
+//
+// Values nested under null or unknown values are not visited, nor are values
+// nested under dynamic values. The walk ends early if the function returns
+// WalkStop or error diagnostics.
+func (s State) Walk(ctx context.Context, walkFunc WalkFunc) diag.Diagnostics {
+	return s.data().Walk(ctx, fwschemadataWalkFunc(walkFunc))
+}
+
+// WalkWithSchema is the same as Walk, except the function also receives the
+// schema attribute or block of each value. This is intended for generic logic
+// which depends on the schema definition, such as redacting the values of
+// Sensitive attributes.
+func (s State) WalkWithSchema(ctx context.Context, walkFunc WalkWithSchemaFunc) diag.Diagnostics {
+	return s.data().WalkWithSchema(ctx, fwschemadataWalkWithSchemaFunc(walkFunc))
+}
+
 // Set populates the entire state using the supplied Go value. The value `val`
 // should be a struct whose values have one of the attr.Value types. Each field
 // must be tagged with the corresponding schema field.
@@ -97,6 +137,112 @@ func (s *State) SetAttribute(ctx context.Context, path path.Path, val interface{
 	return diags
 }
 
+// SetAttributes sets the attribute at each path using the supplied values,
+// such as when assembling data from many independent API fields.
+//
+// Each path and value must be valid with the current schema, following the
+// same rules as SetAttribute, and each path must only be given once. Every
+// value is validated before any value is set and the diagnostics for all
+// paths are returned together. If there are any error diagnostics, no values
+// are set.
+func (s *State) SetAttributes(ctx context.Context, values []PathValue) diag.Diagnostics {
+	data := s.data()
+	diags := data.SetAtPaths(ctx, values)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
+// SetNull sets the attribute at `path` to a null value, without needing to
+// know the value type at that path. State cannot contain unknown values, so
+// there is no equivalent for setting unknown values.
+//
+// The attribute path must be valid with the current schema. If the attribute
+// path does not have a value, it will be added, including any parent
+// attribute paths as necessary.
+func (s *State) SetNull(ctx context.Context, path path.Path) diag.Diagnostics {
+	data := s.data()
+	diags := data.SetNullAtPath(ctx, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
+// Remove removes the attribute, block, or collection element at `path`. This
+// is useful in UpgradeState and MoveState implementations which drop legacy
+// data.
+//
+// The attribute path must be valid with the current schema. Attributes and
+// blocks are set to null, since they cannot be removed from their parent
+// object. Map keys, list elements, and set elements are removed from their
+// parent collection, with later list elements shifting down by one index. If
+// there is no value at the path, this is a no-op.
+func (s *State) Remove(ctx context.Context, path path.Path) diag.Diagnostics {
+	data := s.data()
+	diags := data.RemoveAtPath(ctx, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
+// Snapshot returns a point-in-time copy of the entire state, which can later
+// be passed to Restore. This is useful in resource logic with multiple steps,
+// such as an Update method calling multiple APIs, where state is updated
+// after each successful step and a later failing step should revert to the
+// last known-good state before returning diagnostics.
+//
+// Private state data is not included in the snapshot.
+func (s State) Snapshot() StateSnapshot {
+	return StateSnapshot{
+		raw:    s.Raw,
+		schema: s.Schema,
+	}
+}
+
+// Restore overwrites the entire state with the given snapshot, which must have
+// been created by calling Snapshot on a state with the same schema type.
+func (s *State) Restore(ctx context.Context, snapshot StateSnapshot) diag.Diagnostics {
+	if snapshot.schema == nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"State Restore Error",
+				"An unexpected error was encountered trying to restore the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"The state snapshot is empty. Create snapshots with the State.Snapshot method.",
+			),
+		}
+	}
+
+	if s.Schema != nil && !s.Schema.Type().TerraformType(ctx).Equal(snapshot.schema.Type().TerraformType(ctx)) {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"State Restore Error",
+				"An unexpected error was encountered trying to restore the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"The state snapshot schema type does not match the state schema type. Snapshots can only be restored into state for the same resource type.",
+			),
+		}
+	}
+
+	s.Raw = snapshot.raw
+	s.Schema = snapshot.schema
+
+	return nil
+}
+
 // RemoveResource removes the entire resource from state.
 //
 // If a Resource type Delete method is completed without error, this is
@@ -112,3 +258,10 @@ func (s State) data() fwschemadata.Data {
 		TerraformValue: s.Raw,
 	}
 }
+
+// StateSnapshot is a point-in-time copy of a State, created by the
+// State.Snapshot method and applied with the State.Restore method.
+type StateSnapshot struct {
+	raw    tftypes.Value
+	schema fwschema.Schema
+}
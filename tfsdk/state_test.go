@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -123,6 +124,91 @@ func TestStateGet(t *testing.T) {
 	}
 }
 
+func TestStateGetWithOpts(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		ID   string `tfsdk:"id"`
+		Name string `tfsdk:"name"`
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testRaw := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "test-id"),
+		"name": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testCases := map[string]struct {
+		opts          tfsdk.GetOptions
+		expected      testModel
+		expectedDiags diag.Diagnostics
+	}{
+		"options": {
+			opts: tfsdk.GetOptions{
+				UnhandledNullAsEmpty:    true,
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: testModel{
+				ID: "test-id",
+			},
+		},
+		"no-options": {
+			opts: tfsdk.GetOptions{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values."+"\n\n"+
+						"Path: name\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := tfsdk.State{
+				Raw:    testRaw,
+				Schema: testSchema,
+			}
+
+			var got testModel
+
+			diags := state.GetWithOpts(context.Background(), &got, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateGetAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -200,6 +286,96 @@ func TestStateGetAttribute(t *testing.T) {
 	}
 }
 
+func TestStateGetAttributeOrNull(t *testing.T) {
+	t.Parallel()
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"parent": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Optional: true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		state         tfsdk.State
+		expected      types.String
+		expectedFound bool
+		expectedDiags diag.Diagnostics
+	}{
+		// Refer to fwschemadata.TestDataGetAtPathOrNull for more exhaustive
+		// unit testing. These test cases are to ensure State schema and data
+		// values are passed appropriately to the shared implementation.
+		"null-parent": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"parent": testNestedType,
+					},
+				}, map[string]tftypes.Value{
+					"parent": tftypes.NewValue(testNestedType, nil),
+				}),
+				Schema: testSchema,
+			},
+			expected: types.StringNull(),
+		},
+		"valid": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"parent": testNestedType,
+					},
+				}, map[string]tftypes.Value{
+					"parent": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "namevalue"),
+					}),
+				}),
+				Schema: testSchema,
+			},
+			expected:      types.StringValue("namevalue"),
+			expectedFound: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got types.String
+
+			found, diags := tc.state.GetAttributeOrNull(context.Background(), path.Root("parent").AtName("name"), &got)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if found != tc.expectedFound {
+				t.Errorf("expected found %t, got %t", tc.expectedFound, found)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateSet(t *testing.T) {
 	t.Parallel()
 
@@ -392,3 +568,565 @@ func TestStateSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestStateSetAttributes(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"other": tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+			"other": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testOldValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, "oldvalue"),
+		"other": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testCases := map[string]struct {
+		state         tfsdk.State
+		values        []tfsdk.PathValue
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		// Refer to fwschemadata.TestDataSetAtPaths for more exhaustive unit
+		// testing. These test cases are to ensure State schema and data
+		// values are passed appropriately to the shared implementation.
+		"valid": {
+			state: tfsdk.State{
+				Raw:    testOldValue,
+				Schema: testSchema,
+			},
+			values: []tfsdk.PathValue{
+				{Path: path.Root("name"), Value: types.StringValue("newvalue")},
+				{Path: path.Root("other"), Value: types.StringValue("othervalue")},
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, "newvalue"),
+				"other": tftypes.NewValue(tftypes.String, "othervalue"),
+			}),
+		},
+		"diagnostics": {
+			state: tfsdk.State{
+				Raw:    testOldValue,
+				Schema: testSchema,
+			},
+			values: []tfsdk.PathValue{
+				{Path: path.Root("name"), Value: types.StringValue("newvalue")},
+				{Path: path.Root("other"), Value: types.BoolValue(true)},
+			},
+			expected: testOldValue,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"Value Conversion Error",
+					"An unexpected error was encountered while verifying an attribute value matched its expected type to prevent unexpected behavior or panics. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected framework type from provider logic: basetypes.StringType / underlying type: tftypes.String\n"+
+						"Received framework type from provider logic: basetypes.BoolType / underlying type: tftypes.Bool\n"+
+						"Path: other",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.state.SetAttributes(context.Background(), tc.values)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateSetNull(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		state         tfsdk.State
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataSetNullAtPath for more exhaustive unit
+		// testing. These test cases are to ensure State schema and data values
+		// are passed appropriately to the shared implementation.
+		"valid": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":  tftypes.String,
+						"other": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test":  tftypes.NewValue(tftypes.String, "originalvalue"),
+					"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test"),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":  tftypes.String,
+					"other": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test":  tftypes.NewValue(tftypes.String, nil),
+				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+			}),
+		},
+		"invalid-path": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+					},
+				},
+			},
+			path: path.Root("other"),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"State Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.state.SetNull(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				for _, diagnostic := range diags {
+					t.Log(diagnostic)
+				}
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateRemove(t *testing.T) {
+	t.Parallel()
+
+	testMapType := tftypes.Map{ElementType: tftypes.String}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test":  tftypes.String,
+			"other": testMapType,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"other": testschema.Attribute{
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+		"other": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+			"key1": tftypes.NewValue(tftypes.String, "value1"),
+			"key2": tftypes.NewValue(tftypes.String, "value2"),
+		}),
+	})
+
+	type testCase struct {
+		state         tfsdk.State
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataRemoveAtPath for more exhaustive unit
+		// testing. These test cases are to ensure State schema and data values
+		// are passed appropriately to the shared implementation.
+		"attribute": {
+			state: tfsdk.State{
+				Raw:    testValue,
+				Schema: testSchema,
+			},
+			path: path.Root("test"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, nil),
+				"other": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"key1": tftypes.NewValue(tftypes.String, "value1"),
+					"key2": tftypes.NewValue(tftypes.String, "value2"),
+				}),
+			}),
+		},
+		"map-key": {
+			state: tfsdk.State{
+				Raw:    testValue,
+				Schema: testSchema,
+			},
+			path: path.Root("other").AtMapKey("key1"),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "originalvalue"),
+				"other": tftypes.NewValue(testMapType, map[string]tftypes.Value{
+					"key2": tftypes.NewValue(tftypes.String, "value2"),
+				}),
+			}),
+		},
+		"invalid-path": {
+			state: tfsdk.State{
+				Raw:    testValue,
+				Schema: testSchema,
+			},
+			path:     path.Root("missing"),
+			expected: testValue,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"State Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.state.Remove(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	otherSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"other": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	snapshotValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "snapshotvalue"),
+	})
+
+	testCases := map[string]struct {
+		snapshot      func() tfsdk.StateSnapshot
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"snapshot": {
+			snapshot: func() tfsdk.StateSnapshot {
+				state := tfsdk.State{
+					Raw:    snapshotValue,
+					Schema: testSchema,
+				}
+
+				snapshot := state.Snapshot()
+
+				// Modifying the state after the snapshot should not affect
+				// the snapshot.
+				state.SetAttribute(context.Background(), path.Root("test"), "changedvalue")
+
+				return snapshot
+			},
+			expected: snapshotValue,
+		},
+		"snapshot-empty": {
+			snapshot: func() tfsdk.StateSnapshot {
+				return tfsdk.StateSnapshot{}
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "currentvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Restore Error",
+					"An unexpected error was encountered trying to restore the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The state snapshot is empty. Create snapshots with the State.Snapshot method.",
+				),
+			},
+		},
+		"snapshot-schema-mismatch": {
+			snapshot: func() tfsdk.StateSnapshot {
+				state := tfsdk.State{
+					Raw: tftypes.NewValue(otherSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+						"other": tftypes.NewValue(tftypes.String, "othervalue"),
+					}),
+					Schema: otherSchema,
+				}
+
+				return state.Snapshot()
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "currentvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Restore Error",
+					"An unexpected error was encountered trying to restore the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The state snapshot schema type does not match the state schema type. Snapshots can only be restored into state for the same resource type.",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := tfsdk.State{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "currentvalue"),
+				}),
+				Schema: testSchema,
+			}
+
+			diags := state.Restore(context.Background(), tc.snapshot())
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateWalk(t *testing.T) {
+	t.Parallel()
+
+	type visit struct {
+		Path  path.Path
+		Value attr.Value
+	}
+
+	type testCase struct {
+		state          tfsdk.State
+		control        tfsdk.WalkControl
+		expectedVisits []visit
+	}
+
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list": tftypes.List{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "element"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testschema.Attribute{
+					Type:     types.ListType{ElemType: types.StringType},
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	testListValue := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("element")})
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataWalk for more exhaustive unit testing.
+		// These test cases are to ensure State schema and data values are
+		// passed appropriately to the shared implementation.
+		"WalkContinue": {
+			state:   testState,
+			control: tfsdk.WalkContinue,
+			expectedVisits: []visit{
+				{Path: path.Root("list"), Value: testListValue},
+				{Path: path.Root("list").AtListIndex(0), Value: types.StringValue("element")},
+			},
+		},
+		"WalkSkipChildren": {
+			state:   testState,
+			control: tfsdk.WalkSkipChildren,
+			expectedVisits: []visit{
+				{Path: path.Root("list"), Value: testListValue},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []visit
+
+			diags := tc.state.Walk(context.Background(), func(p path.Path, v attr.Value) (tfsdk.WalkControl, diag.Diagnostics) {
+				got = append(got, visit{Path: p, Value: v})
+
+				return tc.control, nil
+			})
+
+			if len(diags) > 0 {
+				t.Errorf("unexpected diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, tc.expectedVisits); diff != "" {
+				t.Errorf("unexpected visits (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateWalkWithSchema(t *testing.T) {
+	t.Parallel()
+
+	testAttribute := testschema.Attribute{
+		Type:     types.ListType{ElemType: types.StringType},
+		Optional: true,
+	}
+
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"list": tftypes.List{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "element"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testAttribute,
+			},
+		},
+	}
+
+	// Refer to fwschemadata.TestDataWalkWithSchema for more exhaustive unit
+	// testing. This test is to ensure State schema and data values are
+	// passed appropriately to the shared implementation.
+	expected := []tfsdk.WalkStep{
+		{
+			Path:      path.Root("list"),
+			Value:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("element")}),
+			Attribute: testAttribute,
+		},
+		{
+			Path:  path.Root("list").AtListIndex(0),
+			Value: types.StringValue("element"),
+		},
+	}
+
+	var got []tfsdk.WalkStep
+
+	diags := testState.WalkWithSchema(context.Background(), func(step tfsdk.WalkStep) (tfsdk.WalkControl, diag.Diagnostics) {
+		got = append(got, step)
+
+		return tfsdk.WalkContinue, nil
+	})
+
+	if len(diags) > 0 {
+		t.Errorf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected steps (+wanted, -got): %s", diff)
+	}
+}
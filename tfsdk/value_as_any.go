@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Unknown is the Go value representation of an unknown value returned by
+// ValueAsAny and the types.Object ToGoMap method.
+type Unknown = reflect.Unknown
+
+// ValueAsAny returns the attr.Value as a plain Go value, which is intended for
+// passing data to JSON-based logic without defining a Go type for the value.
+// The conversion rules are:
+//
+//   - Null values are nil.
+//   - Unknown values are Unknown.
+//   - Bool values are bool.
+//   - Number values, including Float32, Float64, Int32, and Int64 values, are
+//     int64 if they are an integer within the int64 range, float64 if they
+//     can be exactly represented as a float64, otherwise *big.Float.
+//   - String values are string.
+//   - List, Set, and Tuple values are []any.
+//   - Map and Object values are map[string]any.
+//   - Dynamic values use the rules of the underlying value type.
+//
+// Custom value types are converted using their Terraform representation.
+func ValueAsAny(ctx context.Context, val attr.Value) (any, diag.Diagnostics) {
+	return reflect.AttributeGoValue(ctx, val, path.Empty())
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValueAsAny(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val           attr.Value
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			val:      nil,
+			expected: nil,
+		},
+		"null": {
+			val:      types.StringNull(),
+			expected: nil,
+		},
+		"unknown": {
+			val:      types.StringUnknown(),
+			expected: tfsdk.Unknown{},
+		},
+		"float64": {
+			val:      types.Float64Value(1.5),
+			expected: float64(1.5),
+		},
+		"int64": {
+			val:      types.Int64Value(123),
+			expected: int64(123),
+		},
+		"dynamic": {
+			val:      types.DynamicValue(types.StringValue("test")),
+			expected: "test",
+		},
+		"dynamic-unknown": {
+			val:      types.DynamicUnknown(),
+			expected: tfsdk.Unknown{},
+		},
+		"map": {
+			val: types.MapValueMust(
+				types.StringType,
+				map[string]attr.Value{
+					"key": types.StringValue("value"),
+				},
+			),
+			expected: map[string]any{
+				"key": "value",
+			},
+		},
+		"object": {
+			val: types.ObjectValueMust(
+				map[string]attr.Type{
+					"list": types.ListType{ElemType: types.BoolType},
+				},
+				map[string]attr.Value{
+					"list": types.ListValueMust(
+						types.BoolType,
+						[]attr.Value{
+							types.BoolValue(true),
+							types.BoolUnknown(),
+						},
+					),
+				},
+			),
+			expected: map[string]any{
+				"list": []any{true, tfsdk.Unknown{}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.ValueAsAny(context.Background(), testCase.val)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
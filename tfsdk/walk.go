@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// WalkControl determines how a Walk method proceeds after visiting a value.
+type WalkControl int
+
+const (
+	// WalkContinue continues the walk, including any nested values.
+	WalkContinue WalkControl = iota
+
+	// WalkSkipChildren continues the walk, excluding any nested values of
+	// the current value.
+	WalkSkipChildren
+
+	// WalkStop ends the walk.
+	WalkStop
+)
+
+// WalkFunc is called by the Config, Plan, and State type Walk methods for
+// each value. The path is the location of the value in the schema and the
+// value is the same as would be returned by GetAttribute with that path.
+type WalkFunc func(path.Path, attr.Value) (WalkControl, diag.Diagnostics)
+
+// WalkStep is the schema and value information for a value visited by the
+// Config, Plan, and State type WalkWithSchema methods.
+type WalkStep struct {
+	// Path is the location of the value in the schema.
+	Path path.Path
+
+	// Value is the value at Path, which is the same as would be returned by
+	// GetAttribute with that path.
+	Value attr.Value
+
+	// Attribute is the schema attribute at Path, such as a
+	// resource/schema.StringAttribute, or nil if Path is a block or a
+	// collection element. The attribute of a collection element is the
+	// Attribute of the step which visited the collection.
+	Attribute fwschema.Attribute
+
+	// Block is the schema block at Path, such as a
+	// resource/schema.ListNestedBlock, or nil if Path is an attribute or a
+	// collection element.
+	Block fwschema.Block
+}
+
+// WalkWithSchemaFunc is called by the Config, Plan, and State type
+// WalkWithSchema methods for each value.
+type WalkWithSchemaFunc func(WalkStep) (WalkControl, diag.Diagnostics)
+
+// fwschemadataWalkWithSchemaFunc returns a fwschemadata.WalkWithSchemaFunc
+// which calls the given WalkWithSchemaFunc.
+func fwschemadataWalkWithSchemaFunc(walkFunc WalkWithSchemaFunc) fwschemadata.WalkWithSchemaFunc {
+	return func(step fwschemadata.WalkStep) (fwschemadata.WalkControl, diag.Diagnostics) {
+		control, diags := walkFunc(WalkStep{
+			Path:      step.Path,
+			Value:     step.Value,
+			Attribute: step.Attribute,
+			Block:     step.Block,
+		})
+
+		return fwschemadataWalkControl(control), diags
+	}
+}
+
+// fwschemadataWalkFunc returns a fwschemadata.WalkFunc which calls the given
+// WalkFunc.
+func fwschemadataWalkFunc(walkFunc WalkFunc) fwschemadata.WalkFunc {
+	return func(p path.Path, v attr.Value) (fwschemadata.WalkControl, diag.Diagnostics) {
+		control, diags := walkFunc(p, v)
+
+		return fwschemadataWalkControl(control), diags
+	}
+}
+
+// fwschemadataWalkControl returns the fwschemadata.WalkControl equivalent of
+// the given WalkControl.
+func fwschemadataWalkControl(control WalkControl) fwschemadata.WalkControl {
+	switch control {
+	case WalkSkipChildren:
+		return fwschemadata.WalkSkipChildren
+	case WalkStop:
+		return fwschemadata.WalkStop
+	default:
+		return fwschemadata.WalkContinue
+	}
+}
@@ -190,6 +190,20 @@ func (f Float64Value) String() string {
 	return fmt.Sprintf("%f", f64)
 }
 
+// WithFormat returns the Float64 with its known value formatted according
+// to the NumberFormat, such as before setting the value into state. Null and
+// unknown values are returned unmodified.
+func (f Float64Value) WithFormat(format NumberFormat) Float64Value {
+	if f.state != attr.ValueStateKnown {
+		return f
+	}
+
+	return Float64Value{
+		state: attr.ValueStateKnown,
+		value: format.Apply(f.value),
+	}
+}
+
 // ValueFloat64 returns the known float64 value. If Float64 is null or unknown, returns
 // 0.0.
 func (f Float64Value) ValueFloat64() float64 {
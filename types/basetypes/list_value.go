@@ -122,6 +122,51 @@ func NewListValueFrom(ctx context.Context, elementType attr.Type, elements any)
 	return list, diags
 }
 
+// NewListValueWithUnknownElements creates a List with a known value of the
+// given length, where each element is unknown. If the element type is an
+// object type, such as the element type of a nested attribute, each element is
+// a known object where every attribute is unknown. Use this during plan
+// modification when the number of elements is known before apply, such as from
+// configuration, but the element values are not. Unlike an entirely unknown
+// List, each element is shown in plan output. Access the value via
+// the List type Elements or ElementsAs methods.
+func NewListValueWithUnknownElements(ctx context.Context, elementType attr.Type, length int) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if length < 0 {
+		diags.AddError(
+			"Invalid List Length",
+			"An unexpected negative length was given while creating a List with unknown elements. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("List Length: %d", length),
+		)
+
+		return NewListUnknown(elementType), diags
+	}
+
+	unknownElement, err := unknownCollectionElement(ctx, elementType)
+
+	if err != nil {
+		diags.AddError(
+			"Error Creating Unknown List Element",
+			"An unexpected error occurred while creating an unknown List element value. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("List Element Type: %s\n", elementType.String())+
+				"Error: "+err.Error(),
+		)
+
+		return NewListUnknown(elementType), diags
+	}
+
+	elements := make([]attr.Value, length)
+
+	for idx := range elements {
+		elements[idx] = unknownElement
+	}
+
+	return NewListValue(elementType, elements)
+}
+
 // NewListValueMust creates a List with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the List
 // type Elements or ElementsAs methods.
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ListValueBuilder incrementally constructs a known ListValue. Elements are
+// type checked as they are appended, so building a List with many elements,
+// such as when reading paginated remote data, does not require repeatedly
+// creating new List values from a growing slice. Call Freeze to create the
+// ListValue once all elements are appended.
+//
+// A ListValueBuilder is safe for concurrent use, however elements appended
+// concurrently are ordered by when each Append call occurs. Create a
+// ListValueBuilder with NewListValueBuilder.
+type ListValueBuilder struct {
+	// elements is the collection of appended values.
+	elements []attr.Value
+
+	// elementType is the type of the elements in the List.
+	elementType attr.Type
+
+	// frozen is true after Freeze is called.
+	frozen bool
+
+	// mu protects elements and frozen.
+	mu sync.Mutex
+}
+
+// NewListValueBuilder creates a ListValueBuilder for the given element type.
+func NewListValueBuilder(elementType attr.Type) *ListValueBuilder {
+	return &ListValueBuilder{
+		elementType: elementType,
+	}
+}
+
+// Append adds the given elements to the end of the List. No elements are
+// added if any element is not of the List element type or if Freeze was
+// already called.
+func (b *ListValueBuilder) Append(ctx context.Context, elements ...attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.frozen {
+		diags.Append(builderFrozenDiagnostic("List"))
+
+		return diags
+	}
+
+	for idx, element := range elements {
+		if element == nil || !b.elementType.Equal(element.Type(ctx)) {
+			diags.AddError(
+				"Invalid List Element Type",
+				"While appending to a List value, an invalid element was detected. "+
+					"A List must use the single, given element type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("List Element Type: %s\n", b.elementType.String())+
+					fmt.Sprintf("List Index (%d) Element Type: %s", len(b.elements)+idx, elementTypeString(ctx, element)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	b.elements = append(b.elements, elements...)
+
+	return diags
+}
+
+// AppendFrom adds the given element to the end of the List, using reflection
+// rules. The element must convert into the List element type. The element is
+// not added if it cannot be converted or if Freeze was already called.
+func (b *ListValueBuilder) AppendFrom(ctx context.Context, element any) diag.Diagnostics {
+	value, diags := reflect.FromValue(ctx, b.elementType, element, path.Empty())
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(b.Append(ctx, value)...)
+
+	return diags
+}
+
+// Len returns the number of elements appended to the List.
+func (b *ListValueBuilder) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.elements)
+}
+
+// Freeze returns the ListValue of all appended elements. Any further calls to
+// Append or AppendFrom return an error diagnostic, while further calls to
+// Freeze return an equal ListValue.
+func (b *ListValueBuilder) Freeze() ListValue {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.frozen = true
+
+	// Always return a known, non-nil list, even if there were no elements.
+	if b.elements == nil {
+		b.elements = []attr.Value{}
+	}
+
+	// Elements can no longer change, so the slice can be shared as ListValue
+	// never exposes it to callers.
+	return ListValue{
+		elementType: b.elementType,
+		elements:    b.elements,
+		state:       attr.ValueStateKnown,
+	}
+}
+
+// builderFrozenDiagnostic returns the error diagnostic for modifying a value
+// builder after Freeze was called.
+func builderFrozenDiagnostic(valueType string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Value Builder Already Frozen",
+		fmt.Sprintf("While building a %s value, elements were added after the value was created with Freeze. ", valueType)+
+			"This is always an issue with the provider and should be reported to the provider developers.",
+	)
+}
+
+// elementTypeString returns the type of the element for diagnostics, which
+// handles nil elements.
+func elementTypeString(ctx context.Context, element attr.Value) string {
+	if element == nil {
+		return "<nil>"
+	}
+
+	return element.Type(ctx).String()
+}
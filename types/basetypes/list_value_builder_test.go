@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestListValueBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		build         func(context.Context, *ListValueBuilder) diag.Diagnostics
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"no-elements": {
+			build: func(_ context.Context, _ *ListValueBuilder) diag.Diagnostics {
+				return nil
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"append": {
+			build: func(ctx context.Context, b *ListValueBuilder) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(b.Append(ctx, NewStringValue("one"), NewStringNull())...)
+				diags.Append(b.Append(ctx, NewStringUnknown())...)
+
+				return diags
+			},
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringNull(),
+					NewStringUnknown(),
+				},
+			),
+		},
+		"append-from": {
+			build: func(ctx context.Context, b *ListValueBuilder) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(b.AppendFrom(ctx, "one")...)
+				diags.Append(b.AppendFrom(ctx, "two")...)
+
+				return diags
+			},
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+					NewStringValue("two"),
+				},
+			),
+		},
+		"append-invalid-element-type": {
+			build: func(ctx context.Context, b *ListValueBuilder) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(b.Append(ctx, NewStringValue("one"))...)
+				diags.Append(b.Append(ctx, NewStringValue("two"), NewBoolValue(true))...)
+
+				return diags
+			},
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While appending to a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (2) Element Type: basetypes.BoolType",
+				),
+			},
+		},
+		"append-nil-element": {
+			build: func(ctx context.Context, b *ListValueBuilder) diag.Diagnostics {
+				return b.Append(ctx, nil)
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While appending to a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (0) Element Type: <nil>",
+				),
+			},
+		},
+		"append-from-invalid-value": {
+			build: func(ctx context.Context, b *ListValueBuilder) diag.Diagnostics {
+				return b.AppendFrom(ctx, true)
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"append-after-freeze": {
+			build: func(ctx context.Context, b *ListValueBuilder) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(b.Append(ctx, NewStringValue("one"))...)
+
+				b.Freeze()
+
+				diags.Append(b.Append(ctx, NewStringValue("two"))...)
+
+				return diags
+			},
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("one"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Builder Already Frozen",
+					"While building a List value, elements were added after the value was created with Freeze. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := NewListValueBuilder(StringType{})
+
+			diags := testCase.build(context.Background(), builder)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			got := builder.Freeze()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+
+			if len(got.Elements()) != builder.Len() {
+				t.Errorf("expected length %d, got %d", len(got.Elements()), builder.Len())
+			}
+		})
+	}
+}
+
+func TestListValueBuilderConcurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	builder := NewListValueBuilder(Int64Type{})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			if diags := builder.Append(ctx, NewInt64Value(int64(i))); diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	got := builder.Freeze()
+
+	if len(got.Elements()) != 100 {
+		t.Fatalf("expected 100 elements, got %d", len(got.Elements()))
+	}
+
+	seen := make(map[int64]bool, 100)
+
+	for _, element := range got.Elements() {
+		//nolint:forcetypeassert // Type is checked by Append
+		seen[element.(Int64Value).ValueInt64()] = true
+	}
+
+	if len(seen) != 100 {
+		t.Errorf("expected 100 unique elements, got %d", len(seen))
+	}
+}
@@ -202,6 +202,91 @@ func TestNewListValueFrom(t *testing.T) {
 	}
 }
 
+func TestNewListValueWithUnknownElements(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   StringType{},
+			"tags": ListType{ElemType: StringType{}},
+		},
+	}
+
+	testObjectValue := NewObjectValueMust(
+		testObjectType.AttrTypes,
+		map[string]attr.Value{
+			"id":   NewStringUnknown(),
+			"tags": NewListUnknown(StringType{}),
+		},
+	)
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		length        int
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			elementType: StringType{},
+			length:      0,
+			expected:    NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"primitive": {
+			elementType: StringType{},
+			length:      2,
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+					NewStringUnknown(),
+				},
+			),
+		},
+		"object": {
+			elementType: testObjectType,
+			length:      2,
+			expected: NewListValueMust(
+				testObjectType,
+				[]attr.Value{
+					testObjectValue,
+					testObjectValue,
+				},
+			),
+		},
+		"invalid-length": {
+			elementType: StringType{},
+			length:      -1,
+			expected:    NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Length",
+					"An unexpected negative length was given while creating a List with unknown elements. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Length: -1",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewListValueWithUnknownElements(context.Background(), testCase.elementType, testCase.length)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListElementsAs_stringSlice(t *testing.T) {
 	t.Parallel()
 
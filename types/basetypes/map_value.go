@@ -124,6 +124,39 @@ func NewMapValueFrom(ctx context.Context, elementType attr.Type, elements any) (
 	return m, diags
 }
 
+// NewMapValueWithUnknownElements creates a Map with a known value, where each
+// of the given keys has an unknown element value. Use this during plan
+// modification when the map keys are known before apply, but the element
+// values are not, such as keys derived from configuration. Unlike an entirely
+// unknown Map, Terraform can use the known keys before apply, such as in
+// for_each expressions. Access the value via the Map type Elements or
+// ElementsAs methods.
+func NewMapValueWithUnknownElements(ctx context.Context, elementType attr.Type, keys []string) (MapValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	unknownElement, err := elementType.ValueFromTerraform(ctx, tftypes.NewValue(elementType.TerraformType(ctx), tftypes.UnknownValue))
+
+	if err != nil {
+		diags.AddError(
+			"Error Creating Unknown Map Element",
+			"An unexpected error occurred while creating an unknown Map element value. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Map Element Type: %s\n", elementType.String())+
+				"Error: "+err.Error(),
+		)
+
+		return NewMapUnknown(elementType), diags
+	}
+
+	elements := make(map[string]attr.Value, len(keys))
+
+	for _, key := range keys {
+		elements[key] = unknownElement
+	}
+
+	return NewMapValue(elementType, elements)
+}
+
 // NewMapValueMust creates a Map with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Map
 // type Elements or ElementsAs methods.
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// MapValueBuilder incrementally constructs a known MapValue. Elements are
+// type checked as they are put, so building a Map with many elements, such as
+// when reading paginated remote data, does not require repeatedly creating
+// new Map values from a growing map. Call Freeze to create the MapValue once
+// all elements are put.
+//
+// A MapValueBuilder is safe for concurrent use. Create a MapValueBuilder with
+// NewMapValueBuilder.
+type MapValueBuilder struct {
+	// elements is the mapping of put values.
+	elements map[string]attr.Value
+
+	// elementType is the type of the elements in the Map.
+	elementType attr.Type
+
+	// frozen is true after Freeze is called.
+	frozen bool
+
+	// mu protects elements and frozen.
+	mu sync.Mutex
+}
+
+// NewMapValueBuilder creates a MapValueBuilder for the given element type.
+func NewMapValueBuilder(elementType attr.Type) *MapValueBuilder {
+	return &MapValueBuilder{
+		elements:    make(map[string]attr.Value),
+		elementType: elementType,
+	}
+}
+
+// Put sets the element for the given key, replacing any existing element. The
+// element is not set if it is not of the Map element type or if Freeze was
+// already called.
+func (b *MapValueBuilder) Put(ctx context.Context, key string, element attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.frozen {
+		diags.Append(builderFrozenDiagnostic("Map"))
+
+		return diags
+	}
+
+	if element == nil || !b.elementType.Equal(element.Type(ctx)) {
+		diags.AddError(
+			"Invalid Map Element Type",
+			"While putting into a Map value, an invalid element was detected. "+
+				"A Map must use the single, given element type. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Map Element Type: %s\n", b.elementType.String())+
+				fmt.Sprintf("Map Key (%s) Element Type: %s", key, elementTypeString(ctx, element)),
+		)
+
+		return diags
+	}
+
+	b.elements[key] = element
+
+	return diags
+}
+
+// PutFrom sets the element for the given key, using reflection rules. The
+// element must convert into the Map element type. The element is not set if
+// it cannot be converted or if Freeze was already called.
+func (b *MapValueBuilder) PutFrom(ctx context.Context, key string, element any) diag.Diagnostics {
+	value, diags := reflect.FromValue(ctx, b.elementType, element, path.Empty())
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(b.Put(ctx, key, value)...)
+
+	return diags
+}
+
+// Len returns the number of elements put into the Map.
+func (b *MapValueBuilder) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.elements)
+}
+
+// Freeze returns the MapValue of all put elements. Any further calls to Put
+// or PutFrom return an error diagnostic, while further calls to Freeze return
+// an equal MapValue.
+func (b *MapValueBuilder) Freeze() MapValue {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.frozen = true
+
+	// Elements can no longer change, so the map can be shared as MapValue
+	// never exposes it to callers.
+	return MapValue{
+		elementType: b.elementType,
+		elements:    b.elements,
+		state:       attr.ValueStateKnown,
+	}
+}
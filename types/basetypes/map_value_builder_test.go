@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestMapValueBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		build         func(context.Context, *MapValueBuilder) diag.Diagnostics
+		expected      MapValue
+		expectedDiags diag.Diagnostics
+	}{
+		"no-elements": {
+			build: func(_ context.Context, _ *MapValueBuilder) diag.Diagnostics {
+				return nil
+			},
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{}),
+		},
+		"put": {
+			build: func(ctx context.Context, b *MapValueBuilder) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(b.Put(ctx, "one", NewStringValue("one"))...)
+				diags.Append(b.Put(ctx, "null", NewStringNull())...)
+				diags.Append(b.Put(ctx, "unknown", NewStringUnknown())...)
+
+				return diags
+			},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"one":     NewStringValue("one"),
+					"null":    NewStringNull(),
+					"unknown": NewStringUnknown(),
+				},
+			),
+		},
+		"put-existing-key": {
+			build: func(ctx context.Context, b *MapValueBuilder) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(b.Put(ctx, "key", NewStringValue("one"))...)
+				diags.Append(b.Put(ctx, "key", NewStringValue("two"))...)
+
+				return diags
+			},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key": NewStringValue("two"),
+				},
+			),
+		},
+		"put-from": {
+			build: func(ctx context.Context, b *MapValueBuilder) diag.Diagnostics {
+				return b.PutFrom(ctx, "one", "one")
+			},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"one": NewStringValue("one"),
+				},
+			),
+		},
+		"put-invalid-element-type": {
+			build: func(ctx context.Context, b *MapValueBuilder) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(b.Put(ctx, "one", NewStringValue("one"))...)
+				diags.Append(b.Put(ctx, "two", NewBoolValue(true))...)
+
+				return diags
+			},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"one": NewStringValue("one"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Element Type",
+					"While putting into a Map value, an invalid element was detected. "+
+						"A Map must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Map Element Type: basetypes.StringType\n"+
+						"Map Key (two) Element Type: basetypes.BoolType",
+				),
+			},
+		},
+		"put-after-freeze": {
+			build: func(ctx context.Context, b *MapValueBuilder) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				diags.Append(b.Put(ctx, "one", NewStringValue("one"))...)
+
+				b.Freeze()
+
+				diags.Append(b.Put(ctx, "two", NewStringValue("two"))...)
+
+				return diags
+			},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"one": NewStringValue("one"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Value Builder Already Frozen",
+					"While building a Map value, elements were added after the value was created with Freeze. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := NewMapValueBuilder(StringType{})
+
+			diags := testCase.build(context.Background(), builder)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			got := builder.Freeze()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueBuilderConcurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	builder := NewMapValueBuilder(Int64Type{})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			if diags := builder.Put(ctx, strconv.Itoa(i), NewInt64Value(int64(i))); diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if builder.Len() != 100 {
+		t.Fatalf("expected 100 elements, got %d", builder.Len())
+	}
+
+	got := builder.Freeze()
+
+	for key, element := range got.Elements() {
+		//nolint:forcetypeassert // Type is checked by Put
+		if strconv.FormatInt(element.(Int64Value).ValueInt64(), 10) != key {
+			t.Errorf("unexpected element for key %s: %s", key, element)
+		}
+	}
+}
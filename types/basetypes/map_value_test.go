@@ -202,6 +202,76 @@ func TestNewMapValueFrom(t *testing.T) {
 	}
 }
 
+func TestNewMapValueWithUnknownElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		keys          []string
+		expected      MapValue
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			elementType: StringType{},
+			keys:        []string{},
+			expected:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+		},
+		"nil": {
+			elementType: StringType{},
+			expected:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+		},
+		"ObjectType": {
+			elementType: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test_attr": StringType{},
+				},
+			},
+			keys: []string{"key1"},
+			expected: NewMapValueMust(
+				ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"test_attr": StringType{},
+					},
+				},
+				map[string]attr.Value{
+					"key1": NewObjectUnknown(map[string]attr.Type{
+						"test_attr": StringType{},
+					}),
+				},
+			),
+		},
+		"StringType": {
+			elementType: StringType{},
+			keys:        []string{"key1", "key2"},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key1": NewStringUnknown(),
+					"key2": NewStringUnknown(),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewMapValueWithUnknownElements(context.Background(), testCase.elementType, testCase.keys)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapElementsAs_mapStringString(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"math/big"
+)
+
+// terraformNumberPrecision is the precision, in bits, which Terraform uses
+// when parsing numbers from configuration.
+const terraformNumberPrecision = 512
+
+// NumberFormat controls the precision and decimal representation of number
+// values, such as values from a remote system which are written to state.
+//
+// A *big.Float created from a Go float64, such as big.NewFloat(0.1), has 53
+// bits of precision and is not equal to the same number in configuration,
+// which Terraform parses with 512 bits of precision. This can cause
+// inconsistent result errors or differences between refreshes. Formatted
+// values are rounded to a decimal representation, then parsed with the same
+// precision as Terraform, so the same decimal always results in an equal
+// value, regardless of how the value was created.
+type NumberFormat struct {
+	// SignificantDigits, if greater than zero, rounds values to the given
+	// number of significant decimal digits. If zero, values use the
+	// shortest decimal representation which is exact for their precision,
+	// such as 0.1 for big.NewFloat(0.1).
+	SignificantDigits int
+
+	// DecimalPlaces, if greater than zero, rounds values to the given fixed
+	// number of digits after the decimal point, such as 2 for currency
+	// amounts. It is applied after SignificantDigits.
+	DecimalPlaces int
+}
+
+// Apply returns the value formatted according to the NumberFormat. A nil or
+// infinite value is returned unmodified. Applying the same NumberFormat to a
+// formatted value returns an equal value.
+func (f NumberFormat) Apply(value *big.Float) *big.Float {
+	if value == nil || value.IsInf() {
+		return value
+	}
+
+	text := value.Text('g', -1)
+
+	if f.SignificantDigits > 0 {
+		text = value.Text('g', f.SignificantDigits)
+	}
+
+	result, _, err := big.ParseFloat(text, 10, terraformNumberPrecision, big.ToNearestEven)
+
+	// Not possible with text from big.Float, but check anyways
+	if err != nil {
+		return value
+	}
+
+	if f.DecimalPlaces > 0 {
+		result, _, err = big.ParseFloat(result.Text('f', f.DecimalPlaces), 10, terraformNumberPrecision, big.ToNearestEven)
+
+		if err != nil {
+			return value
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// testTerraformNumber returns the number as Terraform parses it from
+// configuration.
+func testTerraformNumber(t *testing.T, text string) *big.Float {
+	t.Helper()
+
+	result, _, err := big.ParseFloat(text, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing %q: %s", text, err)
+	}
+
+	return result
+}
+
+func TestNumberFormatApply(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		format   NumberFormat
+		input    *big.Float
+		expected string
+	}{
+		"nil": {
+			format: NumberFormat{},
+			input:  nil,
+		},
+		"infinity": {
+			format:   NumberFormat{},
+			input:    big.NewFloat(math.Inf(1)),
+			expected: "+Inf",
+		},
+		"float64-shortest": {
+			format:   NumberFormat{},
+			input:    big.NewFloat(0.1),
+			expected: "0.1",
+		},
+		"integer": {
+			format:   NumberFormat{},
+			input:    big.NewFloat(123),
+			expected: "123",
+		},
+		"significant-digits": {
+			format: NumberFormat{
+				SignificantDigits: 3,
+			},
+			input:    big.NewFloat(123.456),
+			expected: "123",
+		},
+		"decimal-places": {
+			format: NumberFormat{
+				DecimalPlaces: 2,
+			},
+			input:    big.NewFloat(1.005001),
+			expected: "1.01",
+		},
+		"significant-digits-and-decimal-places": {
+			format: NumberFormat{
+				DecimalPlaces:     2,
+				SignificantDigits: 4,
+			},
+			input:    big.NewFloat(0.123456),
+			expected: "0.12",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.format.Apply(testCase.input)
+
+			if testCase.input == nil {
+				if got != nil {
+					t.Fatalf("expected nil, got: %s", got)
+				}
+
+				return
+			}
+
+			if got.IsInf() {
+				if got.String() != testCase.expected {
+					t.Fatalf("expected %s, got: %s", testCase.expected, got)
+				}
+
+				return
+			}
+
+			if expected := testTerraformNumber(t, testCase.expected); got.Cmp(expected) != 0 {
+				t.Fatalf("expected %s, got: %s", expected.Text('g', -1), got.Text('g', -1))
+			}
+
+			if again := testCase.format.Apply(got); again.Cmp(got) != 0 {
+				t.Fatalf("expected applying format again to be equal, got: %s", again.Text('g', -1))
+			}
+		})
+	}
+}
+
+func TestNumberValueWithFormat(t *testing.T) {
+	t.Parallel()
+
+	got := NewNumberValue(big.NewFloat(0.1)).WithFormat(NumberFormat{})
+	expected := NewNumberValue(testTerraformNumber(t, "0.1"))
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got: %s", expected, got)
+	}
+
+	if got := NewNumberNull().WithFormat(NumberFormat{}); !got.IsNull() {
+		t.Errorf("expected null, got: %s", got)
+	}
+
+	if got := NewNumberUnknown().WithFormat(NumberFormat{}); !got.IsUnknown() {
+		t.Errorf("expected unknown, got: %s", got)
+	}
+}
+
+func TestFloat64ValueWithFormat(t *testing.T) {
+	t.Parallel()
+
+	got := NewFloat64Value(1.23456).WithFormat(NumberFormat{DecimalPlaces: 2})
+	expected := Float64Value{
+		state: got.state,
+		value: testTerraformNumber(t, "1.23"),
+	}
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got: %s", expected, got)
+	}
+
+	if got.ValueFloat64() != 1.23 {
+		t.Errorf("expected float64 1.23, got: %f", got.ValueFloat64())
+	}
+
+	if got := NewFloat64Null().WithFormat(NumberFormat{}); !got.IsNull() {
+		t.Errorf("expected null, got: %s", got)
+	}
+}
@@ -153,6 +153,17 @@ func (n NumberValue) String() string {
 	return n.value.String()
 }
 
+// WithFormat returns the Number with its known value formatted according
+// to the NumberFormat, such as before setting the value into state. Null and
+// unknown values are returned unmodified.
+func (n NumberValue) WithFormat(format NumberFormat) NumberValue {
+	if n.state != attr.ValueStateKnown {
+		return n
+	}
+
+	return NewNumberValue(format.Apply(n.value))
+}
+
 // ValueBigFloat returns the known *big.Float value. If Number is null or unknown, returns
 // 0.0.
 func (n NumberValue) ValueBigFloat() *big.Float {
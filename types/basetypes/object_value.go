@@ -230,6 +230,26 @@ func (o ObjectValue) As(ctx context.Context, target interface{}, opts ObjectAsOp
 	}, path.Empty())
 }
 
+// ToGoMap returns the ObjectValue as a map of attribute names to plain Go
+// values, which is intended for passing data to JSON-based logic without
+// defining a Go type for the object. Nested null values are nil and nested
+// unknown values are tfsdk.Unknown. Refer to tfsdk.ValueAsAny for the full
+// conversion rules. A null or unknown ObjectValue returns a nil map.
+func (o ObjectValue) ToGoMap(ctx context.Context) (map[string]any, diag.Diagnostics) {
+	if o.IsNull() || o.IsUnknown() {
+		return nil, nil
+	}
+
+	val, diags := reflect.AttributeGoValue(ctx, o, path.Empty())
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	//nolint:forcetypeassert // Known object values are always converted into a map
+	return val.(map[string]any), diags
+}
+
 // Attributes returns a copy of the mapping of known attribute values for the Object.
 func (o ObjectValue) Attributes() map[string]attr.Value {
 	// Ensure callers cannot mutate the internal attributes
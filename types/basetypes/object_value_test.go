@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -1735,6 +1736,73 @@ func TestObjectValueString(t *testing.T) {
 	}
 }
 
+func TestObjectValueToGoMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ObjectValue
+		expected map[string]any
+	}{
+		"known": {
+			input: NewObjectValueMust(
+				map[string]attr.Type{
+					"bool_attr":    BoolType{},
+					"int64_attr":   Int64Type{},
+					"list_attr":    ListType{ElemType: StringType{}},
+					"null_attr":    StringType{},
+					"string_attr":  StringType{},
+					"unknown_attr": StringType{},
+				},
+				map[string]attr.Value{
+					"bool_attr":  NewBoolValue(true),
+					"int64_attr": NewInt64Value(123),
+					"list_attr": NewListValueMust(
+						StringType{},
+						[]attr.Value{NewStringValue("test-value")},
+					),
+					"null_attr":    NewStringNull(),
+					"string_attr":  NewStringValue("test-value"),
+					"unknown_attr": NewStringUnknown(),
+				},
+			),
+			expected: map[string]any{
+				"bool_attr":    true,
+				"int64_attr":   int64(123),
+				"list_attr":    []any{"test-value"},
+				"null_attr":    nil,
+				"string_attr":  "test-value",
+				"unknown_attr": reflect.Unknown{},
+			},
+		},
+		"null": {
+			input:    NewObjectNull(map[string]attr.Type{"test_attr": StringType{}}),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewObjectUnknown(map[string]attr.Type{"test_attr": StringType{}}),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ToGoMap(context.Background())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestObjectValueType(t *testing.T) {
 	t.Parallel()
 
@@ -122,6 +122,53 @@ func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any) (
 	return set, diags
 }
 
+// NewSetValueWithUnknownElements creates a Set with a known value of the
+// given length, where each element is unknown. If the element type is an
+// object type, such as the element type of a nested attribute, each element is
+// a known object where every attribute is unknown. Use this during plan
+// modification when the number of elements is known before apply, such as from
+// configuration, but the element values are not. Unlike an entirely unknown
+// Set, each element is shown in plan output. Terraform considers
+// elements with unknown values to be distinct, so the elements are not
+// deduplicated. Access the value via
+// the Set type Elements or ElementsAs methods.
+func NewSetValueWithUnknownElements(ctx context.Context, elementType attr.Type, length int) (SetValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if length < 0 {
+		diags.AddError(
+			"Invalid Set Length",
+			"An unexpected negative length was given while creating a Set with unknown elements. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Set Length: %d", length),
+		)
+
+		return NewSetUnknown(elementType), diags
+	}
+
+	unknownElement, err := unknownCollectionElement(ctx, elementType)
+
+	if err != nil {
+		diags.AddError(
+			"Error Creating Unknown Set Element",
+			"An unexpected error occurred while creating an unknown Set element value. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Set Element Type: %s\n", elementType.String())+
+				"Error: "+err.Error(),
+		)
+
+		return NewSetUnknown(elementType), diags
+	}
+
+	elements := make([]attr.Value, length)
+
+	for idx := range elements {
+		elements[idx] = unknownElement
+	}
+
+	return NewSetValue(elementType, elements)
+}
+
 // NewSetValueMust creates a Set with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Set
 // type Elements or ElementsAs methods.
@@ -16,6 +16,84 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
+func TestNewSetValueWithUnknownElements(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id": StringType{},
+		},
+	}
+
+	testObjectValue := NewObjectValueMust(
+		testObjectType.AttrTypes,
+		map[string]attr.Value{
+			"id": NewStringUnknown(),
+		},
+	)
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		length        int
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"primitive": {
+			elementType: StringType{},
+			length:      1,
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+				},
+			),
+		},
+		"object": {
+			elementType: testObjectType,
+			length:      3,
+			expected: NewSetValueMust(
+				testObjectType,
+				[]attr.Value{
+					testObjectValue,
+					testObjectValue,
+					testObjectValue,
+				},
+			),
+		},
+		"invalid-length": {
+			elementType: StringType{},
+			length:      -2,
+			expected:    NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Length",
+					"An unexpected negative length was given while creating a Set with unknown elements. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Length: -2",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewSetValueWithUnknownElements(context.Background(), testCase.elementType, testCase.length)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetElementsAs_stringSlice(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// unknownCollectionElement returns an unknown value of the given element
+// type for the NewListValueWithUnknownElements and
+// NewSetValueWithUnknownElements functions. If the element type is an object
+// type without optional attributes, such as the element type of a nested
+// attribute, the element is a known object where every attribute is unknown,
+// so each element is shown in plan output.
+func unknownCollectionElement(ctx context.Context, elementType attr.Type) (attr.Value, error) {
+	tfType := elementType.TerraformType(ctx)
+	tfValue := tftypes.NewValue(tfType, tftypes.UnknownValue)
+
+	if objectType, ok := tfType.(tftypes.Object); ok && len(objectType.OptionalAttributes) == 0 {
+		attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+		}
+
+		tfValue = tftypes.NewValue(tfType, attributes)
+	}
+
+	return elementType.ValueFromTerraform(ctx, tfValue)
+}
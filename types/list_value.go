@@ -38,6 +38,22 @@ func ListValueFrom(ctx context.Context, elementType attr.Type, elements any) (ba
 	return basetypes.NewListValueFrom(ctx, elementType, elements)
 }
 
+// ListValueBuilder creates a builder for incrementally constructing a known List
+// value, where elements are type checked as they are appended. Call the builder
+// Freeze method to create the List value.
+func ListValueBuilder(elementType attr.Type) *basetypes.ListValueBuilder {
+	return basetypes.NewListValueBuilder(elementType)
+}
+
+// ListValueWithUnknownElements creates a List with a known value of the given
+// length, where each element is unknown, or a known object with unknown
+// attributes if the element type is an object type. This is typically used
+// to plan computed nested attributes where only the number of elements is
+// known.
+func ListValueWithUnknownElements(ctx context.Context, elementType attr.Type, length int) (basetypes.ListValue, diag.Diagnostics) {
+	return basetypes.NewListValueWithUnknownElements(ctx, elementType, length)
+}
+
 // ListValueMust creates a List with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the List
 // type Elements or ElementsAs methods.
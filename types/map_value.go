@@ -38,6 +38,22 @@ func MapValueFrom(ctx context.Context, elementType attr.Type, elements any) (bas
 	return basetypes.NewMapValueFrom(ctx, elementType, elements)
 }
 
+// MapValueWithUnknownElements creates a Map with a known value, where each of
+// the given keys has an unknown element value. Use this during plan
+// modification when the map keys are known before apply, but the element
+// values are not. Access the value via the Map type Elements or ElementsAs
+// methods.
+func MapValueWithUnknownElements(ctx context.Context, elementType attr.Type, keys []string) (basetypes.MapValue, diag.Diagnostics) {
+	return basetypes.NewMapValueWithUnknownElements(ctx, elementType, keys)
+}
+
+// MapValueBuilder creates a builder for incrementally constructing a known Map
+// value, where elements are type checked as they are put. Call the builder
+// Freeze method to create the Map value.
+func MapValueBuilder(elementType attr.Type) *basetypes.MapValueBuilder {
+	return basetypes.NewMapValueBuilder(elementType)
+}
+
 // MapValueMust creates a Map with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Map
 // type Elements or ElementsAs methods.
@@ -38,6 +38,15 @@ func SetValueFrom(ctx context.Context, elementType attr.Type, elements any) (bas
 	return basetypes.NewSetValueFrom(ctx, elementType, elements)
 }
 
+// SetValueWithUnknownElements creates a Set with a known value of the given
+// length, where each element is unknown, or a known object with unknown
+// attributes if the element type is an object type. This is typically used
+// to plan computed nested attributes where only the number of elements is
+// known.
+func SetValueWithUnknownElements(ctx context.Context, elementType attr.Type, length int) (basetypes.SetValue, diag.Diagnostics) {
+	return basetypes.NewSetValueWithUnknownElements(ctx, elementType, length)
+}
+
 // SetValueMust creates a Set with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Set
 // type Elements or ElementsAs methods.